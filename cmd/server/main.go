@@ -7,11 +7,16 @@ import (
 	"net"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/afero"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 
+	"github.com/raspidrum-srv/internal/app/audio"
+	"github.com/raspidrum-srv/internal/app/library"
+	loadkit "github.com/raspidrum-srv/internal/app/load_kit"
+	midi "github.com/raspidrum-srv/internal/app/mididevice"
 	"github.com/raspidrum-srv/internal/app/preset"
 	pb "github.com/raspidrum-srv/internal/pkg/grpc"
 	"github.com/raspidrum-srv/internal/repo/db"
@@ -31,6 +36,31 @@ type Config struct {
 	Log struct {
 		Level string `mapstructure:"level"`
 	} `mapstructure:"log"`
+	Preset struct {
+		// save control changes of the loaded preset automatically
+		Autosave      bool          `mapstructure:"autosave"`
+		AutosaveDelay time.Duration `mapstructure:"autosaveDelay"`
+		// memory in megabytes for samples of the loaded and preloaded presets. 0 disables preloading
+		PreloadBudget int64 `mapstructure:"preloadBudget"`
+	} `mapstructure:"preset"`
+	Kit struct {
+		// limits of unpacked kit archive on import. Sizes are in megabytes, 0 keeps default
+		ImportMaxSize     int64 `mapstructure:"importMaxSize"`
+		ImportMaxFileSize int64 `mapstructure:"importMaxFileSize"`
+		ImportMaxEntries  int64 `mapstructure:"importMaxEntries"`
+	} `mapstructure:"kit"`
+	Midi struct {
+		// polling interval of connected MIDI devices
+		WatchInterval time.Duration `mapstructure:"watchInterval"`
+	} `mapstructure:"midi"`
+	Audio struct {
+		// LinuxSampler audio output driver: ALSA, JACK, COREAUDIO
+		Driver string `mapstructure:"driver"`
+		// audio output device parameters, i.e. CARD, SAMPLERATE
+		Params map[string]string `mapstructure:"params"`
+		// parameters of device audio channels, i.e. JACK_BINDINGS. Key - audio channel
+		Channels map[int]map[string]string `mapstructure:"channels"`
+	} `mapstructure:"audio"`
 }
 
 var cfg Config
@@ -65,6 +95,15 @@ func main() {
 	// Initialize filesystem
 	fs := afero.NewOsFs()
 
+	// rebind sampler MIDI input on reconnect of MIDI devices
+	midiWatcher := midi.NewWatcher(fs, cfg.Midi.WatchInterval, func(devs []midi.USBMIDIDevice) {
+		if err := preset.RebindMidiDevices(sampler, devs); err != nil {
+			slog.Error(fmt.Sprintln(err))
+		}
+	})
+	midiWatcher.Start()
+	defer midiWatcher.Stop()
+
 	// start GRPC server
 	lis, err := net.Listen("tcp", fmt.Sprintf("%s:%d", cfg.Host.Addr, cfg.Host.Port))
 	if err != nil {
@@ -80,9 +119,43 @@ func main() {
 	//defer cleanup()
 
 	// Register services
-	presetServer := preset.NewPresetServer(db, sampler, fs)
+	audioSettings := audio.NewSettings(audio.NewAudioOutput(cfg.Audio.Driver, cfg.Audio.Params, cfg.Audio.Channels))
+	presetServer := preset.NewPresetServer(db, sampler, audioSettings, fs)
+	if cfg.Preset.Autosave {
+		presetServer.EnableAutosave(cfg.Preset.AutosaveDelay)
+	}
+	if cfg.Preset.PreloadBudget > 0 {
+		presetServer.EnablePreload(cfg.Preset.PreloadBudget << 20)
+	}
+	// restarted sampler is empty, load the loaded preset again
+	sampler.OnReconnect(func() {
+		if err := presetServer.RestorePreset(); err != nil {
+			slog.Error(fmt.Sprintln(err))
+		}
+	})
+	// controls follow knobs of drum module
+	if err := sampler.OnMidiCC(presetServer.HandleMidiCC); err != nil {
+		slog.Warn(fmt.Sprintln(err))
+	}
+	// activity of pads for WatchActivity
+	if err := sampler.OnMidiNote(presetServer.HandleMidiNote); err != nil {
+		slog.Warn(fmt.Sprintln(err))
+	}
+	if err := sampler.OnVoiceCount(presetServer.HandleVoiceCount); err != nil {
+		slog.Warn(fmt.Sprintln(err))
+	}
 	pb.RegisterKitPresetServer(s, presetServer)
 	pb.RegisterChannelControlServer(s, presetServer)
+	pb.RegisterLibraryServer(s, library.NewLibraryServer(db))
+	kitServer := loadkit.NewKitServer(db, samplerDataPath, fs)
+	kitServer.SetImportLimits(loadkit.ImportLimits{
+		TotalSize: cfg.Kit.ImportMaxSize << 20,
+		FileSize:  cfg.Kit.ImportMaxFileSize << 20,
+		Entries:   cfg.Kit.ImportMaxEntries,
+	})
+	pb.RegisterKitServer(s, kitServer)
+	pb.RegisterMidiServer(s, midi.NewMidiServer(db, fs))
+	pb.RegisterAudioSetupServer(s, audio.NewAudioServer(sampler, audioSettings, presetServer.ReloadPreset))
 
 	slog.Info("Server is running", slog.Int("port:", cfg.Host.Port))
 	if err := s.Serve(lis); err != nil {
@@ -104,6 +177,9 @@ func loadConfig(configPath string) (Config, error) {
 	v.AutomaticEnv()
 	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	v.BindEnv("log.level", "SRV_LOG_LEVEL")
+	v.SetDefault("preset.autosaveDelay", "5s")
+	v.SetDefault("midi.watchInterval", "2s")
+	v.SetDefault("audio.driver", "ALSA")
 
 	if err := v.ReadInConfig(); err != nil {
 		return Config{}, fmt.Errorf("failed to read config file: %w", err)
@@ -0,0 +1,19 @@
+-- +goose Up
+/*
+  v_kit_preset joined kit by preset id instead of kit id:
+  presets with id != kit id were not visible and every preset was joined with every kit
+*/
+drop view if exists v_kit_preset;
+
+create view v_kit_preset as
+select p.*, k.uid as kit_uid, k.name as kit_name, k.iscustom as kit_iscustom
+  from kit_preset p
+  join kit k on p.kit = k.id;
+
+-- +goose Down
+drop view if exists v_kit_preset;
+
+create view v_kit_preset as
+select p.*, k.uid as kit_uid, k.name as kit_name, k.iscustom as kit_iscustom
+  from kit_preset p
+  join kit k on p.kit = p.id;
@@ -0,0 +1,12 @@
+-- +goose Up
+/*
+  kits loaded from kit files were stored with iscustom = 1.
+  Custom kits could not be created before, so all existing kits are stock kits
+*/
+update kit set iscustom = 0;
+
+-- +goose Down
+/*
+  no-op: the wrong flag of stock kits isn't restored.
+  Kits changed by Up can't be told apart from stock kits loaded later, and custom kits keep iscustom = 1
+*/
@@ -0,0 +1,111 @@
+-- +goose Up
+/*
+  MIDI note mapping profiles. Profile maps MIDI Key aliases of presets (kick1, snare, hihat_open...) to MIDI notes.
+  builtin = 1 - seeded profiles from "doc/midi keys.md". They can't be changed or removed.
+  Raspidrum profile is used for devices without assigned profile
+*/
+create table if not exists midi_map_profile (
+  id          integer primary key autoincrement,
+  name        varchar(64) not null unique,
+  builtin     integer not null default 0
+);
+
+create table if not exists midi_map_entry (
+  profile     integer not null,
+  midikey     varchar(32) not null,
+  note        integer not null check (note between 0 and 127),
+  foreign key (profile) references midi_map_profile(id) on delete cascade,
+  unique (profile, midikey)
+);
+
+/*
+  profile assigned to MIDI device.
+  Device is identified by name: ALSA client id changes on reconnect
+*/
+create table if not exists midi_device (
+  name        varchar(128) primary key,
+  profile     integer not null,
+  foreign key (profile) references midi_map_profile(id) on delete restrict
+);
+
+insert into midi_map_profile(name, builtin) values('Alesis', 1);
+insert into midi_map_entry(profile, midikey, note)
+select p.id, e.column1, e.column2
+  from midi_map_profile p, (values
+  ('kick1', 36),
+  ('snare', 38),
+  ('snare_rimshot', 39),
+  ('tom1', 48),
+  ('tom2', 45),
+  ('tom3', 43),
+  ('tom4', 41),
+  ('hihat_close', 42),
+  ('hihat_open', 44),
+  ('hihat_foot_open', 49),
+  ('hihat_foot_close', 50),
+  ('hihat_splash', 51),
+  ('crash1_edge', 49),
+  ('ride1_edge', 51)
+  ) e
+ where p.name = 'Alesis';
+
+insert into midi_map_profile(name, builtin) values('GM', 1);
+insert into midi_map_entry(profile, midikey, note)
+select p.id, e.column1, e.column2
+  from midi_map_profile p, (values
+  ('kick1', 36),
+  ('snare', 38),
+  ('tom1', 50),
+  ('tom2', 45),
+  ('tom3', 43),
+  ('tom4', 41),
+  ('hihat_close', 42),
+  ('hihat_open', 46),
+  ('hihat_foot_close', 44),
+  ('crash1_edge', 49),
+  ('ride1_edge', 51),
+  ('ride1_bell', 53)
+  ) e
+ where p.name = 'GM';
+
+insert into midi_map_profile(name, builtin) values('Orchestral Percussion', 1);
+insert into midi_map_entry(profile, midikey, note)
+select p.id, e.column1, e.column2
+  from midi_map_profile p, (values
+  ('kick1', 35),
+  ('snare', 38),
+  ('hihat_close', 27),
+  ('hihat_open', 29),
+  ('hihat_splash', 28)
+  ) e
+ where p.name = 'Orchestral Percussion';
+
+insert into midi_map_profile(name, builtin) values('Raspidrum', 1);
+insert into midi_map_entry(profile, midikey, note)
+select p.id, e.column1, e.column2
+  from midi_map_profile p, (values
+  ('kick1', 36),
+  ('snare', 38),
+  ('snare_rimshot', 39),
+  ('tom1', 48),
+  ('tom2', 45),
+  ('tom3', 43),
+  ('tom4', 41),
+  ('hihat_close', 42),
+  ('hihat_open', 46),
+  ('hihat_loose', 29),
+  ('hihat_foot_open', 27),
+  ('hihat_foot_close', 44),
+  ('hihat_splash', 28),
+  ('crash1_edge', 49),
+  ('ride1_edge', 51),
+  ('ride1_bell', 53)
+  ) e
+ where p.name = 'Raspidrum';
+
+-- +goose Down
+drop table midi_device;
+
+drop table midi_map_entry;
+
+drop table midi_map_profile;
@@ -0,0 +1,15 @@
+-- +goose Up
+/*
+  mute and solo state of preset channels and instruments. 1 - on.
+  State of layers is stored in preset_instrument.layers
+*/
+alter table preset_channel add column mute integer not null default 0;
+alter table preset_channel add column solo integer not null default 0;
+alter table preset_instrument add column mute integer not null default 0;
+alter table preset_instrument add column solo integer not null default 0;
+
+-- +goose Down
+alter table preset_instrument drop column solo;
+alter table preset_instrument drop column mute;
+alter table preset_channel drop column solo;
+alter table preset_channel drop column mute;
@@ -0,0 +1,8 @@
+-- +goose Up
+/*
+  send effect chains of preset audio output in json: chains with effect plugins and stored values of their params
+*/
+alter table kit_preset add column fxs text;
+
+-- +goose Down
+alter table kit_preset drop column fxs;
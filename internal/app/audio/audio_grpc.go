@@ -0,0 +1,135 @@
+package audio
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/raspidrum-srv/internal/pkg/grpc"
+	"github.com/raspidrum-srv/internal/repo"
+)
+
+type AudioServer struct {
+	pb.UnimplementedAudioSetupServer
+	sampler  repo.SamplerRepo
+	settings *Settings
+	// called after new settings are applied, i.e. to reload the loaded preset
+	onApply func() error
+}
+
+func NewAudioServer(sampler repo.SamplerRepo, settings *Settings, onApply func() error) *AudioServer {
+	return &AudioServer{
+		sampler:  sampler,
+		settings: settings,
+		onApply:  onApply,
+	}
+}
+
+func (s *AudioServer) ListAudioDrivers(ctx context.Context, req *pb.ListAudioDriversRequest) (*pb.ListAudioDriversResponse, error) {
+	drvs, err := s.sampler.GetAudioOutputDrivers()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list audio drivers: %v", err)
+	}
+	res := &pb.ListAudioDriversResponse{
+		Drivers: make([]*pb.AudioDriver, len(drvs)),
+	}
+	for i := range drvs {
+		res.Drivers[i] = convertDriverToPb(&drvs[i])
+	}
+	return res, nil
+}
+
+func (s *AudioServer) GetAudioOutput(ctx context.Context, req *pb.GetAudioOutputRequest) (*pb.AudioOutputResponse, error) {
+	return &pb.AudioOutputResponse{Output: convertOutputToPb(s.settings.Get())}, nil
+}
+
+func (s *AudioServer) ApplyAudioOutput(ctx context.Context, req *pb.ApplyAudioOutputRequest) (*pb.AudioOutputResponse, error) {
+	if req.Output == nil {
+		return nil, status.Error(codes.InvalidArgument, "output is required")
+	}
+	out := convertOutputToRepo(req.Output)
+	drvs, err := s.sampler.GetAudioOutputDrivers()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to apply audio output: %v", err)
+	}
+	if err := Validate(out, drvs); err != nil {
+		return nil, audioStatusErr("failed to apply audio output", err)
+	}
+	prev := s.settings.Get()
+	s.settings.Set(out)
+	if s.onApply != nil {
+		if err := s.onApply(); err != nil {
+			// the next load of preset uses the previous output
+			s.settings.Set(prev)
+			return nil, audioStatusErr("failed to apply audio output", err)
+		}
+	}
+	return &pb.AudioOutputResponse{Output: convertOutputToPb(out)}, nil
+}
+
+// audioStatusErr maps audio output errors to grpc status codes
+func audioStatusErr(msg string, err error) error {
+	code := codes.Internal
+	if errors.Is(err, ErrInvalidAudioOutput) {
+		code = codes.InvalidArgument
+	}
+	return status.Errorf(code, "%s: %v", msg, err)
+}
+
+func convertDriverToPb(drv *repo.AudioDriver) *pb.AudioDriver {
+	res := &pb.AudioDriver{
+		Name:        drv.Name,
+		Description: drv.Description,
+		Version:     drv.Version,
+		Parameters:  make([]*pb.AudioDriverParameter, len(drv.Params)),
+	}
+	for i, p := range drv.Params {
+		res.Parameters[i] = &pb.AudioDriverParameter{
+			Name:          p.Name,
+			Description:   p.Description,
+			Type:          p.Type,
+			Mandatory:     p.Mandatory,
+			Multiplicity:  p.Multiplicity,
+			Default:       p.Default,
+			Possibilities: p.Possibilities,
+		}
+	}
+	return res
+}
+
+func convertOutputToPb(out repo.AudioOutput) *pb.AudioOutput {
+	res := &pb.AudioOutput{
+		Driver: out.Driver,
+		Params: paramsToMap(out.Params),
+	}
+	for _, k := range slices.Sorted(maps.Keys(out.Channels)) {
+		res.Channels = append(res.Channels, &pb.AudioChannel{
+			Channel: int32(k),
+			Params:  paramsToMap(out.Channels[k]),
+		})
+	}
+	return res
+}
+
+func convertOutputToRepo(out *pb.AudioOutput) repo.AudioOutput {
+	var chnls map[int]map[string]string
+	if len(out.Channels) > 0 {
+		chnls = make(map[int]map[string]string, len(out.Channels))
+		for _, c := range out.Channels {
+			chnls[int(c.Channel)] = c.Params
+		}
+	}
+	return NewAudioOutput(out.Driver, out.Params, chnls)
+}
+
+func paramsToMap(params []repo.Param[string]) map[string]string {
+	res := make(map[string]string, len(params))
+	for _, p := range params {
+		res[p.Name] = p.Value
+	}
+	return res
+}
@@ -0,0 +1,74 @@
+package audio
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/raspidrum-srv/internal/pkg/grpc"
+	"github.com/raspidrum-srv/internal/repo"
+)
+
+type fakeDriversSampler struct {
+	repo.SamplerRepo
+}
+
+func (f *fakeDriversSampler) GetAudioOutputDrivers() ([]repo.AudioDriver, error) {
+	return []repo.AudioDriver{{Name: "ALSA"}, {Name: "JACK"}}, nil
+}
+
+func TestAudioStatusErr(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{fmt.Errorf("%w: empty driver", ErrInvalidAudioOutput), codes.InvalidArgument},
+		{fmt.Errorf("failed"), codes.Internal},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, status.Code(audioStatusErr("failed", tt.err)), tt.err.Error())
+	}
+}
+
+func TestConvertOutput(t *testing.T) {
+	out := repo.AudioOutput{
+		Driver: "JACK",
+		Params: []repo.Param[string]{{Name: "SAMPLERATE", Value: "48000"}},
+		Channels: map[int][]repo.Param[string]{
+			0: {{Name: "JACK_BINDINGS", Value: "system:playback_1"}},
+			1: {{Name: "JACK_BINDINGS", Value: "system:playback_2"}},
+		},
+	}
+	got := convertOutputToPb(out)
+	assert.Equal(t, int32(0), got.Channels[0].Channel)
+	assert.Equal(t, int32(1), got.Channels[1].Channel)
+	assert.Equal(t, out, convertOutputToRepo(got))
+}
+
+func TestAudioServer_ApplyAudioOutput(t *testing.T) {
+	settings := NewSettings(repo.AudioOutput{Driver: "ALSA"})
+	var applyErr error
+	var applied []string
+	s := NewAudioServer(&fakeDriversSampler{}, settings, func() error {
+		applied = append(applied, settings.Get().Driver)
+		return applyErr
+	})
+
+	// settings are restored if they aren't applied
+	applyErr = fmt.Errorf("failed reload preset")
+	_, err := s.ApplyAudioOutput(context.Background(), &pb.ApplyAudioOutputRequest{Output: &pb.AudioOutput{Driver: "JACK"}})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Equal(t, repo.AudioOutput{Driver: "ALSA"}, settings.Get())
+
+	applyErr = nil
+	resp, err := s.ApplyAudioOutput(context.Background(), &pb.ApplyAudioOutputRequest{Output: &pb.AudioOutput{Driver: "JACK"}})
+	require.NoError(t, err)
+	assert.Equal(t, "JACK", resp.Output.Driver)
+	assert.Equal(t, "JACK", settings.Get().Driver)
+	assert.Equal(t, []string{"JACK", "JACK"}, applied)
+}
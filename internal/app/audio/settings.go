@@ -0,0 +1,96 @@
+package audio
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/raspidrum-srv/internal/repo"
+)
+
+// ErrInvalidAudioOutput is returned when driver or parameters of audio output aren't supported by sampler
+var ErrInvalidAudioOutput = errors.New("invalid audio output")
+
+// Settings keeps audio output, which is used on next initialization of sampler
+type Settings struct {
+	mu  sync.Mutex
+	out repo.AudioOutput
+}
+
+func NewSettings(out repo.AudioOutput) *Settings {
+	return &Settings{out: out}
+}
+
+func (s *Settings) Get() repo.AudioOutput {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.out
+}
+
+func (s *Settings) Set(out repo.AudioOutput) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.out = out
+}
+
+// NewAudioOutput builds audio output from parameter maps, i.e. from config.
+// Parameter names are uppercased: lscp parameters are uppercase, but config keys are lowercased by viper
+func NewAudioOutput(driver string, params map[string]string, channels map[int]map[string]string) repo.AudioOutput {
+	out := repo.AudioOutput{
+		Driver: strings.ToUpper(driver),
+		Params: mapToParams(params),
+	}
+	if len(channels) > 0 {
+		out.Channels = make(map[int][]repo.Param[string], len(channels))
+		for k, v := range channels {
+			out.Channels[k] = mapToParams(v)
+		}
+	}
+	return out
+}
+
+// params are sorted by name for stable order of lscp commands
+func mapToParams(m map[string]string) []repo.Param[string] {
+	var res []repo.Param[string]
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		res = append(res, repo.Param[string]{Name: strings.ToUpper(k), Value: m[k]})
+	}
+	return res
+}
+
+// Validate checks that driver is available and device parameters are known by driver.
+// Channel parameters aren't checked: they depend on driver and are known only after device creation
+func Validate(out repo.AudioOutput, drvs []repo.AudioDriver) error {
+	if len(strings.TrimSpace(out.Driver)) == 0 {
+		return fmt.Errorf("%w: empty driver", ErrInvalidAudioOutput)
+	}
+	i := slices.IndexFunc(drvs, func(d repo.AudioDriver) bool { return d.Name == out.Driver })
+	if i < 0 {
+		return fmt.Errorf("%w: driver %s isn't available", ErrInvalidAudioOutput, out.Driver)
+	}
+	drv := drvs[i]
+	for _, p := range out.Params {
+		j := slices.IndexFunc(drv.Params, func(dp repo.DriverParam) bool { return dp.Name == p.Name })
+		if j < 0 {
+			return fmt.Errorf("%w: driver %s hasn't parameter %s", ErrInvalidAudioOutput, drv.Name, p.Name)
+		}
+		dp := drv.Params[j]
+		if !dp.Multiplicity && len(dp.Possibilities) > 0 && !slices.Contains(dp.Possibilities, p.Value) {
+			return fmt.Errorf("%w: value %s of parameter %s isn't one of %v", ErrInvalidAudioOutput, p.Value, p.Name, dp.Possibilities)
+		}
+	}
+	for _, dp := range drv.Params {
+		if dp.Mandatory && dp.Default == nil && !slices.ContainsFunc(out.Params, func(p repo.Param[string]) bool { return p.Name == dp.Name }) {
+			return fmt.Errorf("%w: mandatory parameter %s of driver %s isn't set", ErrInvalidAudioOutput, dp.Name, drv.Name)
+		}
+	}
+	for k := range out.Channels {
+		if k < 0 {
+			return fmt.Errorf("%w: negative audio channel %d", ErrInvalidAudioOutput, k)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package audio
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raspidrum-srv/internal/repo"
+)
+
+func TestNewAudioOutput(t *testing.T) {
+	out := NewAudioOutput("alsa",
+		map[string]string{"samplerate": "44100", "card": "0,0"},
+		map[int]map[string]string{1: {"jack_bindings": "system:playback_2"}},
+	)
+	assert.Equal(t, repo.AudioOutput{
+		Driver: "ALSA",
+		Params: []repo.Param[string]{
+			{Name: "CARD", Value: "0,0"},
+			{Name: "SAMPLERATE", Value: "44100"},
+		},
+		Channels: map[int][]repo.Param[string]{
+			1: {{Name: "JACK_BINDINGS", Value: "system:playback_2"}},
+		},
+	}, out)
+}
+
+func TestValidate(t *testing.T) {
+	dflt := "44100"
+	drvs := []repo.AudioDriver{
+		{
+			Name: "ALSA",
+			Params: []repo.DriverParam{
+				{Name: "CARD", Type: "STRING", Possibilities: []string{"0,0", "1,0"}},
+				{Name: "SAMPLERATE", Type: "INT", Mandatory: true, Default: &dflt},
+			},
+		},
+		{
+			Name: "JACK",
+			Params: []repo.DriverParam{
+				{Name: "NAME", Type: "STRING", Mandatory: true},
+			},
+		},
+	}
+	tests := []struct {
+		name    string
+		out     repo.AudioOutput
+		wantErr bool
+	}{
+		{
+			"valid",
+			repo.AudioOutput{Driver: "ALSA", Params: []repo.Param[string]{{Name: "CARD", Value: "1,0"}}},
+			false,
+		},
+		{
+			"empty driver",
+			repo.AudioOutput{},
+			true,
+		},
+		{
+			"unknown driver",
+			repo.AudioOutput{Driver: "COREAUDIO"},
+			true,
+		},
+		{
+			"unknown parameter",
+			repo.AudioOutput{Driver: "ALSA", Params: []repo.Param[string]{{Name: "FOO", Value: "1"}}},
+			true,
+		},
+		{
+			"value isn't possible",
+			repo.AudioOutput{Driver: "ALSA", Params: []repo.Param[string]{{Name: "CARD", Value: "2,0"}}},
+			true,
+		},
+		{
+			"mandatory parameter without default",
+			repo.AudioOutput{Driver: "JACK"},
+			true,
+		},
+		{
+			"negative channel",
+			repo.AudioOutput{Driver: "ALSA", Channels: map[int][]repo.Param[string]{-1: nil}},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.out, drvs)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidAudioOutput)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package library
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/raspidrum-srv/internal/model"
+	pb "github.com/raspidrum-srv/internal/pkg/grpc"
+	d "github.com/raspidrum-srv/internal/repo/db"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
+
+type LibraryServer struct {
+	pb.UnimplementedLibraryServer
+	db *d.Sqlite
+}
+
+func NewLibraryServer(db *d.Sqlite) *LibraryServer {
+	return &LibraryServer{
+		db: db,
+	}
+}
+
+func (s *LibraryServer) ListKits(ctx context.Context, req *pb.ListKitsRequest) (*pb.ListKitsResponse, error) {
+	page, err := pageBounds(req.Page)
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.db.CountKits()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list kits: %v", err)
+	}
+	kits, err := s.db.ListKitsPage(page)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list kits: %v", err)
+	}
+	res := &pb.ListKitsResponse{
+		Kits: make([]*pb.KitInfo, 0, len(*kits)),
+		Page: makePage(total, page),
+	}
+	for _, k := range *kits {
+		res.Kits = append(res.Kits, convertKitToProto(&k))
+	}
+	return res, nil
+}
+
+func (s *LibraryServer) ListInstruments(ctx context.Context, req *pb.ListInstrumentsRequest) (*pb.ListInstrumentsResponse, error) {
+	page, err := pageBounds(req.Page)
+	if err != nil {
+		return nil, err
+	}
+	conds := []d.Condition{}
+	if req.KitId != nil {
+		conds = append(conds, d.ByKitId(req.GetKitId()))
+	}
+	if req.Type != nil {
+		conds = append(conds, d.ByType(req.GetType()))
+	}
+	if req.Subtype != nil {
+		conds = append(conds, d.BySubtype(req.GetSubtype()))
+	}
+	if req.Tag != nil {
+		conds = append(conds, d.ByInstrumentTag(req.GetTag()))
+	}
+	total, err := s.db.CountInstruments(conds...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list instruments: %v", err)
+	}
+	instrs, err := s.db.ListInstrumentsPage(page, conds...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list instruments: %v", err)
+	}
+	res := &pb.ListInstrumentsResponse{
+		Instruments: make([]*pb.InstrumentInfo, 0, len(*instrs)),
+		Page:        makePage(total, page),
+	}
+	for _, i := range *instrs {
+		res.Instruments = append(res.Instruments, convertInstrumentToProto(&i))
+	}
+	return res, nil
+}
+
+func (s *LibraryServer) ListPresets(ctx context.Context, req *pb.ListPresetsRequest) (*pb.ListPresetsResponse, error) {
+	page, err := pageBounds(req.Page)
+	if err != nil {
+		return nil, err
+	}
+	conds := []d.Condition{}
+	if req.KitId != nil {
+		conds = append(conds, d.ByKitId(req.GetKitId()))
+	}
+	total, err := s.db.CountPresets(conds...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list presets: %v", err)
+	}
+	psts, err := s.db.ListPresetsPage(page, conds...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list presets: %v", err)
+	}
+	res := &pb.ListPresetsResponse{
+		Presets: make([]*pb.PresetInfo, 0, len(*psts)),
+		Page:    makePage(total, page),
+	}
+	for _, p := range *psts {
+		res.Presets = append(res.Presets, convertPresetToProto(&p))
+	}
+	return res, nil
+}
+
+// pageBounds returns requested range of items. Page token is offset of first item
+func pageBounds(req *pb.PageRequest) (d.Page, error) {
+	size := int(req.GetPageSize())
+	switch {
+	case size < 0:
+		return d.Page{}, status.Errorf(codes.InvalidArgument, "negative page size: %d", size)
+	case size == 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+	var from int
+	if token := req.GetPageToken(); len(token) > 0 {
+		var err error
+		from, err = strconv.Atoi(token)
+		if err != nil || from < 0 {
+			return d.Page{}, status.Errorf(codes.InvalidArgument, "invalid page token: %s", token)
+		}
+	}
+	return d.Page{Offset: from, Limit: size}, nil
+}
+
+// makePage makes page info by total count of items
+func makePage(total int, page d.Page) *pb.PageResponse {
+	res := &pb.PageResponse{TotalSize: int32(total)}
+	if to := page.Offset + page.Limit; to < total {
+		res.NextPageToken = strconv.Itoa(to)
+	}
+	return res
+}
+
+func convertKitToProto(kit *model.Kit) *pb.KitInfo {
+	return &pb.KitInfo{
+		Id:       kit.Id,
+		Key:      kit.Uid,
+		Name:     kit.Name,
+		IsCustom: kit.IsCustom,
+		Tags:     kit.Tags,
+		Credits: &pb.Credits{
+			Description: makeStringPtr(kit.Description),
+			Copyright:   makeStringPtr(kit.Copyright),
+			Licence:     makeStringPtr(kit.Licence),
+			Credits:     makeStringPtr(kit.Credits),
+			Url:         makeStringPtr(kit.Url),
+		},
+	}
+}
+
+func convertInstrumentToProto(instr *model.Instrument) *pb.InstrumentInfo {
+	return &pb.InstrumentInfo{
+		Id:       instr.Id,
+		Key:      instr.Uid,
+		SfzKey:   instr.Key,
+		Name:     instr.Name,
+		FullName: makeStringPtr(instr.FullName),
+		Type:     instr.Type,
+		Subtype:  instr.SubType,
+		MidiKey:  makeStringPtr(instr.MidiKey),
+		Tags:     instr.Tags,
+		Credits: &pb.Credits{
+			Description: makeStringPtr(instr.Description),
+			Copyright:   makeStringPtr(instr.Copyright),
+			Licence:     makeStringPtr(instr.Licence),
+			Credits:     makeStringPtr(instr.Credits),
+		},
+	}
+}
+
+func convertPresetToProto(pst *model.KitPreset) *pb.PresetInfo {
+	return &pb.PresetInfo{
+		Id:          pst.Id,
+		Key:         pst.Uid,
+		Name:        pst.Name,
+		KitId:       pst.Kit.Id,
+		KitKey:      pst.Kit.Uid,
+		KitName:     pst.Kit.Name,
+		KitIsCustom: pst.Kit.IsCustom,
+	}
+}
+
+// returns nil for empty string
+func makeStringPtr(v string) *string {
+	if len(v) == 0 {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,112 @@
+package library
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/raspidrum-srv/internal/model"
+	pb "github.com/raspidrum-srv/internal/pkg/grpc"
+	d "github.com/raspidrum-srv/internal/repo/db"
+)
+
+func TestPaging(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *pb.PageRequest
+		total    int
+		wantPage d.Page
+		wantResp *pb.PageResponse
+		wantCode codes.Code
+	}{
+		{
+			name:     "default page size",
+			req:      nil,
+			total:    60,
+			wantPage: d.Page{Offset: 0, Limit: 50},
+			wantResp: &pb.PageResponse{NextPageToken: "50", TotalSize: 60},
+		},
+		{
+			name:     "last page",
+			req:      &pb.PageRequest{PageSize: 20, PageToken: "40"},
+			total:    60,
+			wantPage: d.Page{Offset: 40, Limit: 20},
+			wantResp: &pb.PageResponse{TotalSize: 60},
+		},
+		{
+			name:     "partial last page",
+			req:      &pb.PageRequest{PageSize: 20, PageToken: "50"},
+			total:    60,
+			wantPage: d.Page{Offset: 50, Limit: 20},
+			wantResp: &pb.PageResponse{TotalSize: 60},
+		},
+		{
+			name:     "token beyond total",
+			req:      &pb.PageRequest{PageSize: 20, PageToken: "100"},
+			total:    60,
+			wantPage: d.Page{Offset: 100, Limit: 20},
+			wantResp: &pb.PageResponse{TotalSize: 60},
+		},
+		{
+			name:     "page size is limited",
+			req:      &pb.PageRequest{PageSize: 5000},
+			total:    1500,
+			wantPage: d.Page{Offset: 0, Limit: 1000},
+			wantResp: &pb.PageResponse{NextPageToken: "1000", TotalSize: 1500},
+		},
+		{
+			name:     "invalid token",
+			req:      &pb.PageRequest{PageToken: "abc"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "negative token",
+			req:      &pb.PageRequest{PageToken: "-1"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "negative page size",
+			req:      &pb.PageRequest{PageSize: -1},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := pageBounds(tt.req)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantPage, page)
+			resp := makePage(tt.total, page)
+			assert.True(t, proto.Equal(tt.wantResp, resp), "page = %v, want %v", resp, tt.wantResp)
+		})
+	}
+}
+
+func TestConvertKitToProto(t *testing.T) {
+	kit := &model.Kit{
+		Id:       1,
+		Uid:      "kit-1",
+		Name:     "SMDrums",
+		IsCustom: false,
+		Licence:  "CC BY 4.0",
+		Credits:  "SM Drums",
+		Tags:     []string{"Ludwig", "Zildjian"},
+	}
+	licence := "CC BY 4.0"
+	credits := "SM Drums"
+	want := &pb.KitInfo{
+		Id:      1,
+		Key:     "kit-1",
+		Name:    "SMDrums",
+		Tags:    []string{"Ludwig", "Zildjian"},
+		Credits: &pb.Credits{Licence: &licence, Credits: &credits},
+	}
+	got := convertKitToProto(kit)
+	assert.True(t, proto.Equal(want, got), "got = %v, want %v", got, want)
+}
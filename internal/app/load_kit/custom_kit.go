@@ -0,0 +1,45 @@
+package loadkit
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+
+	m "github.com/raspidrum-srv/internal/model"
+	db "github.com/raspidrum-srv/internal/repo/db"
+)
+
+// ErrInvalidKit is returned when custom kit definition is incomplete
+var ErrInvalidKit = errors.New("invalid kit")
+
+// CreateCustomKit stores custom kit, which is assembled from instruments of stock kits.
+// Instruments are chosen by uid and are only linked with the kit: their sfz-files and samples
+// stay in place of the stock kit, so presets of custom kit use the same instrument files.
+// Fails with db.ErrNotFound if any of instruments doesn't exist
+func CreateCustomKit(kit *m.Kit, instrUids []string, d *db.Sqlite) (kitId int64, err error) {
+	if len(strings.TrimSpace(kit.Name)) == 0 {
+		return 0, fmt.Errorf("%w: empty name", ErrInvalidKit)
+	}
+	if len(instrUids) == 0 {
+		return 0, fmt.Errorf("%w: no instruments", ErrInvalidKit)
+	}
+	kit.IsCustom = true
+	if err = assignUids(kit, nil); err != nil {
+		return 0, err
+	}
+
+	err = d.RunInTx(func(tx *sqlx.Tx) error {
+		kitId, err = d.StoreKit(tx, kit)
+		if err != nil {
+			return err
+		}
+		return d.LinkInstruments(tx, kitId, instrUids)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed create custom kit: %w", err)
+	}
+	kit.Id = kitId
+	return kitId, nil
+}
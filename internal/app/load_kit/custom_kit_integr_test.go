@@ -0,0 +1,79 @@
+//go:build integration
+
+package loadkit
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	m "github.com/raspidrum-srv/internal/model"
+	db "github.com/raspidrum-srv/internal/repo/db"
+)
+
+func TestCreateCustomKit(t *testing.T) {
+	d := getTempDB(t)
+	psts, err := d.ListPresets()
+	require.NoError(t, err)
+	pst, err := d.GetPreset(db.ById((*psts)[0].Id))
+	require.NoError(t, err)
+	stockKitId := pst.Kit.Id
+	stockInstrs, err := d.ListInstruments(db.ByKitId(stockKitId))
+	require.NoError(t, err)
+	uids := make([]string, len(pst.Instruments))
+	for i, v := range pst.Instruments {
+		uids[i] = v.Instrument.Uid
+	}
+
+	kit := &m.Kit{Name: "custom test kit", Tags: []string{"custom"}}
+	kitId, err := CreateCustomKit(kit, uids, d)
+	require.NoError(t, err)
+	assert.NotEmpty(t, kit.Uid)
+
+	kits, err := d.ListKits()
+	require.NoError(t, err)
+	for _, k := range *kits {
+		assert.Equal(t, k.Id == kitId, k.IsCustom, k.Name)
+	}
+	instrs, err := d.ListInstruments(db.ByKitId(kitId))
+	require.NoError(t, err)
+	assert.Len(t, *instrs, len(uids))
+
+	// preset of custom kit refers to instruments of stock kit
+	pst.Id = 0
+	pst.Uid = "custom-kit-preset"
+	pst.Kit = m.KitRef{Uid: kit.Uid}
+	pstId, err := d.StorePreset(nil, pst)
+	require.NoError(t, err)
+	got, err := d.GetPreset(db.ById(pstId))
+	require.NoError(t, err)
+	assert.Equal(t, kitId, got.Kit.Id)
+	for i, v := range got.Instruments {
+		assert.Equal(t, pst.Instruments[i].Instrument.Uid, v.Instrument.Uid)
+	}
+
+	// instruments stay with stock kit
+	require.NoError(t, DeleteKit(kitId, "/data", d, afero.NewMemMapFs()))
+	instrs, err = d.ListInstruments(db.ByKitId(stockKitId))
+	require.NoError(t, err)
+	assert.Len(t, *instrs, len(*stockInstrs))
+}
+
+func TestCreateCustomKit_Invalid(t *testing.T) {
+	d := getTempDB(t)
+
+	_, err := CreateCustomKit(&m.Kit{Name: "custom test kit"}, []string{"missing-uid"}, d)
+	assert.ErrorIs(t, err, db.ErrNotFound)
+	_, err = CreateCustomKit(&m.Kit{Name: "custom test kit"}, nil, d)
+	assert.ErrorIs(t, err, ErrInvalidKit)
+	_, err = CreateCustomKit(&m.Kit{Name: " "}, []string{"missing-uid"}, d)
+	assert.ErrorIs(t, err, ErrInvalidKit)
+
+	kits, err := d.ListKits()
+	require.NoError(t, err)
+	for _, k := range *kits {
+		assert.NotEqual(t, "custom test kit", k.Name)
+	}
+}
@@ -0,0 +1,473 @@
+package loadkit
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	u "github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/spf13/afero"
+
+	m "github.com/raspidrum-srv/internal/model"
+	db "github.com/raspidrum-srv/internal/repo/db"
+	f "github.com/raspidrum-srv/internal/repo/file"
+)
+
+/*
+Kit archive is tar (optionally gzipped) or zip with layout:
+
+	*.yaml                  kit file and instrument files
+	instruments/<key>.sfz   main sfz file of instrument
+	instruments/<key>/      optional files included by main sfz file
+	samples/<key>/          samples of instrument
+
+All files may be placed in one root directory of archive.
+Instrument files are installed to data dir:
+
+	instruments/<uid>/<key>.sfz
+	samples/<uid>/<key>/
+*/
+
+// TODO: move to cfg
+var instrumentRoot = "instruments"
+var sampleRoot = "samples"
+var importRoot = ".import"
+var dirPermission os.FileMode = os.ModePerm
+
+// report receiving progress after each chunk of bytes
+var receiveProgressStep int64 = 1 << 20
+
+// ErrInvalidArchive is returned when archive has unsupported format or content
+var ErrInvalidArchive = errors.New("invalid kit archive")
+
+// ImportLimits caps unpacked content of kit archive. Zero limit means no limit
+type ImportLimits struct {
+	// total size of unpacked files in bytes
+	TotalSize int64
+	// size of unpacked file in bytes
+	FileSize int64
+	// count of archive entries
+	Entries int64
+}
+
+// DefaultImportLimits are used by KitServer, if limits aren't set
+var DefaultImportLimits = ImportLimits{
+	TotalSize: 8 << 30,
+	FileSize:  1 << 30,
+	Entries:   100000,
+}
+
+var (
+	zipMagic  = []byte("PK\x03\x04")
+	gzipMagic = []byte{0x1f, 0x8b}
+)
+
+type ImportStage int
+
+const (
+	StageReceive ImportStage = iota + 1
+	StageUnpack
+	StageParse
+	StageStore
+	StageDone
+)
+
+// Done and Total are bytes for receive stage, files for unpack stage and instruments for store stage.
+// Total is 0 if unknown
+type ImportProgress struct {
+	Stage ImportStage
+	Done  int64
+	Total int64
+}
+
+type ProgressFunc func(ImportProgress)
+
+// ImportKitArchive unpacks kit archive, installs instrument files to dataDir and stores kit to db.
+// Size of archive is used only for progress and may be 0.
+// Archive exceeding limits is rejected with ErrInvalidArchive.
+// Returns stored kit. On failure installed files and stored db rows are removed
+func ImportKitArchive(archive io.Reader, size int64, limits ImportLimits, dataDir string, d *db.Sqlite, fs afero.Fs, progress ProgressFunc) (kit *m.Kit, err error) {
+	if progress == nil {
+		progress = func(ImportProgress) {}
+	}
+
+	stageDir, err := makeStageDir(dataDir, fs)
+	if err != nil {
+		return nil, err
+	}
+	defer fs.RemoveAll(stageDir)
+
+	archFile := path.Join(stageDir, "archive")
+	archSize, err := receiveArchive(archive, size, archFile, fs, progress)
+	if err != nil {
+		return nil, err
+	}
+
+	unpackDir := path.Join(stageDir, "unpacked")
+	if err = unpackArchive(archFile, archSize, unpackDir, limits, fs, progress); err != nil {
+		return nil, err
+	}
+	root, err := archiveRoot(unpackDir, fs)
+	if err != nil {
+		return nil, err
+	}
+
+	progress(ImportProgress{Stage: StageParse})
+	kit, instrs, err := parseKitFiles(root, fs)
+	if err != nil {
+		return nil, err
+	}
+	if err = assignUids(kit, instrs); err != nil {
+		return nil, err
+	}
+
+	progress(ImportProgress{Stage: StageStore, Total: int64(len(instrs))})
+	var installed []string
+	err = d.RunInTx(func(tx *sqlx.Tx) error {
+		kit.Id, err = storeKit(tx, d, kit, instrs)
+		if err != nil {
+			return err
+		}
+		installed, err = installInstrumentFiles(root, dataDir, instrs, fs, progress)
+		return err
+	})
+	if err != nil {
+		for _, dir := range installed {
+			fs.RemoveAll(dir)
+		}
+		return nil, err
+	}
+
+	progress(ImportProgress{Stage: StageDone})
+	return kit, nil
+}
+
+func makeStageDir(dataDir string, fs afero.Fs) (string, error) {
+	uuid, err := u.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("failed gen name for import dir: %w", err)
+	}
+	dir := path.Join(dataDir, importRoot, uuid.String())
+	if err := fs.MkdirAll(dir, dirPermission); err != nil {
+		return "", fmt.Errorf("failed create import dir: %w", err)
+	}
+	return dir, nil
+}
+
+// receiveArchive writes archive to file. Returns size of archive
+func receiveArchive(archive io.Reader, size int64, fname string, fs afero.Fs, progress ProgressFunc) (int64, error) {
+	file, err := fs.Create(fname)
+	if err != nil {
+		return 0, fmt.Errorf("failed create archive file: %w", err)
+	}
+	defer file.Close()
+
+	pw := &progressWriter{w: file, total: size, progress: progress}
+	n, err := io.Copy(pw, archive)
+	if err != nil {
+		return n, fmt.Errorf("failed receive archive: %w", err)
+	}
+	progress(ImportProgress{Stage: StageReceive, Done: n, Total: size})
+	return n, nil
+}
+
+type progressWriter struct {
+	w        io.Writer
+	done     int64
+	reported int64
+	total    int64
+	progress ProgressFunc
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.done += int64(n)
+	if p.done-p.reported >= receiveProgressStep {
+		p.reported = p.done
+		p.progress(ImportProgress{Stage: StageReceive, Done: p.done, Total: p.total})
+	}
+	return n, err
+}
+
+// unpackArchive detects format of archive by content and unpacks it to dst dir
+func unpackArchive(fname string, size int64, dst string, limits ImportLimits, fs afero.Fs, progress ProgressFunc) error {
+	file, err := fs.Open(fname)
+	if err != nil {
+		return fmt.Errorf("failed open archive: %w", err)
+	}
+	defer file.Close()
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed read archive: %w", err)
+	}
+	head = head[:n]
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed read archive: %w", err)
+	}
+
+	lim := &unpackLimiter{limits: limits}
+	switch {
+	case bytes.HasPrefix(head, zipMagic):
+		return unpackZip(file, size, dst, lim, fs, progress)
+	case bytes.HasPrefix(head, gzipMagic):
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+		}
+		defer gz.Close()
+		return unpackTar(gz, dst, lim, fs, progress)
+	case len(head) > 262 && string(head[257:262]) == "ustar":
+		return unpackTar(file, dst, lim, fs, progress)
+	default:
+		return fmt.Errorf("%w: unknown archive format. Must be tar or zip", ErrInvalidArchive)
+	}
+}
+
+func unpackTar(r io.Reader, dst string, lim *unpackLimiter, fs afero.Fs, progress ProgressFunc) error {
+	tr := tar.NewReader(r)
+	var cnt int64
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+		}
+		if err = lim.entry(); err != nil {
+			return err
+		}
+		name, skip, err := entryPath(hdr.Name)
+		if err != nil {
+			return err
+		}
+		if skip {
+			continue
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			err = fs.MkdirAll(path.Join(dst, name), dirPermission)
+		case tar.TypeReg:
+			err = writeFile(fs, path.Join(dst, name), lim.reader(hdr.Name, tr))
+		case tar.TypeXGlobalHeader:
+			continue
+		default:
+			return fmt.Errorf("%w: unsupported type of entry: %s", ErrInvalidArchive, hdr.Name)
+		}
+		if err != nil {
+			return err
+		}
+		cnt++
+		progress(ImportProgress{Stage: StageUnpack, Done: cnt})
+	}
+}
+
+func unpackZip(r io.ReaderAt, size int64, dst string, lim *unpackLimiter, fs afero.Fs, progress ProgressFunc) error {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	total := int64(len(zr.File))
+	for i, zf := range zr.File {
+		if err = lim.entry(); err != nil {
+			return err
+		}
+		name, skip, err := entryPath(zf.Name)
+		if err != nil {
+			return err
+		}
+		if skip {
+			continue
+		}
+		mode := zf.Mode()
+		switch {
+		case mode.IsDir():
+			err = fs.MkdirAll(path.Join(dst, name), dirPermission)
+		case mode.IsRegular():
+			err = unpackZipFile(zf, path.Join(dst, name), lim, fs)
+		default:
+			return fmt.Errorf("%w: unsupported type of entry: %s", ErrInvalidArchive, zf.Name)
+		}
+		if err != nil {
+			return err
+		}
+		progress(ImportProgress{Stage: StageUnpack, Done: int64(i + 1), Total: total})
+	}
+	return nil
+}
+
+func unpackZipFile(zf *zip.File, fname string, lim *unpackLimiter, fs afero.Fs) error {
+	rc, err := zf.Open()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	defer rc.Close()
+	return writeFile(fs, fname, lim.reader(zf.Name, rc))
+}
+
+// unpackLimiter counts unpacked entries and bytes of archive.
+// Sizes are counted by read content, so sizes in headers of archive aren't trusted
+type unpackLimiter struct {
+	limits  ImportLimits
+	entries int64
+	size    int64
+}
+
+func (l *unpackLimiter) entry() error {
+	l.entries++
+	if l.limits.Entries > 0 && l.entries > l.limits.Entries {
+		return fmt.Errorf("%w: more than %d entries", ErrInvalidArchive, l.limits.Entries)
+	}
+	return nil
+}
+
+// reader returns reader of entry content, which fails when limit of size is exceeded
+func (l *unpackLimiter) reader(name string, r io.Reader) io.Reader {
+	return &limitedEntry{r: r, name: name, lim: l}
+}
+
+type limitedEntry struct {
+	r    io.Reader
+	name string
+	size int64
+	lim  *unpackLimiter
+}
+
+func (e *limitedEntry) Read(b []byte) (int, error) {
+	n, err := e.r.Read(b)
+	e.size += int64(n)
+	e.lim.size += int64(n)
+	if limit := e.lim.limits.FileSize; limit > 0 && e.size > limit {
+		return n, fmt.Errorf("%w: size of entry %s exceeds %d bytes", ErrInvalidArchive, e.name, limit)
+	}
+	if limit := e.lim.limits.TotalSize; limit > 0 && e.lim.size > limit {
+		return n, fmt.Errorf("%w: unpacked size exceeds %d bytes", ErrInvalidArchive, limit)
+	}
+	return n, err
+}
+
+// entryPath returns cleaned path of archive entry.
+// Returns error for path outside of archive and skip for service entries
+func entryPath(name string) (res string, skip bool, err error) {
+	res = path.Clean(strings.TrimPrefix(name, "./"))
+	if res == "." {
+		return res, true, nil
+	}
+	if !filepath.IsLocal(filepath.FromSlash(res)) {
+		return res, false, fmt.Errorf("%w: unsafe path of entry: %s", ErrInvalidArchive, name)
+	}
+	// metadata of archives made on macOS
+	if res == "__MACOSX" || strings.HasPrefix(res, "__MACOSX/") {
+		return res, true, nil
+	}
+	return res, false, nil
+}
+
+func writeFile(fs afero.Fs, fname string, r io.Reader) error {
+	if err := fs.MkdirAll(path.Dir(fname), dirPermission); err != nil {
+		return fmt.Errorf("failed create dir for file %s: %w", fname, err)
+	}
+	file, err := fs.Create(fname)
+	if err != nil {
+		return fmt.Errorf("failed create file %s: %w", fname, err)
+	}
+	defer file.Close()
+	if _, err := io.Copy(file, r); err != nil {
+		return fmt.Errorf("failed write file %s: %w", fname, err)
+	}
+	return nil
+}
+
+// archiveRoot returns dir with kit files. It's unpacked dir or the single root dir of archive
+func archiveRoot(dir string, fs afero.Fs) (string, error) {
+	entries, err := afero.ReadDir(fs, dir)
+	if err != nil {
+		return "", fmt.Errorf("failed read unpacked archive: %w", err)
+	}
+	if len(entries) == 1 && entries[0].IsDir() && entries[0].Name() != instrumentRoot && entries[0].Name() != sampleRoot {
+		return path.Join(dir, entries[0].Name()), nil
+	}
+	return dir, nil
+}
+
+// parseKitFiles parses kit and instrument files and checks that each instrument has sfz file and samples
+func parseKitFiles(root string, fs afero.Fs) (kit *m.Kit, instrs []*m.Instrument, err error) {
+	items, err := f.ParseYAMLDirFs(root, fs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	kit, instrs, err = splitKitItems(items)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+
+	missing := []string{}
+	for _, instr := range instrs {
+		if len(instr.Key) == 0 {
+			missing = append(missing, fmt.Sprintf("key of instrument: %s", instr.Name))
+			continue
+		}
+		sfz := path.Join(root, instrumentRoot, instr.Key+".sfz")
+		if ok, _ := afero.Exists(fs, sfz); !ok {
+			missing = append(missing, path.Join(instrumentRoot, instr.Key+".sfz"))
+		}
+		smpl := path.Join(root, sampleRoot, instr.Key)
+		if ok, _ := afero.DirExists(fs, smpl); !ok {
+			missing = append(missing, path.Join(sampleRoot, instr.Key))
+		}
+	}
+	if len(missing) > 0 {
+		return nil, nil, fmt.Errorf("%w: missing %s", ErrInvalidArchive, strings.Join(missing, ", "))
+	}
+	return kit, instrs, nil
+}
+
+// installInstrumentFiles moves instrument files from unpacked archive to data dir.
+// Returns created dirs. On failure returned dirs must be removed
+func installInstrumentFiles(root, dataDir string, instrs []*m.Instrument, fs afero.Fs, progress ProgressFunc) (installed []string, err error) {
+	for i, instr := range instrs {
+		instrDir := path.Join(dataDir, instrumentRoot, instr.Uid)
+		smplDir := path.Join(dataDir, sampleRoot, instr.Uid)
+		for _, dir := range []string{instrDir, smplDir} {
+			if ok, _ := afero.Exists(fs, dir); ok {
+				return installed, fmt.Errorf("files of instrument %s: %s: %w", instr.Key, dir, iofs.ErrExist)
+			}
+		}
+
+		if err = fs.MkdirAll(instrDir, dirPermission); err != nil {
+			return installed, fmt.Errorf("failed create dir %s: %w", instrDir, err)
+		}
+		installed = append(installed, instrDir)
+		src := path.Join(root, instrumentRoot, instr.Key)
+		if err = fs.Rename(src+".sfz", path.Join(instrDir, instr.Key+".sfz")); err != nil {
+			return installed, fmt.Errorf("failed install sfz of instrument %s: %w", instr.Key, err)
+		}
+		if ok, _ := afero.DirExists(fs, src); ok {
+			if err = fs.Rename(src, path.Join(instrDir, instr.Key)); err != nil {
+				return installed, fmt.Errorf("failed install sfz of instrument %s: %w", instr.Key, err)
+			}
+		}
+
+		if err = fs.MkdirAll(smplDir, dirPermission); err != nil {
+			return installed, fmt.Errorf("failed create dir %s: %w", smplDir, err)
+		}
+		installed = append(installed, smplDir)
+		if err = fs.Rename(path.Join(root, sampleRoot, instr.Key), path.Join(smplDir, instr.Key)); err != nil {
+			return installed, fmt.Errorf("failed install samples of instrument %s: %w", instr.Key, err)
+		}
+		progress(ImportProgress{Stage: StageStore, Done: int64(i + 1), Total: int64(len(instrs))})
+	}
+	return installed, nil
+}
@@ -0,0 +1,84 @@
+//go:build integration
+
+package loadkit
+
+import (
+	"bytes"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	db "github.com/raspidrum-srv/internal/repo/db"
+)
+
+// copy of project db in temp dir
+func getTempDB(t *testing.T) *db.Sqlite {
+	t.Helper()
+	dir := t.TempDir()
+	cont, err := os.ReadFile(path.Join(getDBPath(), "kits.sqlite3"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path.Join(dir, "kits.sqlite3"), cont, 0644))
+	d, err := db.NewSqlite(dir)
+	require.NoError(t, err)
+	t.Cleanup(func() { d.Close() })
+	return d
+}
+
+func TestImportKitArchive(t *testing.T) {
+	d := getTempDB(t)
+	fs := afero.NewMemMapFs()
+	archive := makeZip(t, testKitFiles("TestKit/"))
+
+	kit, err := ImportKitArchive(bytes.NewReader(archive), int64(len(archive)), DefaultImportLimits, "/data", d, fs, nil)
+	require.NoError(t, err)
+
+	instrs, err := d.ListInstruments(db.ByKitId(kit.Id))
+	require.NoError(t, err)
+	require.Len(t, *instrs, 1)
+	uid := (*instrs)[0].Uid
+	for _, name := range []string{
+		path.Join("/data/instruments", uid, "snare.sfz"),
+		path.Join("/data/samples", uid, "snare/hit1.wav"),
+	} {
+		ok, _ := afero.Exists(fs, name)
+		assert.True(t, ok, name)
+	}
+	// import dir is removed
+	entries, _ := afero.ReadDir(fs, path.Join("/data", importRoot))
+	assert.Empty(t, entries)
+
+	// same kit name
+	_, err = ImportKitArchive(bytes.NewReader(archive), int64(len(archive)), DefaultImportLimits, "/data", d, fs, nil)
+	assert.ErrorIs(t, err, db.ErrAlreadyExists)
+}
+
+func TestImportKitArchive_Rollback(t *testing.T) {
+	d := getTempDB(t)
+	fs := afero.NewMemMapFs()
+	files := testKitFiles("")
+	// second instrument fails on install: its files already exist
+	files["tom.yaml"] = "instrument:\n  uuid: tom-uid\n  key: tom\n  name: Tom\n  type: tom\n  subtype: tom1\n"
+	files["instruments/tom.sfz"] = "<group>"
+	files["samples/tom/hit1.wav"] = "wav"
+	require.NoError(t, afero.WriteFile(fs, "/data/samples/tom-uid/tom/old.wav", []byte("wav"), 0644))
+	archive := makeTar(t, files, false)
+
+	kits, err := d.ListKits()
+	require.NoError(t, err)
+	_, err = ImportKitArchive(bytes.NewReader(archive), 0, DefaultImportLimits, "/data", d, fs, nil)
+	require.Error(t, err)
+
+	// db rows are rolled back
+	got, err := d.ListKits()
+	require.NoError(t, err)
+	assert.Len(t, *got, len(*kits))
+	// installed files are removed, existing files are kept
+	entries, _ := afero.ReadDir(fs, "/data/instruments")
+	assert.Empty(t, entries)
+	ok, _ := afero.Exists(fs, "/data/samples/tom-uid/tom/old.wav")
+	assert.True(t, ok)
+}
@@ -0,0 +1,263 @@
+package loadkit
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"path"
+	"sort"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	m "github.com/raspidrum-srv/internal/model"
+)
+
+const testKitYaml = `kit:
+  name: Test Kit
+`
+
+const testInstrYaml = `instrument:
+  key: snare
+  name: Snare
+  type: snare
+  subtype: snare
+`
+
+func testKitFiles(prefix string) map[string]string {
+	return map[string]string{
+		prefix + "kit.yaml":                  testKitYaml,
+		prefix + "snare.yaml":                testInstrYaml,
+		prefix + "instruments/snare.sfz":     "<group>",
+		prefix + "instruments/snare/inc.sfz": "<region>",
+		prefix + "samples/snare/hit1.wav":    "wav",
+	}
+}
+
+func makeTar(t *testing.T, files map[string]string, gz bool) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	var tw *tar.Writer
+	var zw *gzip.Writer
+	if gz {
+		zw = gzip.NewWriter(&buf)
+		tw = tar.NewWriter(zw)
+	} else {
+		tw = tar.NewWriter(&buf)
+	}
+	for _, name := range sortedKeys(files) {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(files[name])), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(files[name]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	if zw != nil {
+		require.NoError(t, zw.Close())
+	}
+	return buf.Bytes()
+}
+
+func makeZip(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, name := range sortedKeys(files) {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(files[name]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func sortedKeys(files map[string]string) []string {
+	keys := make([]string, 0, len(files))
+	for k := range files {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func unpackTest(t *testing.T, fs afero.Fs, archive []byte) (string, error) {
+	t.Helper()
+	require.NoError(t, afero.WriteFile(fs, "/stage/archive", archive, 0644))
+	var stages []ImportStage
+	err := unpackArchive("/stage/archive", int64(len(archive)), "/stage/unpacked", DefaultImportLimits, fs, func(p ImportProgress) {
+		stages = append(stages, p.Stage)
+	})
+	if err != nil {
+		return "", err
+	}
+	assert.NotEmpty(t, stages)
+	return archiveRoot("/stage/unpacked", fs)
+}
+
+func TestUnpackArchive(t *testing.T) {
+	tests := []struct {
+		name     string
+		archive  func(t *testing.T) []byte
+		wantRoot string
+	}{
+		{
+			name:     "tar",
+			archive:  func(t *testing.T) []byte { return makeTar(t, testKitFiles(""), false) },
+			wantRoot: "/stage/unpacked",
+		},
+		{
+			name:     "tar.gz with root dir",
+			archive:  func(t *testing.T) []byte { return makeTar(t, testKitFiles("./TestKit/"), true) },
+			wantRoot: "/stage/unpacked/TestKit",
+		},
+		{
+			name:     "zip with root dir",
+			archive:  func(t *testing.T) []byte { return makeZip(t, testKitFiles("TestKit/")) },
+			wantRoot: "/stage/unpacked/TestKit",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			root, err := unpackTest(t, fs, tt.archive(t))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRoot, root)
+			for name, cont := range testKitFiles("") {
+				got, err := afero.ReadFile(fs, path.Join(root, name))
+				require.NoError(t, err)
+				assert.Equal(t, cont, string(got))
+			}
+		})
+	}
+}
+
+func TestUnpackArchive_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		archive func(t *testing.T) []byte
+	}{
+		{
+			name:    "unknown format",
+			archive: func(t *testing.T) []byte { return []byte("just text") },
+		},
+		{
+			name:    "tar with path outside",
+			archive: func(t *testing.T) []byte { return makeTar(t, map[string]string{"../evil.sfz": "x"}, false) },
+		},
+		{
+			name:    "zip with absolute path",
+			archive: func(t *testing.T) []byte { return makeZip(t, map[string]string{"/etc/evil": "x"}) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			_, err := unpackTest(t, fs, tt.archive(t))
+			assert.ErrorIs(t, err, ErrInvalidArchive)
+			ok, _ := afero.Exists(fs, "/evil.sfz")
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestUnpackArchive_Limits(t *testing.T) {
+	tests := []struct {
+		name    string
+		archive func(t *testing.T) []byte
+		limits  ImportLimits
+		wantErr string
+	}{
+		{
+			name:    "tar with too many entries",
+			archive: func(t *testing.T) []byte { return makeTar(t, testKitFiles(""), false) },
+			limits:  ImportLimits{Entries: 4},
+			wantErr: "more than 4 entries",
+		},
+		{
+			name:    "zip with too large file",
+			archive: func(t *testing.T) []byte { return makeZip(t, map[string]string{"hit.wav": "0123456789"}) },
+			limits:  ImportLimits{FileSize: 9},
+			wantErr: "size of entry hit.wav exceeds 9 bytes",
+		},
+		{
+			name:    "tar.gz with too large content",
+			archive: func(t *testing.T) []byte { return makeTar(t, testKitFiles(""), true) },
+			limits:  ImportLimits{TotalSize: 40},
+			wantErr: "unpacked size exceeds 40 bytes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			archive := tt.archive(t)
+			require.NoError(t, afero.WriteFile(fs, "/stage/archive", archive, 0644))
+			err := unpackArchive("/stage/archive", int64(len(archive)), "/stage/unpacked", tt.limits, fs, func(ImportProgress) {})
+			assert.ErrorIs(t, err, ErrInvalidArchive)
+			assert.ErrorContains(t, err, tt.wantErr)
+			assert.Equal(t, codes.InvalidArgument, status.Code(importStatusErr(err)))
+
+			// archive within limits
+			err = unpackArchive("/stage/archive", int64(len(archive)), "/stage/unpacked", ImportLimits{}, fs, func(ImportProgress) {})
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestParseKitFiles(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	files := testKitFiles("/root/")
+	delete(files, "/root/samples/snare/hit1.wav")
+	for name, cont := range files {
+		require.NoError(t, afero.WriteFile(fs, name, []byte(cont), 0644))
+	}
+	_, _, err := parseKitFiles("/root", fs)
+	assert.ErrorIs(t, err, ErrInvalidArchive)
+	assert.ErrorContains(t, err, "samples/snare")
+
+	require.NoError(t, afero.WriteFile(fs, "/root/samples/snare/hit1.wav", []byte("wav"), 0644))
+	kit, instrs, err := parseKitFiles("/root", fs)
+	require.NoError(t, err)
+	assert.Equal(t, "Test Kit", kit.Name)
+	require.Len(t, instrs, 1)
+	assert.Equal(t, "snare", instrs[0].Key)
+}
+
+func TestInstallInstrumentFiles(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	for name, cont := range testKitFiles("/stage/") {
+		require.NoError(t, afero.WriteFile(fs, name, []byte(cont), 0644))
+	}
+	instrs := []*m.Instrument{{Uid: "uid-1", Key: "snare"}}
+
+	installed, err := installInstrumentFiles("/stage", "/data", instrs, fs, func(ImportProgress) {})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/data/instruments/uid-1", "/data/samples/uid-1"}, installed)
+	for _, name := range []string{
+		"/data/instruments/uid-1/snare.sfz",
+		"/data/instruments/uid-1/snare/inc.sfz",
+		"/data/samples/uid-1/snare/hit1.wav",
+	} {
+		ok, _ := afero.Exists(fs, name)
+		assert.True(t, ok, name)
+	}
+}
+
+func TestInstallInstrumentFiles_Exists(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	for name, cont := range testKitFiles("/stage/") {
+		require.NoError(t, afero.WriteFile(fs, name, []byte(cont), 0644))
+	}
+	// files of other instrument with same uid
+	require.NoError(t, afero.WriteFile(fs, "/data/samples/uid-1/other/hit.wav", []byte("wav"), 0644))
+	instrs := []*m.Instrument{{Uid: "uid-1", Key: "snare"}}
+
+	installed, err := installInstrumentFiles("/stage", "/data", instrs, fs, func(ImportProgress) {})
+	assert.Error(t, err)
+	// existing dirs aren't reported for removing
+	assert.Empty(t, installed)
+}
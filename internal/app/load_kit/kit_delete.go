@@ -0,0 +1,85 @@
+package loadkit
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/spf13/afero"
+
+	m "github.com/raspidrum-srv/internal/model"
+	db "github.com/raspidrum-srv/internal/repo/db"
+)
+
+// DeleteKit removes kit from db and files of its removed instruments from dataDir
+func DeleteKit(kitId int64, dataDir string, d *db.Sqlite, fs afero.Fs) error {
+	return deleteWithFiles(dataDir, d, fs, func(tx *sqlx.Tx) ([]m.Instrument, error) {
+		return d.DeleteKit(tx, kitId)
+	})
+}
+
+// DeleteInstrument removes instrument from db and its files from dataDir
+func DeleteInstrument(instrId int64, dataDir string, d *db.Sqlite, fs afero.Fs) error {
+	return deleteWithFiles(dataDir, d, fs, func(tx *sqlx.Tx) ([]m.Instrument, error) {
+		instr, err := d.DeleteInstrument(tx, instrId)
+		if err != nil {
+			return nil, err
+		}
+		return []m.Instrument{*instr}, nil
+	})
+}
+
+type movedDir struct {
+	from string
+	to   string
+}
+
+// deleteWithFiles runs del in transaction and removes files of returned instruments.
+// Files are moved to stage dir before commit and are restored if transaction fails
+func deleteWithFiles(dataDir string, d *db.Sqlite, fs afero.Fs, del func(tx *sqlx.Tx) ([]m.Instrument, error)) error {
+	trashDir, err := makeStageDir(dataDir, fs)
+	if err != nil {
+		return err
+	}
+	defer fs.RemoveAll(trashDir)
+
+	var moved []movedDir
+	err = d.RunInTx(func(tx *sqlx.Tx) error {
+		instrs, err := del(tx)
+		if err != nil {
+			return err
+		}
+		moved, err = moveInstrumentFiles(instrs, dataDir, trashDir, fs)
+		return err
+	})
+	if err != nil {
+		// restore files
+		for i := len(moved) - 1; i >= 0; i-- {
+			fs.Rename(moved[i].to, moved[i].from)
+		}
+		return err
+	}
+	return nil
+}
+
+// moveInstrumentFiles moves sfz and sample dirs of instruments to dst dir. Missing dirs are skipped.
+// Returns moved dirs. On failure returned dirs must be moved back
+func moveInstrumentFiles(instrs []m.Instrument, dataDir, dst string, fs afero.Fs) (moved []movedDir, err error) {
+	for _, instr := range instrs {
+		for _, root := range []string{instrumentRoot, sampleRoot} {
+			from := path.Join(dataDir, root, instr.Uid)
+			if ok, _ := afero.DirExists(fs, from); !ok {
+				continue
+			}
+			to := path.Join(dst, root, instr.Uid)
+			if err = fs.MkdirAll(path.Dir(to), dirPermission); err != nil {
+				return moved, fmt.Errorf("failed remove files of instrument %s: %w", instr.Key, err)
+			}
+			if err = fs.Rename(from, to); err != nil {
+				return moved, fmt.Errorf("failed remove files of instrument %s: %w", instr.Key, err)
+			}
+			moved = append(moved, movedDir{from: from, to: to})
+		}
+	}
+	return moved, nil
+}
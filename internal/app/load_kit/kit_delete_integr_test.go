@@ -0,0 +1,55 @@
+//go:build integration
+
+package loadkit
+
+import (
+	"bytes"
+	"path"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	db "github.com/raspidrum-srv/internal/repo/db"
+)
+
+func TestDeleteKit(t *testing.T) {
+	d := getTempDB(t)
+	fs := afero.NewMemMapFs()
+	archive := makeZip(t, testKitFiles(""))
+	kit, err := ImportKitArchive(bytes.NewReader(archive), 0, DefaultImportLimits, "/data", d, fs, nil)
+	require.NoError(t, err)
+	instrs, err := d.ListInstruments(db.ByKitId(kit.Id))
+	require.NoError(t, err)
+	uid := (*instrs)[0].Uid
+
+	require.NoError(t, DeleteKit(kit.Id, "/data", d, fs))
+
+	for _, dir := range []string{
+		path.Join("/data", instrumentRoot, uid),
+		path.Join("/data", sampleRoot, uid),
+		path.Join("/data", importRoot),
+	} {
+		entries, _ := afero.ReadDir(fs, dir)
+		assert.Empty(t, entries, dir)
+	}
+	assert.ErrorIs(t, DeleteKit(kit.Id, "/data", d, fs), db.ErrNotFound)
+}
+
+func TestDeleteInstrument_InUse(t *testing.T) {
+	d := getTempDB(t)
+	fs := afero.NewMemMapFs()
+	psts, err := d.ListPresets()
+	require.NoError(t, err)
+	pst, err := d.GetPreset(db.ById((*psts)[0].Id))
+	require.NoError(t, err)
+	instr := pst.Instruments[0].Instrument
+	sfz := path.Join("/data", instrumentRoot, instr.Uid, instr.Key+".sfz")
+	require.NoError(t, afero.WriteFile(fs, sfz, []byte("<group>"), 0644))
+
+	err = DeleteInstrument(instr.Id, "/data", d, fs)
+	assert.ErrorIs(t, err, db.ErrInUse)
+	ok, _ := afero.Exists(fs, sfz)
+	assert.True(t, ok)
+}
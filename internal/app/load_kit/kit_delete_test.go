@@ -0,0 +1,30 @@
+package loadkit
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	m "github.com/raspidrum-srv/internal/model"
+)
+
+func TestMoveInstrumentFiles(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/data/instruments/uid-1/snare.sfz", []byte("<group>"), 0644))
+	require.NoError(t, afero.WriteFile(fs, "/data/samples/uid-1/snare/hit.wav", []byte("wav"), 0644))
+	instrs := []m.Instrument{{Uid: "uid-1", Key: "snare"}, {Uid: "uid-2", Key: "tom"}}
+
+	moved, err := moveInstrumentFiles(instrs, "/data", "/trash", fs)
+	require.NoError(t, err)
+	// instrument without files is skipped
+	assert.Equal(t, []movedDir{
+		{from: "/data/instruments/uid-1", to: "/trash/instruments/uid-1"},
+		{from: "/data/samples/uid-1", to: "/trash/samples/uid-1"},
+	}, moved)
+	ok, _ := afero.Exists(fs, "/trash/samples/uid-1/snare/hit.wav")
+	assert.True(t, ok)
+	ok, _ = afero.DirExists(fs, "/data/samples/uid-1")
+	assert.False(t, ok)
+}
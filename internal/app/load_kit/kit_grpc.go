@@ -0,0 +1,193 @@
+package loadkit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"log/slog"
+
+	"github.com/spf13/afero"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	m "github.com/raspidrum-srv/internal/model"
+	pb "github.com/raspidrum-srv/internal/pkg/grpc"
+	d "github.com/raspidrum-srv/internal/repo/db"
+)
+
+type KitServer struct {
+	pb.UnimplementedKitServer
+	db      *d.Sqlite
+	dataDir string // root dir for sfz-files and samples
+	fs      afero.Fs
+	limits  ImportLimits
+}
+
+func NewKitServer(db *d.Sqlite, dataDir string, fs afero.Fs) *KitServer {
+	return &KitServer{
+		db:      db,
+		dataDir: dataDir,
+		fs:      fs,
+		limits:  DefaultImportLimits,
+	}
+}
+
+// SetImportLimits caps unpacked content of imported kit archives. Zero limits keep defaults
+func (s *KitServer) SetImportLimits(limits ImportLimits) {
+	if limits.TotalSize > 0 {
+		s.limits.TotalSize = limits.TotalSize
+	}
+	if limits.FileSize > 0 {
+		s.limits.FileSize = limits.FileSize
+	}
+	if limits.Entries > 0 {
+		s.limits.Entries = limits.Entries
+	}
+}
+
+func (s *KitServer) ImportKit(stream grpc.BidiStreamingServer[pb.ImportKitRequest, pb.ImportKitProgress]) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "empty kit archive")
+	}
+	if err != nil {
+		return err
+	}
+
+	var sendErr error
+	progress := func(p ImportProgress) {
+		if sendErr != nil {
+			return
+		}
+		sendErr = stream.Send(&pb.ImportKitProgress{
+			Stage: pb.ImportStage(p.Stage),
+			Done:  p.Done,
+			Total: p.Total,
+		})
+		if sendErr != nil {
+			slog.Warn("failed send kit import progress", slog.Any("error", sendErr))
+		}
+	}
+
+	archive := &chunkReader{stream: stream, buf: first.Chunk}
+	kit, err := ImportKitArchive(archive, first.Size, s.limits, s.dataDir, s.db, s.fs, progress)
+	if err != nil {
+		return importStatusErr(err)
+	}
+	if sendErr != nil {
+		return sendErr
+	}
+	return stream.Send(&pb.ImportKitProgress{
+		Stage:  pb.ImportStage_IMPORT_STAGE_DONE,
+		KitId:  kit.Id,
+		KitKey: kit.Uid,
+	})
+}
+
+func (s *KitServer) DeleteKit(ctx context.Context, req *pb.DeleteKitRequest) (*pb.DeleteKitResponse, error) {
+	if err := DeleteKit(req.KitId, s.dataDir, s.db, s.fs); err != nil {
+		return nil, deleteStatusErr("failed to delete kit", err)
+	}
+	return &pb.DeleteKitResponse{}, nil
+}
+
+func (s *KitServer) DeleteInstrument(ctx context.Context, req *pb.DeleteInstrumentRequest) (*pb.DeleteInstrumentResponse, error) {
+	if err := DeleteInstrument(req.InstrumentId, s.dataDir, s.db, s.fs); err != nil {
+		return nil, deleteStatusErr("failed to delete instrument", err)
+	}
+	return &pb.DeleteInstrumentResponse{}, nil
+}
+
+func (s *KitServer) CreateCustomKit(ctx context.Context, req *pb.CreateCustomKitRequest) (*pb.CreateCustomKitResponse, error) {
+	kit := &m.Kit{
+		Name:        req.Name,
+		Description: req.GetDescription(),
+		Tags:        req.Tags,
+	}
+	kitId, err := CreateCustomKit(kit, req.InstrumentKeys, s.db)
+	if err != nil {
+		return nil, customKitStatusErr(err)
+	}
+	return &pb.CreateCustomKitResponse{
+		KitId:  kitId,
+		KitKey: kit.Uid,
+	}, nil
+}
+
+// chunkReader reads archive from chunks of client stream
+type chunkReader struct {
+	stream grpc.BidiStreamingServer[pb.ImportKitRequest, pb.ImportKitProgress]
+	buf    []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = req.Chunk
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// importStatusErr maps kit import errors to grpc status codes
+func importStatusErr(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	code := codes.Internal
+	switch {
+	case errors.Is(err, ErrInvalidArchive):
+		code = codes.InvalidArgument
+	case errors.Is(err, d.ErrAlreadyExists), errors.Is(err, iofs.ErrExist):
+		code = codes.AlreadyExists
+	}
+	return status.Errorf(code, "failed to import kit: %v", err)
+}
+
+// customKitStatusErr maps custom kit errors to grpc status codes
+func customKitStatusErr(err error) error {
+	code := codes.Internal
+	switch {
+	case errors.Is(err, ErrInvalidKit):
+		code = codes.InvalidArgument
+	case errors.Is(err, d.ErrNotFound):
+		code = codes.NotFound
+	case errors.Is(err, d.ErrAlreadyExists):
+		code = codes.AlreadyExists
+	}
+	return status.Errorf(code, "failed to create custom kit: %v", err)
+}
+
+// deleteStatusErr maps delete errors to grpc status codes.
+// Presets which block deleting are reported as precondition failure details
+func deleteStatusErr(msg string, err error) error {
+	var inUse *d.PresetsInUseError
+	switch {
+	case errors.As(err, &inUse):
+		st := status.Newf(codes.FailedPrecondition, "%s: %v", msg, err)
+		details := &errdetails.PreconditionFailure{}
+		for _, p := range inUse.Presets {
+			details.Violations = append(details.Violations, &errdetails.PreconditionFailure_Violation{
+				Type:        "PRESET",
+				Subject:     p.Uid,
+				Description: fmt.Sprintf("preset '%s' of kit '%s' uses instrument", p.Name, p.Kit.Name),
+			})
+		}
+		if std, derr := st.WithDetails(details); derr == nil {
+			st = std
+		}
+		return st.Err()
+	case errors.Is(err, d.ErrNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
@@ -0,0 +1,49 @@
+package loadkit
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	m "github.com/raspidrum-srv/internal/model"
+	d "github.com/raspidrum-srv/internal/repo/db"
+)
+
+func TestDeleteStatusErr(t *testing.T) {
+	inUse := &d.PresetsInUseError{Presets: []m.KitPreset{
+		{Uid: "preset-1", Name: "Rock", Kit: m.KitRef{Name: "Custom"}},
+	}}
+	err := deleteStatusErr("failed to delete kit", fmt.Errorf("failed delete kit 1: %w", inUse))
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	require.Len(t, st.Details(), 1)
+	details, ok := st.Details()[0].(*errdetails.PreconditionFailure)
+	require.True(t, ok)
+	require.Len(t, details.Violations, 1)
+	assert.Equal(t, "PRESET", details.Violations[0].Type)
+	assert.Equal(t, "preset-1", details.Violations[0].Subject)
+
+	err = deleteStatusErr("failed to delete kit", fmt.Errorf("failed delete kit 1: %w", d.ErrNotFound))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCustomKitStatusErr(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{fmt.Errorf("%w: no instruments", ErrInvalidKit), codes.InvalidArgument},
+		{fmt.Errorf("failed create custom kit: %w", d.ErrNotFound), codes.NotFound},
+		{fmt.Errorf("failed create custom kit: %w", d.ErrAlreadyExists), codes.AlreadyExists},
+		{fmt.Errorf("failed create custom kit"), codes.Internal},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, status.Code(customKitStatusErr(tt.err)), tt.err.Error())
+	}
+}
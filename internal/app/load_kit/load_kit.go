@@ -16,65 +16,78 @@ func LoadKit(path string, db *db.Sqlite) (kitId int64, err error) {
 	if err != nil {
 		return kitId, fmt.Errorf("failed load kit files: %w", err)
 	}
+	kit, instrs, err := splitKitItems(items)
+	if err != nil {
+		return kitId, err
+	}
+	if err = assignUids(kit, instrs); err != nil {
+		return kitId, err
+	}
 
+	// store kit and instrument in one transaction
+	err = db.RunInTx(func(tx *sqlx.Tx) error {
+		kitId, err = storeKit(tx, db, kit, instrs)
+		return err
+	})
+	return kitId, err
+}
+
+// splitKitItems checks that parsed files contain one kit and returns kit and instruments
+func splitKitItems(items map[string]interface{}) (kit *m.Kit, instrs []*m.Instrument, err error) {
 	// search kit
 	kitCnt := 0
-	var kitKey string
 	for k, v := range items {
-		switch v.(type) {
+		switch v := v.(type) {
 		case *m.Instrument:
-			continue
+			instrs = append(instrs, v)
 		case *m.Kit:
 			kitCnt++
-			kitKey = k
+			kit = v
 		default:
-			return kitId, fmt.Errorf("unknown format in: %s %w", k, err)
+			return nil, nil, fmt.Errorf("unknown format in: %s", k)
 		}
 	}
 	// check for one kit in path
 	if kitCnt > 1 {
-		return kitId, fmt.Errorf("too may kit-files: %d. must be one", kitCnt)
+		return nil, nil, fmt.Errorf("too may kit-files: %d. must be one", kitCnt)
 	}
-	if len(kitKey) == 0 {
-		return kitId, fmt.Errorf("not found kit file")
+	if kit == nil {
+		return nil, nil, fmt.Errorf("not found kit file")
 	}
+	return kit, instrs, nil
+}
 
-	// store kit and instrument in one transaction
-	err = db.RunInTx(func(tx *sqlx.Tx) error {
-		// store kit
-		kit := items[kitKey].(*m.Kit)
-		if len(kit.Uid) == 0 {
+// assignUids generates uuid for kit and instruments without it
+func assignUids(kit *m.Kit, instrs []*m.Instrument) error {
+	if len(kit.Uid) == 0 {
+		uuid, err := u.NewV7()
+		if err != nil {
+			return fmt.Errorf("failed gen uuid for kit: %w", err)
+		}
+		kit.Uid = uuid.String()
+	}
+	for _, instr := range instrs {
+		if len(instr.Uid) == 0 {
 			uuid, err := u.NewV7()
 			if err != nil {
-				return fmt.Errorf("failed gen uuid for kit: %w", err)
+				return fmt.Errorf("failed gen uuid for instrument: %w", err)
 			}
-			kit.Uid = uuid.String()
-		}
-		kitId, err = db.StoreKit(tx, kit)
-		if err != nil {
-			return err
+			instr.Uid = uuid.String()
 		}
+	}
+	return nil
+}
 
-		// store instruments
-		for k, v := range items {
-			// skip kit
-			if k == kitKey {
-				continue
-			}
-			insrt := v.(*m.Instrument)
-			if len(insrt.Uid) == 0 {
-				uuid, err := u.NewV7()
-				if err != nil {
-					return fmt.Errorf("failed gen uuid for instrument: %w", err)
-				}
-				insrt.Uid = uuid.String()
-			}
-			_, err = db.StoreInstrument(tx, kitId, insrt)
-			if err != nil {
-				return err
-			}
+func storeKit(tx *sqlx.Tx, db *db.Sqlite, kit *m.Kit, instrs []*m.Instrument) (kitId int64, err error) {
+	kitId, err = db.StoreKit(tx, kit)
+	if err != nil {
+		return kitId, err
+	}
+	for _, instr := range instrs {
+		_, err = db.StoreInstrument(tx, kitId, instr)
+		if err != nil {
+			return kitId, err
 		}
-		return nil
-	})
-	return kitId, err
+	}
+	return kitId, nil
 }
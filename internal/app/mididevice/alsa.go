@@ -0,0 +1,91 @@
+package mididevice
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// AlsaSeqClients is a proc file with ALSA sequencer clients and their ports
+const AlsaSeqClients = "/proc/asound/seq/clients"
+
+// System client with timer and announce ports
+const alsaSystemClient = "0"
+
+// Kernel client which echoes everything. It is present without any hardware
+const alsaMidiThrough = "Midi Through"
+
+var (
+	// Client  24 : "Alesis Nitro" [Kernel card:1]
+	alsaClientRe = regexp.MustCompile(`^Client\s+(\d+)\s*:\s*"(.*)"`)
+	//   Port   0 : "Alesis Nitro MIDI 1" (RWeX) [In/Out]
+	alsaPortRe = regexp.MustCompile(`^\s+Port\s+(\d+)\s*:\s*"(.*)"\s*\(([^)]*)\)`)
+)
+
+// ListAlsaDevices returns MIDI devices from ALSA sequencer clients.
+// Device is a port, which can send MIDI events to subscribers: it is readable and exported.
+// Returns empty list if ALSA sequencer isn't available
+func ListAlsaDevices(fs afero.Fs) ([]USBMIDIDevice, error) {
+	f, err := fs.Open(AlsaSeqClients)
+	if errors.Is(err, iofs.ErrNotExist) {
+		return []USBMIDIDevice{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed list ALSA MIDI devices: %w", err)
+	}
+	defer f.Close()
+
+	devs, err := parseAlsaSeqClients(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed list ALSA MIDI devices: %w", err)
+	}
+	return devs, nil
+}
+
+func parseAlsaSeqClients(r io.Reader) ([]USBMIDIDevice, error) {
+	devs := []USBMIDIDevice{}
+	var clientId, clientName string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if m := alsaClientRe.FindStringSubmatch(line); m != nil {
+			clientId, clientName = m[1], m[2]
+			continue
+		}
+		m := alsaPortRe.FindStringSubmatch(line)
+		if m == nil || len(clientId) == 0 {
+			continue
+		}
+		if clientId == alsaSystemClient || clientName == alsaMidiThrough {
+			continue
+		}
+		if !isAlsaSource(m[3]) {
+			continue
+		}
+		devs = append(devs, NewUSBMIDIDevice(clientId+":"+m[1], alsaDeviceName(clientName, m[2])))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return devs, nil
+}
+
+// port capabilities are flags "RWeX":
+// R - readable (port sends events), W - writable, e - exported for subscription, X - duplex
+func isAlsaSource(caps string) bool {
+	return len(caps) >= 3 && caps[0] == 'R' && caps[2] == 'e'
+}
+
+// port names of hardware clients usually start with client name
+func alsaDeviceName(client, port string) string {
+	if strings.HasPrefix(port, client) {
+		return port
+	}
+	return client + " " + port
+}
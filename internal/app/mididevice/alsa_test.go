@@ -0,0 +1,39 @@
+package mididevice
+
+import (
+	"os"
+	"path"
+	"runtime"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getProjectPath() string {
+	_, f, _, _ := runtime.Caller(0)
+	dir := path.Join(path.Dir(f), "../../../")
+	return dir
+}
+
+func TestListAlsaDevices(t *testing.T) {
+	cont, err := os.ReadFile(path.Join(getProjectPath(), "testdata/alsa/seq_clients"))
+	require.NoError(t, err)
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, AlsaSeqClients, cont, 0444))
+
+	devs, err := ListAlsaDevices(fs)
+	require.NoError(t, err)
+	assert.Equal(t, []USBMIDIDevice{
+		NewUSBMIDIDevice("24:0", "Alesis Nitro MIDI 1"),
+		NewUSBMIDIDevice("28:0", "MIDI Pad MIDI 1"),
+		NewUSBMIDIDevice("129:0", "vmpk out"),
+	}, devs)
+}
+
+func TestListAlsaDevices_NoSequencer(t *testing.T) {
+	devs, err := ListAlsaDevices(afero.NewMemMapFs())
+	require.NoError(t, err)
+	assert.Empty(t, devs)
+}
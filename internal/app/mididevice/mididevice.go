@@ -1,5 +1,7 @@
 package mididevice
 
+import "fmt"
+
 type MIDIDevice interface {
 	DevID() string
 	Name() string
@@ -12,6 +14,8 @@ type USBMIDIDevice struct {
 	devId string
 	// Device Name
 	name string
+	// MIDI Keys mapping of assigned profile
+	keys map[string]int
 }
 
 func NewUSBMIDIDevice(devId string, name string) USBMIDIDevice {
@@ -35,25 +39,15 @@ func (m *USBMIDIDevice) DevID() string {
 //
 //	hihat_close : 42
 //	tom1 : 48
+//
+// Mapping is set from MIDI map profile of device by LoadKeysMappings
 func (m *USBMIDIDevice) GetKeysMapping() (map[string]int, error) {
-	// TODO: get mapping from repo
-	return map[string]int{
-		"kick1":            36,
-		"snare":            38,
-		"snare_rimshot":    39,
-		"tom1":             48,
-		"tom2":             45,
-		"tom3":             43,
-		"tom4":             41,
-		"hihat_close":      42,
-		"hihat_open":       46,
-		"hihat_loose":      29,
-		"hihat_foot_open":  27,
-		"hihat_foot_close": 44,
-		"hihat_splash":     28,
-		"crash1_edge":      49,
-		"ride1_edge":       51,
-		"ride1_bell":       53,
-	}, nil
+	if m.keys == nil {
+		return nil, fmt.Errorf("MIDI Keys mapping isn't loaded for device %s", m.name)
+	}
+	return m.keys, nil
+}
 
+func (m *USBMIDIDevice) SetKeysMapping(keys map[string]int) {
+	m.keys = keys
 }
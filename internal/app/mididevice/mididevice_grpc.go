@@ -0,0 +1,156 @@
+package mididevice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/afero"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	m "github.com/raspidrum-srv/internal/model"
+	pb "github.com/raspidrum-srv/internal/pkg/grpc"
+	d "github.com/raspidrum-srv/internal/repo/db"
+)
+
+type MidiServer struct {
+	pb.UnimplementedMidiServer
+	db *d.Sqlite
+	fs afero.Fs
+}
+
+func NewMidiServer(db *d.Sqlite, fs afero.Fs) *MidiServer {
+	return &MidiServer{
+		db: db,
+		fs: fs,
+	}
+}
+
+func (s *MidiServer) ListMidiDevices(ctx context.Context, req *pb.ListMidiDevicesRequest) (*pb.ListMidiDevicesResponse, error) {
+	devs, err := ListAlsaDevices(s.fs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list MIDI devices: %v", err)
+	}
+	prfls, err := s.db.ListMidiDeviceProfiles()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list MIDI devices: %v", err)
+	}
+	res := &pb.ListMidiDevicesResponse{
+		Devices: make([]*pb.MidiDevice, len(devs)),
+	}
+	for i, v := range devs {
+		res.Devices[i] = &pb.MidiDevice{
+			Id:   v.DevID(),
+			Name: v.Name(),
+		}
+		if prflId, ok := prfls[v.Name()]; ok {
+			res.Devices[i].ProfileId = &prflId
+		}
+	}
+	return res, nil
+}
+
+func (s *MidiServer) ListMidiMapProfiles(ctx context.Context, req *pb.ListMidiMapProfilesRequest) (*pb.ListMidiMapProfilesResponse, error) {
+	prfls, err := s.db.ListMidiMapProfiles()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list MIDI map profiles: %v", err)
+	}
+	res := &pb.ListMidiMapProfilesResponse{
+		Profiles: make([]*pb.MidiMapProfile, len(*prfls)),
+	}
+	for i := range *prfls {
+		res.Profiles[i] = convertProfileToPb(&(*prfls)[i])
+	}
+	return res, nil
+}
+
+func (s *MidiServer) GetMidiMapProfile(ctx context.Context, req *pb.GetMidiMapProfileRequest) (*pb.MidiMapProfileResponse, error) {
+	return s.profileResponse(req.ProfileId, "failed to get MIDI map profile")
+}
+
+func (s *MidiServer) CreateMidiMapProfile(ctx context.Context, req *pb.CreateMidiMapProfileRequest) (*pb.MidiMapProfileResponse, error) {
+	if req.Profile == nil {
+		return nil, status.Error(codes.InvalidArgument, "profile is required")
+	}
+	prfl := convertProfileToModel(req.Profile)
+	id, err := CreateMidiMapProfile(prfl, s.db)
+	if err != nil {
+		return nil, profileStatusErr("failed to create MIDI map profile", err)
+	}
+	return s.profileResponse(id, "failed to create MIDI map profile")
+}
+
+func (s *MidiServer) UpdateMidiMapProfile(ctx context.Context, req *pb.UpdateMidiMapProfileRequest) (*pb.MidiMapProfileResponse, error) {
+	if req.Profile == nil {
+		return nil, status.Error(codes.InvalidArgument, "profile is required")
+	}
+	prfl := convertProfileToModel(req.Profile)
+	prfl.Id = req.ProfileId
+	if err := UpdateMidiMapProfile(prfl, s.db); err != nil {
+		return nil, profileStatusErr("failed to update MIDI map profile", err)
+	}
+	return s.profileResponse(req.ProfileId, "failed to update MIDI map profile")
+}
+
+func (s *MidiServer) DeleteMidiMapProfile(ctx context.Context, req *pb.DeleteMidiMapProfileRequest) (*pb.DeleteMidiMapProfileResponse, error) {
+	if err := DeleteMidiMapProfile(req.ProfileId, s.db); err != nil {
+		return nil, profileStatusErr("failed to delete MIDI map profile", err)
+	}
+	return &pb.DeleteMidiMapProfileResponse{}, nil
+}
+
+func (s *MidiServer) AssignMidiMapProfile(ctx context.Context, req *pb.AssignMidiMapProfileRequest) (*pb.AssignMidiMapProfileResponse, error) {
+	if err := AssignMidiMapProfile(req.DeviceName, req.ProfileId, s.db); err != nil {
+		return nil, profileStatusErr("failed to assign MIDI map profile", err)
+	}
+	return &pb.AssignMidiMapProfileResponse{}, nil
+}
+
+func (s *MidiServer) profileResponse(prflId int64, msg string) (*pb.MidiMapProfileResponse, error) {
+	prfl, err := s.db.GetMidiMapProfile(d.ById(prflId))
+	if err != nil {
+		return nil, profileStatusErr(msg, err)
+	}
+	return &pb.MidiMapProfileResponse{Profile: convertProfileToPb(prfl)}, nil
+}
+
+// profileStatusErr maps MIDI map profile errors to grpc status codes
+func profileStatusErr(msg string, err error) error {
+	code := codes.Internal
+	switch {
+	case errors.Is(err, ErrInvalidProfile):
+		code = codes.InvalidArgument
+	case errors.Is(err, d.ErrNotFound):
+		code = codes.NotFound
+	case errors.Is(err, d.ErrAlreadyExists):
+		code = codes.AlreadyExists
+	case errors.Is(err, d.ErrReadOnly), errors.Is(err, d.ErrInUse):
+		code = codes.FailedPrecondition
+	}
+	return status.Errorf(code, "%s: %v", msg, err)
+}
+
+func convertProfileToPb(prfl *m.MidiMapProfile) *pb.MidiMapProfile {
+	notes := make(map[string]int32, len(prfl.Notes))
+	for k, v := range prfl.Notes {
+		notes[k] = int32(v)
+	}
+	return &pb.MidiMapProfile{
+		Id:      prfl.Id,
+		Name:    prfl.Name,
+		Builtin: prfl.Builtin,
+		Notes:   notes,
+	}
+}
+
+func convertProfileToModel(prfl *pb.MidiMapProfile) *m.MidiMapProfile {
+	notes := make(map[string]int, len(prfl.Notes))
+	for k, v := range prfl.Notes {
+		notes[k] = int(v)
+	}
+	return &m.MidiMapProfile{
+		Id:    prfl.Id,
+		Name:  prfl.Name,
+		Notes: notes,
+	}
+}
@@ -0,0 +1,38 @@
+package mididevice
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	m "github.com/raspidrum-srv/internal/model"
+	d "github.com/raspidrum-srv/internal/repo/db"
+)
+
+func TestProfileStatusErr(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{fmt.Errorf("%w: empty name", ErrInvalidProfile), codes.InvalidArgument},
+		{fmt.Errorf("failed: %w", d.ErrNotFound), codes.NotFound},
+		{fmt.Errorf("failed: %w", d.ErrAlreadyExists), codes.AlreadyExists},
+		{fmt.Errorf("failed: %w", d.ErrReadOnly), codes.FailedPrecondition},
+		{fmt.Errorf("failed: %w", d.ErrInUse), codes.FailedPrecondition},
+		{fmt.Errorf("failed"), codes.Internal},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, status.Code(profileStatusErr("failed", tt.err)), tt.err.Error())
+	}
+}
+
+func TestConvertProfile(t *testing.T) {
+	prfl := &m.MidiMapProfile{Id: 5, Name: "GM", Builtin: true, Notes: map[string]int{"hihat_open": 46}}
+	got := convertProfileToModel(convertProfileToPb(prfl))
+	// builtin flag isn't accepted from client
+	prfl.Builtin = false
+	assert.Equal(t, prfl, got)
+}
@@ -0,0 +1,75 @@
+package mididevice
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	m "github.com/raspidrum-srv/internal/model"
+	db "github.com/raspidrum-srv/internal/repo/db"
+)
+
+// ErrInvalidProfile is returned when MIDI map profile has empty name or notes out of MIDI range
+var ErrInvalidProfile = errors.New("invalid MIDI map profile")
+
+const maxMidiNote = 127
+
+// LoadKeysMappings sets MIDI Keys mapping of devices from their assigned profiles.
+// Devices without assigned profile get default profile
+func LoadKeysMappings(devs []USBMIDIDevice, d *db.Sqlite) error {
+	for i := range devs {
+		prfl, err := d.GetDeviceMidiMapProfile(devs[i].Name())
+		if err != nil {
+			return fmt.Errorf("failed load MIDI Keys mapping of device %s: %w", devs[i].Name(), err)
+		}
+		devs[i].SetKeysMapping(prfl.Notes)
+	}
+	return nil
+}
+
+func CreateMidiMapProfile(prfl *m.MidiMapProfile, d *db.Sqlite) (int64, error) {
+	if err := validateProfile(prfl); err != nil {
+		return 0, err
+	}
+	prfl.Builtin = false
+	id, err := d.StoreMidiMapProfile(nil, prfl)
+	if err != nil {
+		return 0, err
+	}
+	prfl.Id = id
+	return id, nil
+}
+
+func UpdateMidiMapProfile(prfl *m.MidiMapProfile, d *db.Sqlite) error {
+	if err := validateProfile(prfl); err != nil {
+		return err
+	}
+	return d.UpdateMidiMapProfile(nil, prfl)
+}
+
+func DeleteMidiMapProfile(prflId int64, d *db.Sqlite) error {
+	return d.DeleteMidiMapProfile(nil, prflId)
+}
+
+// AssignMidiMapProfile assigns profile to device by device name
+func AssignMidiMapProfile(device string, prflId int64, d *db.Sqlite) error {
+	if len(strings.TrimSpace(device)) == 0 {
+		return fmt.Errorf("%w: empty device name", ErrInvalidProfile)
+	}
+	return d.AssignMidiMapProfile(nil, device, prflId)
+}
+
+func validateProfile(prfl *m.MidiMapProfile) error {
+	if len(strings.TrimSpace(prfl.Name)) == 0 {
+		return fmt.Errorf("%w: empty name", ErrInvalidProfile)
+	}
+	for k, v := range prfl.Notes {
+		if len(strings.TrimSpace(k)) == 0 {
+			return fmt.Errorf("%w: empty MIDI Key", ErrInvalidProfile)
+		}
+		if v < 0 || v > maxMidiNote {
+			return fmt.Errorf("%w: note %d of MIDI Key %s out of range 0..%d", ErrInvalidProfile, v, k, maxMidiNote)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package mididevice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	m "github.com/raspidrum-srv/internal/model"
+)
+
+func TestValidateProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		prfl    m.MidiMapProfile
+		wantErr bool
+	}{
+		{"valid", m.MidiMapProfile{Name: "Roland", Notes: map[string]int{"kick1": 36, "snare": 0, "ride1_bell": 127}}, false},
+		{"without notes", m.MidiMapProfile{Name: "Roland"}, false},
+		{"empty name", m.MidiMapProfile{Name: " ", Notes: map[string]int{"kick1": 36}}, true},
+		{"empty key", m.MidiMapProfile{Name: "Roland", Notes: map[string]int{"": 36}}, true},
+		{"note above range", m.MidiMapProfile{Name: "Roland", Notes: map[string]int{"kick1": 128}}, true},
+		{"negative note", m.MidiMapProfile{Name: "Roland", Notes: map[string]int{"kick1": -1}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateProfile(&tt.prfl)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidProfile)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUSBMIDIDevice_GetKeysMapping(t *testing.T) {
+	dev := NewUSBMIDIDevice("24:0", "Alesis Nitro MIDI 1")
+	_, err := dev.GetKeysMapping()
+	assert.Error(t, err, "mapping isn't loaded")
+
+	dev.SetKeysMapping(map[string]int{"hihat_open": 44})
+	keys, err := dev.GetKeysMapping()
+	assert.NoError(t, err)
+	assert.Equal(t, 44, keys["hihat_open"])
+}
@@ -0,0 +1,96 @@
+package mididevice
+
+import (
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/spf13/afero"
+)
+
+// Watcher polls ALSA sequencer clients and reports connected and disconnected MIDI devices.
+// onChange gets all currently connected devices
+type Watcher struct {
+	fs       afero.Fs
+	interval time.Duration
+	onChange func(devs []USBMIDIDevice)
+
+	last []USBMIDIDevice
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewWatcher(fs afero.Fs, interval time.Duration, onChange func(devs []USBMIDIDevice)) *Watcher {
+	return &Watcher{
+		fs:       fs,
+		interval: interval,
+		onChange: onChange,
+	}
+}
+
+// Start takes current devices as initial state and starts polling
+func (w *Watcher) Start() {
+	devs, err := ListAlsaDevices(w.fs)
+	if err != nil {
+		slog.Warn("failed get MIDI devices", slog.Any("error", err))
+	}
+	w.last = devs
+	w.stop = make(chan struct{})
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-w.stop:
+				return
+			case <-ticker.C:
+				w.poll()
+			}
+		}
+	}()
+}
+
+func (w *Watcher) Stop() {
+	if w.stop == nil {
+		return
+	}
+	close(w.stop)
+	w.wg.Wait()
+	w.stop = nil
+}
+
+func (w *Watcher) poll() {
+	devs, err := ListAlsaDevices(w.fs)
+	if err != nil {
+		slog.Warn("failed get MIDI devices", slog.Any("error", err))
+		return
+	}
+	if slices.EqualFunc(devs, w.last, sameDevice) {
+		return
+	}
+	for _, v := range devs {
+		if !containsDevice(w.last, v) {
+			slog.Info("MIDI device connected", slog.String("id", v.DevID()), slog.String("name", v.Name()))
+		}
+	}
+	for _, v := range w.last {
+		if !containsDevice(devs, v) {
+			slog.Info("MIDI device disconnected", slog.String("id", v.DevID()), slog.String("name", v.Name()))
+		}
+	}
+	w.last = devs
+	w.onChange(devs)
+}
+
+// same ALSA address and name. ALSA may reuse client id for other device
+func sameDevice(a, b USBMIDIDevice) bool {
+	return a.devId == b.devId && a.name == b.name
+}
+
+func containsDevice(devs []USBMIDIDevice, dev USBMIDIDevice) bool {
+	return slices.ContainsFunc(devs, func(v USBMIDIDevice) bool { return sameDevice(v, dev) })
+}
@@ -0,0 +1,49 @@
+package mididevice
+
+import (
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcher_poll(t *testing.T) {
+	cont, err := os.ReadFile(path.Join(getProjectPath(), "testdata/alsa/seq_clients"))
+	require.NoError(t, err)
+	fs := afero.NewMemMapFs()
+
+	var got [][]USBMIDIDevice
+	w := NewWatcher(fs, time.Second, func(devs []USBMIDIDevice) {
+		got = append(got, devs)
+	})
+	w.Start()
+	w.Stop()
+
+	// devices connected
+	require.NoError(t, afero.WriteFile(fs, AlsaSeqClients, cont, 0444))
+	w.poll()
+	require.Len(t, got, 1)
+	assert.Len(t, got[0], 3)
+
+	// without changes
+	w.poll()
+	assert.Len(t, got, 1)
+
+	// drum module reconnected with new client id
+	replugged := strings.ReplaceAll(string(cont), "Client  24 :", "Client  32 :")
+	require.NoError(t, afero.WriteFile(fs, AlsaSeqClients, []byte(replugged), 0444))
+	w.poll()
+	require.Len(t, got, 2)
+	assert.Equal(t, "32:0", got[1][0].DevID())
+
+	// all devices disconnected
+	require.NoError(t, fs.Remove(AlsaSeqClients))
+	w.poll()
+	require.Len(t, got, 3)
+	assert.Empty(t, got[2])
+}
@@ -0,0 +1,212 @@
+package preset
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/raspidrum-srv/internal/pkg/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/raspidrum-srv/internal/model"
+	"github.com/raspidrum-srv/internal/repo"
+)
+
+// interval of sending activity frames. UI renders about 30 frames per second
+var activityInterval = 33 * time.Millisecond
+
+type hitKey struct {
+	instrument string
+	layer      string
+}
+
+// activityWatcher collects events of WatchActivity stream until the frame is sent.
+// Hits of the same instrument or layer are merged by max velocity, voice count of channel is replaced by the last one.
+// So frame size is limited by preset size
+type activityWatcher struct {
+	mu     sync.Mutex
+	hits   map[hitKey]*pb.ActivityEvent
+	voices map[string]*pb.ActivityEvent
+}
+
+func (w *activityWatcher) add(evt *pb.ActivityEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	switch evt.Type {
+	case pb.ActivityEventType_ACTIVITY_EVENT_TYPE_HIT:
+		if w.hits == nil {
+			w.hits = map[hitKey]*pb.ActivityEvent{}
+		}
+		key := hitKey{evt.InstrumentKey, evt.LayerKey}
+		if prev, ok := w.hits[key]; ok && prev.Velocity >= evt.Velocity {
+			return
+		}
+		w.hits[key] = evt
+	case pb.ActivityEventType_ACTIVITY_EVENT_TYPE_VOICES:
+		if w.voices == nil {
+			w.voices = map[string]*pb.ActivityEvent{}
+		}
+		w.voices[evt.ChannelKey] = evt
+	}
+}
+
+// take returns collected events in order of their time
+func (w *activityWatcher) take() []*pb.ActivityEvent {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	res := make([]*pb.ActivityEvent, 0, len(w.hits)+len(w.voices))
+	for _, evt := range w.hits {
+		res = append(res, evt)
+	}
+	for _, evt := range w.voices {
+		res = append(res, evt)
+	}
+	clear(w.hits)
+	clear(w.voices)
+	slices.SortFunc(res, func(a, b *pb.ActivityEvent) int {
+		return cmp.Or(
+			cmp.Compare(a.Timestamp, b.Timestamp),
+			cmp.Compare(a.Type, b.Type),
+			cmp.Compare(a.ChannelKey, b.ChannelKey),
+			cmp.Compare(a.InstrumentKey, b.InstrumentKey),
+			cmp.Compare(a.LayerKey, b.LayerKey),
+		)
+	})
+	return res
+}
+
+// activityWatchers delivers activity events to WatchActivity streams
+type activityWatchers struct {
+	mu       sync.Mutex
+	watchers map[*activityWatcher]struct{}
+}
+
+func (a *activityWatchers) add() *activityWatcher {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.watchers == nil {
+		a.watchers = map[*activityWatcher]struct{}{}
+	}
+	w := &activityWatcher{}
+	a.watchers[w] = struct{}{}
+	return w
+}
+
+func (a *activityWatchers) remove(w *activityWatcher) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.watchers, w)
+}
+
+func (a *activityWatchers) watched() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return len(a.watchers) > 0
+}
+
+func (a *activityWatchers) publish(evt *pb.ActivityEvent) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for w := range a.watchers {
+		w.add(evt)
+	}
+}
+
+func (s *PresetServer) WatchActivity(req *pb.WatchActivityRequest, stream grpc.ServerStreamingServer[pb.ActivityFrame]) error {
+	w := s.activity.add()
+	defer s.activity.remove(w)
+	ticker := time.NewTicker(activityInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+			evts := w.take()
+			if len(evts) == 0 {
+				continue
+			}
+			if err := stream.Send(&pb.ActivityFrame{Events: evts}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// activityKeys maps sampler channels and MIDI notes of the loaded preset to keys of activity events.
+// It's replaced on loading of preset, so LSCP event reader doesn't wait for preset lock
+type activityKeys struct {
+	// sampler channel to channel key
+	channels map[int]string
+	// channel key to hit by MIDI note
+	hits map[string]map[int]hitKey
+}
+
+func newActivityKeys(preset *model.KitPreset, chnls repo.SamplerChannels) *activityKeys {
+	res := &activityKeys{
+		channels: make(map[int]string, len(chnls)),
+		hits:     make(map[string]map[int]hitKey, len(chnls)),
+	}
+	for chnlKey, chnl := range chnls {
+		res.channels[chnl] = chnlKey
+		hits := map[int]hitKey{}
+		for note := range 128 {
+			if instr, layerKey, ok := preset.FindByMidiNote(chnlKey, note); ok {
+				hits[note] = hitKey{strconv.FormatInt(instr.Id, 10), layerKey}
+			}
+		}
+		res.hits[chnlKey] = hits
+	}
+	return res
+}
+
+// HandleMidiNote sends hit of instrument or layer of the loaded preset played by note to WatchActivity streams.
+// Notes of preloaded presets and notes without instrument are skipped
+func (s *PresetServer) HandleMidiNote(samplerChn int, note int, velocity int) {
+	if !s.activity.watched() {
+		return
+	}
+	keys := s.activityKeys.Load()
+	if keys == nil {
+		return
+	}
+	chnlKey, ok := keys.channels[samplerChn]
+	if !ok {
+		return
+	}
+	hit, ok := keys.hits[chnlKey][note]
+	if !ok {
+		return
+	}
+	s.activity.publish(&pb.ActivityEvent{
+		Type:          pb.ActivityEventType_ACTIVITY_EVENT_TYPE_HIT,
+		ChannelKey:    chnlKey,
+		InstrumentKey: hit.instrument,
+		LayerKey:      hit.layer,
+		Velocity:      int32(velocity),
+		Timestamp:     time.Now().UnixMilli(),
+	})
+}
+
+// HandleVoiceCount sends voice count of channel of the loaded preset to WatchActivity streams
+func (s *PresetServer) HandleVoiceCount(samplerChn int, count int) {
+	if !s.activity.watched() {
+		return
+	}
+	keys := s.activityKeys.Load()
+	if keys == nil {
+		return
+	}
+	chnlKey, ok := keys.channels[samplerChn]
+	if !ok {
+		return
+	}
+	s.activity.publish(&pb.ActivityEvent{
+		Type:       pb.ActivityEventType_ACTIVITY_EVENT_TYPE_VOICES,
+		ChannelKey: chnlKey,
+		VoiceCount: int32(count),
+		Timestamp:  time.Now().UnixMilli(),
+	})
+}
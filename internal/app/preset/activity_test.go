@@ -0,0 +1,98 @@
+package preset
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/raspidrum-srv/internal/app/audio"
+	m "github.com/raspidrum-srv/internal/model"
+	pb "github.com/raspidrum-srv/internal/pkg/grpc"
+	"github.com/raspidrum-srv/internal/repo"
+)
+
+type fakeActivityStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	frames chan *pb.ActivityFrame
+}
+
+func (f *fakeActivityStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeActivityStream) Send(frame *pb.ActivityFrame) error {
+	f.frames <- frame
+	return nil
+}
+
+type activityHit struct {
+	channel, instrument, layer string
+	velocity, voices           int32
+}
+
+func activityHits(evts []*pb.ActivityEvent) []activityHit {
+	var res []activityHit
+	for _, e := range evts {
+		res = append(res, activityHit{e.ChannelKey, e.InstrumentKey, e.LayerKey, e.Velocity, e.VoiceCount})
+	}
+	return res
+}
+
+func TestPresetServer_Activity(t *testing.T) {
+	sampler := &fakeRestoreSampler{}
+	s := NewPresetServer(nil, sampler, audio.NewSettings(repo.AudioOutput{Driver: "ALSA"}), afero.NewMemMapFs())
+	pst := loadPresetFromYAML(t, "single instr_with_layers.yaml")
+	require.NoError(t, pst.PrepareToLoad([]m.MIDIDevice{&MockMMIDIDevice{}}))
+	s.activityKeys.Store(newActivityKeys(pst, repo.SamplerChannels{"ch1": 3}))
+
+	w := s.activity.add()
+	// event reader doesn't wait for preset lock, i.e. held by applying of control
+	s.mu.Lock()
+	// bell
+	s.HandleMidiNote(3, 53, 40)
+	s.HandleMidiNote(3, 53, 100)
+	s.HandleMidiNote(3, 53, 70)
+	// edge
+	s.HandleMidiNote(3, 51, 20)
+	// note without instrument
+	s.HandleMidiNote(3, 36, 90)
+	// channel of preloaded preset
+	s.HandleMidiNote(5, 53, 90)
+	s.HandleVoiceCount(5, 1)
+	s.HandleVoiceCount(3, 2)
+	s.HandleVoiceCount(3, 5)
+	s.mu.Unlock()
+
+	assert.ElementsMatch(t, []activityHit{
+		{channel: "ch1", instrument: "0", layer: "bell", velocity: 100},
+		{channel: "ch1", instrument: "0", layer: "edge", velocity: 20},
+		{channel: "ch1", voices: 5},
+	}, activityHits(w.take()))
+	assert.Empty(t, w.take())
+	s.activity.remove(w)
+
+	// frames are sent by stream
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fakeActivityStream{ctx: ctx, frames: make(chan *pb.ActivityFrame, 8)}
+	done := make(chan error, 1)
+	go func() {
+		done <- s.WatchActivity(&pb.WatchActivityRequest{}, stream)
+	}()
+	require.Eventually(t, s.activity.watched, time.Second, time.Millisecond)
+	s.HandleMidiNote(3, 51, 64)
+	select {
+	case frame := <-stream.frames:
+		assert.Equal(t, []activityHit{{channel: "ch1", instrument: "0", layer: "edge", velocity: 64}}, activityHits(frame.Events))
+	case <-time.After(time.Second):
+		t.Fatal("activity frame isn't sent")
+	}
+	cancel()
+	require.NoError(t, <-done)
+	assert.False(t, s.activity.watched())
+}
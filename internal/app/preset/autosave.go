@@ -0,0 +1,51 @@
+package preset
+
+import (
+	"sync"
+	"time"
+)
+
+// autosaver calls save after delay since the last trigger.
+// Every trigger during the delay postpones saving
+type autosaver struct {
+	delay time.Duration
+	save  func()
+	mu    sync.Mutex
+	timer *time.Timer
+}
+
+func newAutosaver(delay time.Duration, save func()) *autosaver {
+	return &autosaver{
+		delay: delay,
+		save:  save,
+	}
+}
+
+func (a *autosaver) Trigger() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.timer == nil {
+		a.timer = time.AfterFunc(a.delay, a.save)
+		return
+	}
+	a.timer.Reset(a.delay)
+}
+
+// Flush saves immediately if saving is pending
+func (a *autosaver) Flush() {
+	a.mu.Lock()
+	pending := a.timer != nil && a.timer.Stop()
+	a.mu.Unlock()
+	if pending {
+		a.save()
+	}
+}
+
+// Stop cancels pending saving
+func (a *autosaver) Stop() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.timer != nil {
+		a.timer.Stop()
+	}
+}
@@ -0,0 +1,66 @@
+package preset
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raspidrum-srv/internal/model"
+)
+
+func TestAutosaver_Trigger(t *testing.T) {
+	var saved atomic.Int32
+	a := newAutosaver(50*time.Millisecond, func() { saved.Add(1) })
+	defer a.Stop()
+
+	// triggers during delay postpone saving
+	for range 5 {
+		a.Trigger()
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, int32(0), saved.Load())
+
+	assert.Eventually(t, func() bool { return saved.Load() == 1 }, time.Second, 10*time.Millisecond)
+
+	a.Trigger()
+	assert.Eventually(t, func() bool { return saved.Load() == 2 }, time.Second, 10*time.Millisecond)
+}
+
+func TestAutosaver_Flush(t *testing.T) {
+	var saved atomic.Int32
+	a := newAutosaver(time.Hour, func() { saved.Add(1) })
+	defer a.Stop()
+
+	// nothing pending
+	a.Flush()
+	assert.Equal(t, int32(0), saved.Load())
+
+	a.Trigger()
+	a.Flush()
+	assert.Equal(t, int32(1), saved.Load())
+
+	// flushed save isn't repeated
+	a.Flush()
+	assert.Equal(t, int32(1), saved.Load())
+}
+
+func TestPresetServer_markSaved(t *testing.T) {
+	s := &PresetServer{loadedPreset: &model.KitPreset{Id: 1}}
+	s.markChanged()
+
+	// control is changed while preset is stored
+	pst, changes := s.loadedToStore()
+	require.NotNil(t, pst)
+	s.markChanged()
+	s.markSaved(changes)
+	assert.True(t, s.dirty)
+
+	_, changes = s.loadedToStore()
+	// failed store doesn't mark preset saved
+	assert.True(t, s.dirty)
+	s.markSaved(changes)
+	assert.False(t, s.dirty)
+}
@@ -0,0 +1,118 @@
+package preset
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// min interval between values of the same control applied to sampler.
+// Fader sends values faster, intermediate values are skipped
+var controlInterval = 20 * time.Millisecond
+
+// controlUpdate is control value received from ChannelControl stream
+type controlUpdate struct {
+	key       string
+	value     float64
+	sender    *controlStream
+	clientSeq int64
+}
+
+// controlQueue applies control values by single worker, so stream receiving doesn't wait for sampler.
+// Queued value of control is replaced by the latest one and control is applied not often than interval.
+// Controls are applied in order of queueing, so burst of one control doesn't delay others
+type controlQueue struct {
+	interval time.Duration
+	apply    func(controlUpdate)
+	once     sync.Once
+	wake     chan struct{}
+
+	mu      sync.Mutex
+	pending map[string]controlUpdate
+	// keys of pending values in order of queueing
+	order []string
+	// time of the last applying of control. Kept only for interval
+	applied map[string]time.Time
+}
+
+func newControlQueue(interval time.Duration, apply func(controlUpdate)) *controlQueue {
+	return &controlQueue{
+		interval: interval,
+		apply:    apply,
+		wake:     make(chan struct{}, 1),
+		pending:  map[string]controlUpdate{},
+		applied:  map[string]time.Time{},
+	}
+}
+
+// push queues value. Worker is started on the first value
+func (q *controlQueue) push(u controlUpdate) {
+	q.once.Do(func() {
+		go q.run()
+	})
+	q.add(u)
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// add replaces queued value of control
+func (q *controlQueue) add(u controlUpdate) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if _, ok := q.pending[u.key]; !ok {
+		q.order = append(q.order, u.key)
+	}
+	q.pending[u.key] = u
+}
+
+// next returns the first queued value, which control was applied at least interval ago.
+// Otherwise returns time to wait for such value or -1 if queue is empty
+func (q *controlQueue) next(now time.Time) (controlUpdate, time.Duration, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	// control applied at least interval ago is ready as never applied one
+	for key, at := range q.applied {
+		if now.Sub(at) >= q.interval {
+			delete(q.applied, key)
+		}
+	}
+	wait := time.Duration(-1)
+	for i, key := range q.order {
+		d := q.applied[key].Add(q.interval).Sub(now)
+		if d <= 0 {
+			u := q.pending[key]
+			delete(q.pending, key)
+			q.order = slices.Delete(q.order, i, i+1)
+			q.applied[key] = now
+			return u, 0, true
+		}
+		if wait < 0 || d < wait {
+			wait = d
+		}
+	}
+	return controlUpdate{}, wait, false
+}
+
+func (q *controlQueue) run() {
+	timer := time.NewTimer(q.interval)
+	timer.Stop()
+	for {
+		u, wait, ok := q.next(time.Now())
+		if ok {
+			q.apply(u)
+			continue
+		}
+		if wait < 0 {
+			<-q.wake
+			continue
+		}
+		timer.Reset(wait)
+		select {
+		case <-q.wake:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
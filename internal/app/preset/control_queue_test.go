@@ -0,0 +1,97 @@
+package preset
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// controlRecorder records applied values. Applying of value takes delay like LSCP round-trip
+type controlRecorder struct {
+	delay   time.Duration
+	mu      sync.Mutex
+	applied []controlUpdate
+}
+
+func (r *controlRecorder) apply(u controlUpdate) {
+	time.Sleep(r.delay)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.applied = append(r.applied, u)
+}
+
+func (r *controlRecorder) values(key string) []float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var res []float64
+	for _, u := range r.applied {
+		if u.key == key {
+			res = append(res, u.value)
+		}
+	}
+	return res
+}
+
+func TestControlQueue_next(t *testing.T) {
+	q := newControlQueue(50*time.Millisecond, nil)
+	now := time.Unix(1000, 0)
+
+	// fader burst
+	for i := 1; i <= 100; i++ {
+		q.add(controlUpdate{key: "c0volume", value: float64(i) / 100})
+	}
+	q.add(controlUpdate{key: "c1volume", value: 0.3})
+
+	// the latest value wins, other control isn't delayed by burst
+	u, _, ok := q.next(now)
+	require.True(t, ok)
+	assert.Equal(t, controlUpdate{key: "c0volume", value: 1}, u)
+	u, _, ok = q.next(now)
+	require.True(t, ok)
+	assert.Equal(t, controlUpdate{key: "c1volume", value: 0.3}, u)
+	_, wait, ok := q.next(now)
+	assert.False(t, ok)
+	assert.Equal(t, time.Duration(-1), wait)
+
+	// control is applied not often than interval
+	q.add(controlUpdate{key: "c0volume", value: 0.5})
+	_, wait, ok = q.next(now.Add(10 * time.Millisecond))
+	assert.False(t, ok)
+	assert.Equal(t, 40*time.Millisecond, wait)
+	u, _, ok = q.next(now.Add(50 * time.Millisecond))
+	require.True(t, ok)
+	assert.Equal(t, 0.5, u.value)
+
+	// applying times are dropped after interval
+	assert.Len(t, q.applied, 1)
+	_, _, ok = q.next(now.Add(100 * time.Millisecond))
+	assert.False(t, ok)
+	assert.Empty(t, q.applied)
+}
+
+func TestControlQueue_Worker(t *testing.T) {
+	rec := &controlRecorder{delay: time.Millisecond}
+	q := newControlQueue(20*time.Millisecond, rec.apply)
+
+	for i := 1; i <= 100; i++ {
+		q.push(controlUpdate{key: "c0volume", value: float64(i) / 100})
+	}
+	q.push(controlUpdate{key: "c1volume", value: 0.3})
+
+	// the latest values are applied
+	require.Eventually(t, func() bool {
+		v := rec.values("c0volume")
+		return len(v) > 0 && v[len(v)-1] == 1
+	}, 5*time.Second, time.Millisecond)
+	require.Eventually(t, func() bool { return len(rec.values("c1volume")) == 1 }, 5*time.Second, time.Millisecond)
+	assert.Equal(t, []float64{0.3}, rec.values("c1volume"))
+
+	q.push(controlUpdate{key: "c0volume", value: 0.2})
+	require.Eventually(t, func() bool {
+		v := rec.values("c0volume")
+		return v[len(v)-1] == 0.2
+	}, 5*time.Second, time.Millisecond)
+}
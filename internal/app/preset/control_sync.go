@@ -0,0 +1,304 @@
+package preset
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+
+	pb "github.com/raspidrum-srv/internal/pkg/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/raspidrum-srv/internal/model"
+)
+
+// size of value buffer of ChannelControl stream in addition to snapshot.
+// Slow stream is closed on overflow, client gets current values on reconnect
+const controlBufferSize = 256
+
+// controlStream receives values accepted by control hub
+type controlStream struct {
+	ch chan *pb.ControlValue
+	// closed when stream has to be ended with err, i.e. on overflow
+	done chan struct{}
+	once sync.Once
+	err  error
+}
+
+func (cs *controlStream) close(err error) {
+	cs.once.Do(func() {
+		cs.err = err
+		close(cs.done)
+	})
+}
+
+// controlHub sends accepted control values to all ChannelControl streams.
+// Every accepted value gets next seq, so clients can discard stale values
+type controlHub struct {
+	mu  sync.Mutex
+	seq int64
+	// seq of the last value of control
+	last map[string]int64
+	// key of control by its address
+	keys    map[string]string
+	streams map[*controlStream]struct{}
+}
+
+// add registers stream and queues snapshot of current control values into it
+func (h *controlHub) add(snapshot []*pb.ControlValue) *controlStream {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.streams == nil {
+		h.streams = map[*controlStream]struct{}{}
+	}
+	cs := &controlStream{
+		ch:   make(chan *pb.ControlValue, len(snapshot)+controlBufferSize),
+		done: make(chan struct{}),
+	}
+	for _, v := range snapshot {
+		cs.ch <- &pb.ControlValue{Key: v.Key, Address: v.Address, Seq: h.last[v.Key], Value: v.Value, Status: pb.ControlStatus_CONTROL_STATUS_OK}
+	}
+	h.streams[cs] = struct{}{}
+	return cs
+}
+
+func (h *controlHub) remove(cs *controlStream) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.streams, cs)
+}
+
+// publish sends accepted value to all streams. Stream of sender gets clientSeq of the change
+func (h *controlHub) publish(key, address string, value float64, sender *controlStream, clientSeq int64) {
+	h.publishAck(&pb.ControlValue{Key: key, Address: address, Value: value, ClientSeq: clientSeq, Status: pb.ControlStatus_CONTROL_STATUS_OK}, sender)
+}
+
+// publishAck sends stored value to all streams. Stream of sender gets ack with clientSeq and status of the change,
+// other streams get value with OK status
+func (h *controlHub) publishAck(ack *pb.ControlValue, sender *controlStream) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.last == nil {
+		h.last = map[string]int64{}
+	}
+	h.seq++
+	h.last[ack.Key] = h.seq
+	ack.Seq = h.seq
+	for cs := range h.streams {
+		v := ack
+		if cs != sender {
+			v = &pb.ControlValue{Key: ack.Key, Address: ack.Address, Seq: h.seq, Value: ack.Value, Status: pb.ControlStatus_CONTROL_STATUS_OK}
+		}
+		h.send(cs, v)
+	}
+}
+
+// reply sends rejected change only to its sender. Value has seq of the last accepted value of control
+func (h *controlHub) reply(sender *controlStream, v *pb.ControlValue) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.streams[sender]; !ok {
+		return
+	}
+	v.Seq = h.last[v.Key]
+	h.send(sender, v)
+}
+
+// reset replaces values of all controls, i.e. on loading of other preset, and sends them to all streams
+func (h *controlHub) reset(snapshot []*pb.ControlValue) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.seq++
+	h.last = make(map[string]int64, len(snapshot))
+	h.keys = make(map[string]string, len(snapshot))
+	for _, v := range snapshot {
+		h.last[v.Key] = h.seq
+		if v.Address != "" {
+			h.keys[v.Address] = v.Key
+		}
+	}
+	for cs := range h.streams {
+		for _, v := range snapshot {
+			if !h.send(cs, &pb.ControlValue{Key: v.Key, Address: v.Address, Seq: h.seq, Value: v.Value, Status: pb.ControlStatus_CONTROL_STATUS_OK}) {
+				break
+			}
+		}
+	}
+}
+
+// resolve returns key of control addressed by key or address. Unknown key is returned as is
+func (h *controlHub) resolve(key string) string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if k, ok := h.keys[key]; ok {
+		return k
+	}
+	return key
+}
+
+// send doesn't block on slow stream, it is dropped instead. Must be called with mu held
+func (h *controlHub) send(cs *controlStream, v *pb.ControlValue) bool {
+	select {
+	case cs.ch <- v:
+		return true
+	default:
+		slog.Warn("control stream dropped for overflow", slog.String("key", v.Key))
+		delete(h.streams, cs)
+		cs.close(status.Error(codes.ResourceExhausted, "control stream is too slow"))
+		return false
+	}
+}
+
+// controlValues returns values of all controls of converted preset
+func controlValues(p *pb.Preset) []*pb.ControlValue {
+	var res []*pb.ControlValue
+	addBase := func(c *pb.BaseControl) {
+		if c != nil {
+			res = append(res, &pb.ControlValue{Key: c.Key, Address: c.Address, Value: c.Value})
+		}
+	}
+	addFxs := func(fxs []*pb.FX) {
+		for _, fx := range fxs {
+			for _, p := range fx.Params {
+				res = append(res, &pb.ControlValue{Key: p.Key, Address: p.Address, Value: p.Value})
+			}
+		}
+	}
+	for _, ch := range p.GetChannels() {
+		addBase(ch.Volume)
+		addBase(ch.Pan)
+		addBase(ch.Mute)
+		addBase(ch.Solo)
+		for _, send := range ch.Sends {
+			addBase(send)
+		}
+		addFxs(ch.Fxs)
+		for _, instr := range ch.Instruments {
+			addBase(instr.Volume)
+			addBase(instr.Pan)
+			addBase(instr.Mute)
+			addBase(instr.Solo)
+			addFxs(instr.Tunes)
+			for _, l := range instr.Layers {
+				addBase(l.Volume)
+				addBase(l.Pan)
+				addBase(l.Mute)
+				addBase(l.Solo)
+				addFxs(l.Fxs)
+			}
+		}
+	}
+	return res
+}
+
+// controlSnapshot returns current values of the loaded preset. Must be called with mu held
+func (s *PresetServer) controlSnapshot() []*pb.ControlValue {
+	if s.loadedPreset == nil {
+		return nil
+	}
+	p, err := convertPresetToProto(s.loadedPreset)
+	if err != nil {
+		slog.Warn("failed make snapshot of control values", slog.Any("error", err))
+		return nil
+	}
+	return controlValues(p)
+}
+
+// SetValue applies values received from client. Accepted values are sent to all streams.
+// The stream first gets values of all controls of the loaded preset
+func (s *PresetServer) SetValue(stream grpc.BidiStreamingServer[pb.ControlValue, pb.ControlValue]) error {
+	// snapshot and registration under mu, so no change is missed or sent before snapshot
+	s.mu.Lock()
+	cs := s.controls.add(s.controlSnapshot())
+	s.mu.Unlock()
+	defer s.controls.remove(cs)
+
+	// stream is sent only from this goroutine
+	recvErr := make(chan error, 1)
+	go func() {
+		recvErr <- s.receiveValues(stream, cs)
+	}()
+	for {
+		select {
+		case err := <-recvErr:
+			return err
+		case <-cs.done:
+			return cs.err
+		case v := <-cs.ch:
+			if err := stream.Send(v); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// receiveValues queues received values. Stream isn't blocked by sampler.
+// Values are queued by key of control, so changes by key and by address of the same control are coalesced
+func (s *PresetServer) receiveValues(stream grpc.BidiStreamingServer[pb.ControlValue, pb.ControlValue], cs *controlStream) error {
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		s.queue.push(controlUpdate{key: s.controls.resolve(in.Key), value: in.Value, sender: cs, clientSeq: in.Seq})
+	}
+}
+
+// applyControl sets value of the loaded preset and sends stored value to all streams.
+// Value, which sampler failed to apply, is stored too: its sender gets error status, other streams get the value.
+// Rejected change is answered only to its sender with error status and the current value.
+// Change may address control by key or by address, answers have both of them
+func (s *PresetServer) applyControl(u controlUpdate) {
+	ack := &pb.ControlValue{Key: u.key, ClientSeq: u.clientSeq}
+	s.mu.Lock()
+	if s.loadedPreset == nil {
+		s.mu.Unlock()
+		ack.Status = pb.ControlStatus_CONTROL_STATUS_UNKNOWN_KEY
+		ack.Error = ErrPresetNotLoaded.Error()
+		s.controls.reply(u.sender, ack)
+		return
+	}
+	if ctrl, cerr := s.loadedPreset.GetControl(u.key); cerr == nil {
+		ack.Key, ack.Address = ctrl.Key, ctrl.Address
+	}
+	err := s.loadedPreset.SetControlValue(u.key, float32(u.value), s.ctrlHandler)
+	stored := err == nil || !(errors.Is(err, model.ErrControlNotFound) || errors.Is(err, model.ErrValueOutOfRange))
+	if stored {
+		s.markChanged()
+	}
+	if val, verr := s.loadedPreset.GetControlValue(ack.Key); verr == nil {
+		ack.Value = roundFloat(float64(val), 3)
+	}
+	switch {
+	case err == nil:
+		ack.Status = pb.ControlStatus_CONTROL_STATUS_OK
+	case errors.Is(err, model.ErrControlNotFound):
+		ack.Status = pb.ControlStatus_CONTROL_STATUS_UNKNOWN_KEY
+	case errors.Is(err, model.ErrValueOutOfRange):
+		ack.Status = pb.ControlStatus_CONTROL_STATUS_OUT_OF_RANGE
+	default:
+		ack.Status = pb.ControlStatus_CONTROL_STATUS_SAMPLER_ERROR
+	}
+	if err != nil {
+		ack.Error = err.Error()
+	}
+	if stored {
+		s.controls.publishAck(ack, u.sender)
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		slog.Warn("failed set control value", slog.String("key", u.key), slog.Float64("value", u.value), slog.Any("error", err))
+	}
+	if !stored {
+		s.controls.reply(u.sender, ack)
+	}
+	if stored && s.autosave != nil {
+		s.autosave.Trigger()
+	}
+}
@@ -0,0 +1,236 @@
+package preset
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/raspidrum-srv/internal/app/audio"
+	m "github.com/raspidrum-srv/internal/model"
+	pb "github.com/raspidrum-srv/internal/pkg/grpc"
+	"github.com/raspidrum-srv/internal/repo"
+)
+
+// fakeControlStream is client side of ChannelControl stream. Closing of in ends the stream
+type fakeControlStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	in  chan *pb.ControlValue
+	out chan *pb.ControlValue
+}
+
+func newFakeControlStream(ctx context.Context) *fakeControlStream {
+	return &fakeControlStream{
+		ctx: ctx,
+		in:  make(chan *pb.ControlValue),
+		out: make(chan *pb.ControlValue, 64),
+	}
+}
+
+func (f *fakeControlStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeControlStream) Recv() (*pb.ControlValue, error) {
+	select {
+	case v, ok := <-f.in:
+		if !ok {
+			return nil, io.EOF
+		}
+		return v, nil
+	case <-f.ctx.Done():
+		return nil, f.ctx.Err()
+	}
+}
+
+func (f *fakeControlStream) Send(v *pb.ControlValue) error {
+	f.out <- v
+	return nil
+}
+
+func (f *fakeControlStream) receive(t *testing.T, n int) []*pb.ControlValue {
+	t.Helper()
+	var res []*pb.ControlValue
+	for range n {
+		select {
+		case v := <-f.out:
+			res = append(res, v)
+		case <-time.After(time.Second):
+			t.Fatalf("got %d of %d values", len(res), n)
+		}
+	}
+	return res
+}
+
+func controlKeys(vals []*pb.ControlValue) []string {
+	keys := make([]string, 0, len(vals))
+	for _, v := range vals {
+		keys = append(keys, v.Key)
+	}
+	return keys
+}
+
+func startControlStream(t *testing.T, s *PresetServer) (*fakeControlStream, chan error) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	stream := newFakeControlStream(ctx)
+	done := make(chan error, 1)
+	go func() {
+		done <- s.SetValue(stream)
+	}()
+	return stream, done
+}
+
+func TestPresetServer_SetValueBroadcast(t *testing.T) {
+	sampler := &fakeRestoreSampler{}
+	s := NewPresetServer(nil, sampler, audio.NewSettings(repo.AudioOutput{Driver: "ALSA"}), afero.NewMemMapFs())
+	pst := loadPresetFromYAML(t, "single_instrument.yaml")
+	require.NoError(t, pst.PrepareToLoad([]m.MIDIDevice{&MockMMIDIDevice{}}))
+	s.loadedPreset = pst
+	s.ctrlHandler = NewSamplerControlHandler(sampler, repo.SamplerChannels{"ch1": 3})
+	s.controls.reset(s.controlSnapshot())
+
+	// channel volume is shown as linked instrument volume
+	keys := []string{"s0volume", "c0mute", "c0solo", "i0volume", "i0pan", "i0mute", "i0solo"}
+	phone, phoneDone := startControlStream(t, s)
+	snapshot := phone.receive(t, len(keys))
+	assert.ElementsMatch(t, keys, controlKeys(snapshot))
+	for _, v := range snapshot {
+		assert.Equal(t, int64(1), v.Seq)
+	}
+	tablet, tabletDone := startControlStream(t, s)
+	tablet.receive(t, len(keys))
+
+	// applied value is sent to all streams, sender gets its seq. MIDI CC value is 64
+	ok := pb.ControlStatus_CONTROL_STATUS_OK
+	phone.in <- &pb.ControlValue{Key: "i0volume", Seq: 7, Value: 0.5}
+	assert.Equal(t, []*pb.ControlValue{{Key: "i0volume", Address: "ch1/kick/volume", Seq: 2, Value: 0.504, ClientSeq: 7, Status: ok}}, controlValuesOnly(phone.receive(t, 1)))
+	assert.Equal(t, []*pb.ControlValue{{Key: "i0volume", Address: "ch1/kick/volume", Seq: 2, Value: 0.504, Status: ok}}, controlValuesOnly(tablet.receive(t, 1)))
+	tablet.in <- &pb.ControlValue{Key: "i0pan", Seq: 1, Value: -0.5}
+	assert.Equal(t, []*pb.ControlValue{{Key: "i0pan", Address: "ch1/kick/pan", Seq: 3, Value: -0.496, Status: ok}}, controlValuesOnly(phone.receive(t, 1)))
+	assert.Equal(t, []*pb.ControlValue{{Key: "i0pan", Address: "ch1/kick/pan", Seq: 3, Value: -0.496, ClientSeq: 1, Status: ok}}, controlValuesOnly(tablet.receive(t, 1)))
+	assert.True(t, s.dirty)
+
+	// new stream gets current values with seq of their last change
+	laptop, _ := startControlStream(t, s)
+	for _, v := range laptop.receive(t, len(keys)) {
+		switch v.Key {
+		case "i0volume":
+			assert.Equal(t, int64(2), v.Seq)
+			assert.InDelta(t, 0.5, v.Value, 0.01)
+		case "i0pan":
+			assert.Equal(t, int64(3), v.Seq)
+			assert.InDelta(t, -0.5, v.Value, 0.01)
+		default:
+			assert.Equal(t, int64(1), v.Seq)
+		}
+	}
+
+	// closed stream doesn't get changes
+	close(tablet.in)
+	require.NoError(t, <-tabletDone)
+	phone.in <- &pb.ControlValue{Key: "c0volume", Seq: 8, Value: 0.7}
+	phone.receive(t, 1)
+	laptop.receive(t, 1)
+	assert.Empty(t, tablet.out)
+
+	// rejected changes are answered only to sender, stream stays open
+	phone.in <- &pb.ControlValue{Key: "unknown", Seq: 9, Value: 0.1}
+	v := phone.receive(t, 1)[0]
+	assert.Equal(t, pb.ControlStatus_CONTROL_STATUS_UNKNOWN_KEY, v.Status)
+	assert.Equal(t, int64(9), v.ClientSeq)
+	assert.NotEmpty(t, v.Error)
+
+	// rounded value is in range
+	phone.in <- &pb.ControlValue{Key: "s0volume", Seq: 10, Value: 1.0004}
+	assert.Equal(t, []*pb.ControlValue{{Key: "s0volume", Address: "sampler/volume", Seq: 5, Value: 1, ClientSeq: 10, Status: ok}}, controlValuesOnly(phone.receive(t, 1)))
+	laptop.receive(t, 1)
+	for _, val := range []float64{1.001, -1.0006, math.NaN()} {
+		phone.in <- &pb.ControlValue{Key: "i0pan", Seq: 11, Value: val}
+		assert.Equal(t, []*pb.ControlValue{{Key: "i0pan", Address: "ch1/kick/pan", Seq: 3, Value: -0.496, ClientSeq: 11, Status: pb.ControlStatus_CONTROL_STATUS_OUT_OF_RANGE}}, controlValuesOnly(phone.receive(t, 1)))
+	}
+
+	// value, which sampler failed to apply, is stored and sent to all streams
+	sampler.ccErr = errors.New("lscp failed")
+	phone.in <- &pb.ControlValue{Key: "i0volume", Seq: 12, Value: 0.7}
+	v = phone.receive(t, 1)[0]
+	assert.NotEmpty(t, v.Error)
+	assert.Equal(t, []*pb.ControlValue{{Key: "i0volume", Address: "ch1/kick/volume", Seq: 6, Value: 0.701, ClientSeq: 12, Status: pb.ControlStatus_CONTROL_STATUS_SAMPLER_ERROR}}, controlValuesOnly([]*pb.ControlValue{v}))
+	assert.Equal(t, []*pb.ControlValue{{Key: "i0volume", Address: "ch1/kick/volume", Seq: 6, Value: 0.701, Status: ok}}, controlValuesOnly(laptop.receive(t, 1)))
+
+	// control can be changed by address, values have its key
+	sampler.ccErr = nil
+	phone.in <- &pb.ControlValue{Key: "ch1/kick/pan", Seq: 13, Value: 0.25}
+	assert.Equal(t, []*pb.ControlValue{{Key: "i0pan", Address: "ch1/kick/pan", Seq: 7, Value: 0.244, ClientSeq: 13, Status: ok}}, controlValuesOnly(phone.receive(t, 1)))
+	assert.Equal(t, []*pb.ControlValue{{Key: "i0pan", Address: "ch1/kick/pan", Seq: 7, Value: 0.244, Status: ok}}, controlValuesOnly(laptop.receive(t, 1)))
+
+	close(phone.in)
+	require.NoError(t, <-phoneDone)
+}
+
+func TestPresetServer_SetValueNotLoaded(t *testing.T) {
+	s := NewPresetServer(nil, &fakeRestoreSampler{}, audio.NewSettings(repo.AudioOutput{Driver: "ALSA"}), afero.NewMemMapFs())
+	stream, done := startControlStream(t, s)
+	stream.in <- &pb.ControlValue{Key: "c0volume", Seq: 1, Value: 0.5}
+	v := stream.receive(t, 1)[0]
+	assert.Equal(t, pb.ControlStatus_CONTROL_STATUS_UNKNOWN_KEY, v.Status)
+	assert.Equal(t, ErrPresetNotLoaded.Error(), v.Error)
+	close(stream.in)
+	require.NoError(t, <-done)
+}
+
+func TestControlHub_DropSlowStream(t *testing.T) {
+	var h controlHub
+	slow := h.add(nil)
+	for range controlBufferSize {
+		h.publish("c0volume", "ch1/volume", 0.5, nil, 0)
+	}
+	select {
+	case <-slow.done:
+		t.Fatal("stream shouldn't be dropped until buffer is full")
+	default:
+	}
+	h.publish("c0volume", "ch1/volume", 0.5, nil, 0)
+	select {
+	case <-slow.done:
+	default:
+		t.Fatal("stream should be dropped on overflow")
+	}
+	assert.Empty(t, h.streams)
+}
+
+func TestControlHub_resolve(t *testing.T) {
+	var h controlHub
+	h.reset([]*pb.ControlValue{{Key: "c0volume", Address: "ch1/volume", Value: 0.5}})
+	assert.Equal(t, "c0volume", h.resolve("ch1/volume"))
+	assert.Equal(t, "c0volume", h.resolve("c0volume"))
+	assert.Equal(t, "unknown", h.resolve("unknown"))
+
+	// changes by key and by address of the same control take one slot of queue
+	q := newControlQueue(time.Second, nil)
+	q.add(controlUpdate{key: h.resolve("c0volume"), value: 0.1})
+	q.add(controlUpdate{key: h.resolve("ch1/volume"), value: 0.2})
+	u, _, ok := q.next(time.Now())
+	require.True(t, ok)
+	assert.Equal(t, controlUpdate{key: "c0volume", value: 0.2}, u)
+	_, _, ok = q.next(time.Now())
+	assert.False(t, ok)
+}
+
+// controlValuesOnly strips protobuf internal state to compare values
+func controlValuesOnly(vals []*pb.ControlValue) []*pb.ControlValue {
+	res := make([]*pb.ControlValue, 0, len(vals))
+	for _, v := range vals {
+		res = append(res, &pb.ControlValue{Key: v.Key, Address: v.Address, Seq: v.Seq, Value: v.Value, ClientSeq: v.ClientSeq, Status: v.Status})
+	}
+	return res
+}
@@ -0,0 +1,30 @@
+package preset
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/raspidrum-srv/internal/model"
+)
+
+// applyEffects makes controls of effect params of preset from effects built by sampler,
+// so params are changed by SetValue. Must be called with mu held after ctrlHandler is created
+func (s *PresetServer) applyEffects(preset *model.KitPreset) error {
+	if len(preset.Fxs) == 0 {
+		return nil
+	}
+	sess, ok := s.sampler.Session()
+	if !ok || sess.PresetId != preset.Id {
+		return fmt.Errorf("preset %d isn't loaded", preset.Id)
+	}
+	s.ctrlHandler.effects = sess.Effects
+	var errs []error
+	for _, k := range slices.Sorted(maps.Keys(sess.Effects)) {
+		if err := preset.SetFxParams(k, sess.Effects[k].Params); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
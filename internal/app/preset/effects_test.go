@@ -0,0 +1,85 @@
+package preset
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raspidrum-srv/internal/app/audio"
+	m "github.com/raspidrum-srv/internal/model"
+	pb "github.com/raspidrum-srv/internal/pkg/grpc"
+	"github.com/raspidrum-srv/internal/repo"
+)
+
+// fakeFxSampler has effects built for preset
+type fakeFxSampler struct {
+	repo.SamplerRepo
+	session repo.SamplerSession
+	calls   []string
+}
+
+func (f *fakeFxSampler) Session() (repo.SamplerSession, bool) {
+	return f.session, true
+}
+
+func (f *fakeFxSampler) SetEffectParam(fxInstId int, param int, value float32) error {
+	f.calls = append(f.calls, fmt.Sprintf("fx %d %d %v", fxInstId, param, value))
+	return nil
+}
+
+func TestPresetServer_applyEffects(t *testing.T) {
+	var min, max float32 = 0, 1
+	sampler := &fakeFxSampler{session: repo.SamplerSession{
+		PresetId: 0,
+		Effects: repo.SamplerEffects{"plate": {ChainId: 1, InstanceId: 4, Params: []m.FxParam{
+			{Description: "bandwidth", Value: 0.5, Min: &min, Max: &max},
+			{Description: "mode", Value: 1, Possibilities: []float32{0, 1}},
+		}}},
+	}}
+	s := NewPresetServer(nil, sampler, audio.NewSettings(repo.AudioOutput{Driver: "ALSA"}), afero.NewMemMapFs())
+
+	pst := loadPresetFromYAML(t, "single_instrument_with_fxs.yaml")
+	require.NoError(t, pst.PrepareToLoad([]m.MIDIDevice{&MockMMIDIDevice{}}))
+	s.loadedPreset = pst
+	s.ctrlHandler = NewSamplerControlHandler(sampler, repo.SamplerChannels{"ch1": 0})
+	require.NoError(t, s.applyEffects(pst))
+
+	require.NoError(t, pst.SetControlValue("sampler/reverb/plate/p0", 0.25, s.ctrlHandler))
+	assert.Equal(t, []string{"fx 4 0 0.25"}, sampler.calls)
+
+	pbPreset, err := convertPresetToProto(pst)
+	require.NoError(t, err)
+	require.Equal(t, pb.ChannelType_CHANNEL_TYPE_SAMPLER, pbPreset.Channels[0].Type)
+	fxs := pbPreset.Channels[0].Fxs
+	require.Len(t, fxs, 1)
+	assert.Equal(t, "plate", fxs[0].Key)
+	assert.Equal(t, "Plate", fxs[0].Name)
+	require.Len(t, fxs[0].Params, 2)
+	p0, p1 := fxs[0].Params[0], fxs[0].Params[1]
+	assert.Equal(t, "x0platep0", p0.Key)
+	assert.Equal(t, "sampler/reverb/plate/p0", p0.Address)
+	assert.Equal(t, "bandwidth", p0.Name)
+	assert.Equal(t, pb.FXParamType_FX_PARAM_TYPE_RANGE, p0.Type)
+	assert.Equal(t, 0.25, p0.Value)
+	assert.Equal(t, 0.0, p0.GetMin())
+	assert.Equal(t, 1.0, p0.GetMax())
+	// stored value
+	assert.Equal(t, 0.7, p1.Value)
+	assert.Equal(t, pb.FXParamType_FX_PARAM_TYPE_FIXED, p1.Type)
+	assert.Nil(t, p1.Min)
+	assert.Len(t, p1.DiscreteVals, 2)
+
+	// FX send of channel
+	sends := pbPreset.Channels[1].Sends
+	require.Len(t, sends, 1)
+	assert.Equal(t, "ch1/reverb", sends[0].Address)
+	assert.Equal(t, 0.504, sends[0].Value)
+
+	// params and sends are controls of ChannelControl stream
+	values := controlValues(pbPreset)
+	assert.Contains(t, values, &pb.ControlValue{Key: "x0platep1", Address: "sampler/reverb/plate/p1", Value: 0.7})
+	assert.Contains(t, values, &pb.ControlValue{Key: sends[0].Key, Address: "ch1/reverb", Value: 0.504})
+}
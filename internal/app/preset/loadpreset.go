@@ -1,7 +1,10 @@
 package preset
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 
 	"github.com/spf13/afero"
 
@@ -11,38 +14,72 @@ import (
 	d "github.com/raspidrum-srv/internal/repo/db"
 )
 
-// TODO: init MIDI device on connect/reconnect (and startup)
-var mdev = midi.NewUSBMIDIDevice("0:0", "Dummy")
-var midiDevices = []m.MIDIDevice{
-	&mdev,
+// name of device, which maps MIDI Keys of preset when no MIDI device is connected
+const defaultMidiDevice = "Default"
+
+// ErrPresetNotLoaded is returned when operation needs the loaded preset
+var ErrPresetNotLoaded = errors.New("preset isn't loaded")
+
+// loads prepared preset into sampler devices
+type presetLoader func(audioDevId, midiDevId int, pst *m.KitPreset) (repo.SamplerChannels, error)
+
+// Loads the specified preset into the sampler and returns information about the loaded preset.
+// Preloaded preset is switched to without loading
+func LoadPreset(presetId int64, db *d.Sqlite, sampler repo.SamplerRepo, audio repo.AudioOutput, fs afero.Fs) (*m.KitPreset, repo.SamplerChannels, error) {
+	return loadPreset(presetId, db, sampler, audio, fs, func(audioDevId, midiDevId int, pst *m.KitPreset) (repo.SamplerChannels, error) {
+		return sampler.LoadPreset(audioDevId, midiDevId, pst, fs)
+	})
 }
 
-// Loads the specified preset into the sampler and returns information about the loaded preset
-func LoadPreset(presetId int64, db *d.Sqlite, sampler repo.SamplerRepo, fs afero.Fs) (*m.KitPreset, repo.SamplerChannels, error) {
+// LoadPresetAsync loads preset like LoadPreset, but instruments are loaded in background
+// and loading progress of preset channels is reported. Loading is canceled with ctx
+func LoadPresetAsync(ctx context.Context, presetId int64, db *d.Sqlite, sampler repo.SamplerRepo, audio repo.AudioOutput, fs afero.Fs, progress func(repo.ChannelProgress)) (*m.KitPreset, repo.SamplerChannels, error) {
+	return loadPreset(presetId, db, sampler, audio, fs, func(audioDevId, midiDevId int, pst *m.KitPreset) (repo.SamplerChannels, error) {
+		return sampler.LoadPresetAsync(ctx, audioDevId, midiDevId, pst, fs, progress)
+	})
+}
+
+func loadPreset(presetId int64, db *d.Sqlite, sampler repo.SamplerRepo, audio repo.AudioOutput, fs afero.Fs, load presetLoader) (*m.KitPreset, repo.SamplerChannels, error) {
 
 	// 1st step: get preset info from db
-	pst, err := db.GetPreset(d.ById(presetId))
+	pst, err := getPreset(presetId, db)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed LoadPreset: %w", err)
 	}
 
 	// 2nd step: augment channels and layers info from instrument and instrument preset
-	err = pst.PrepareToLoad(midiDevices)
+	mdevs, err := prepareToLoad(pst, db, fs)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, fmt.Errorf("failed LoadPreset: %w", err)
 	}
 
 	// 3rd step: substitute ids of MIDI Keys and MIDI CC
 	// skipped: substitute MIDI Keys needed only for generation sfz-ctrl files. MIDI CC stored in db and not needed for substitute
 
-	// 4rd step: init sampler
-	audioDevId, midiDevId, err := InitSampler(sampler)
+	chnls, ok, err := sampler.SwitchPreset(pst)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed init sampler: %w", err)
+		return nil, nil, fmt.Errorf("failed switch to preloaded preset: %w", err)
+	}
+	if ok {
+		return pst, chnls, nil
+	}
+
+	// 4rd step: init sampler. Devices are created once per sampler session
+	sess, ok := sampler.Session()
+	audioDevId, midiDevId := sess.AudioDevId, sess.MidiDevId
+	if !ok {
+		// remove leftovers of failed loading
+		if err = sampler.CloseSession(); err != nil {
+			slog.Warn("failed close sampler session", slog.Any("error", err))
+		}
+		audioDevId, midiDevId, err = InitSampler(sampler, audio, mdevs)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed init sampler: %w", err)
+		}
 	}
 
 	// 5th step: load to sampler
-	chnls, err := sampler.LoadPreset(audioDevId, midiDevId, pst, fs)
+	chnls, err = load(audioDevId, midiDevId, pst)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed load preset to sampler: %w", err)
 	}
@@ -50,6 +87,46 @@ func LoadPreset(presetId int64, db *d.Sqlite, sampler repo.SamplerRepo, fs afero
 	return pst, chnls, nil
 }
 
+// PreloadPreset loads preset into muted sampler channels, so switching to it doesn't load samples.
+// Preset is loaded into devices of the loaded preset
+func PreloadPreset(pst *m.KitPreset, db *d.Sqlite, sampler repo.SamplerRepo, fs afero.Fs) error {
+	sess, ok := sampler.Session()
+	if !ok {
+		return fmt.Errorf("failed preload preset %d: %w", pst.Id, ErrPresetNotLoaded)
+	}
+	if _, err := prepareToLoad(pst, db, fs); err != nil {
+		return fmt.Errorf("failed preload preset %d: %w", pst.Id, err)
+	}
+	return sampler.PreloadPreset(sess.AudioDevId, sess.MidiDevId, pst, fs)
+}
+
+// sets MIDI Keys mapping of connected MIDI devices to preset. Returns connected devices
+func prepareToLoad(pst *m.KitPreset, db *d.Sqlite, fs afero.Fs) ([]midi.USBMIDIDevice, error) {
+	mdevs, err := midi.ListAlsaDevices(fs)
+	if err != nil {
+		return nil, err
+	}
+	kdevs := mdevs
+	if len(kdevs) == 0 {
+		kdevs = []midi.USBMIDIDevice{midi.NewUSBMIDIDevice("", defaultMidiDevice)}
+	}
+	if err = midi.LoadKeysMappings(kdevs, db); err != nil {
+		return nil, err
+	}
+	if err = pst.PrepareToLoad(keyMappingDevices(kdevs)); err != nil {
+		return nil, err
+	}
+	return mdevs, nil
+}
+
+func keyMappingDevices(mdevs []midi.USBMIDIDevice) []m.MIDIDevice {
+	res := make([]m.MIDIDevice, len(mdevs))
+	for i := range mdevs {
+		res[i] = &mdevs[i]
+	}
+	return res
+}
+
 // deprecated
 // old func for testing load one instrument-file in new sampler channel
 func LoadPresetToSampler(sampler repo.SamplerRepo, audDevId, midiDevId int, instrumentFile string) (chnl int, err error) {
@@ -0,0 +1,113 @@
+package preset
+
+import (
+	"log/slog"
+	"slices"
+	"sync"
+)
+
+// midiCCQueue handles MIDI CC events by single worker, so LSCP event reader doesn't wait for preset lock.
+// Queued value of CC is replaced by the latest one, so queue size is limited by count of channels and CCs
+type midiCCQueue struct {
+	handle func(samplerChn int, cc int, value int)
+	once   sync.Once
+	wake   chan struct{}
+
+	mu      sync.Mutex
+	pending map[midiCC]int
+	// CCs of pending values in order of queueing
+	order []midiCC
+}
+
+func newMidiCCQueue(handle func(samplerChn int, cc int, value int)) *midiCCQueue {
+	return &midiCCQueue{
+		handle:  handle,
+		wake:    make(chan struct{}, 1),
+		pending: map[midiCC]int{},
+	}
+}
+
+// push queues value. Worker is started on the first value
+func (q *midiCCQueue) push(samplerChn int, cc int, value int) {
+	q.once.Do(func() {
+		go q.run()
+	})
+	q.add(samplerChn, cc, value)
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// add replaces queued value of CC
+func (q *midiCCQueue) add(samplerChn int, cc int, value int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	key := midiCC{samplerChn, cc}
+	if _, ok := q.pending[key]; !ok {
+		q.order = append(q.order, key)
+	}
+	q.pending[key] = value
+}
+
+// next returns the first queued value
+func (q *midiCCQueue) next() (midiCC, int, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.order) == 0 {
+		return midiCC{}, 0, false
+	}
+	key := q.order[0]
+	value := q.pending[key]
+	delete(q.pending, key)
+	q.order = slices.Delete(q.order, 0, 1)
+	return key, value, true
+}
+
+func (q *midiCCQueue) run() {
+	for {
+		key, value, ok := q.next()
+		if !ok {
+			<-q.wake
+			continue
+		}
+		q.handle(key.samplerChn, key.cc, value)
+	}
+}
+
+// HandleMidiCC queues value of MIDI CC received by sampler channel. It's called by LSCP event reader,
+// so value is applied to the loaded preset in background
+func (s *PresetServer) HandleMidiCC(samplerChn int, cc int, value int) {
+	s.midiCC.push(samplerChn, cc, value)
+}
+
+// applyMidiCC stores value of MIDI CC received by sampler channel into the loaded preset,
+// so faders of UI follow knobs of drum module. Changed values are sent to ChannelControl streams.
+// CC values, which sampler channel already has (i.e. sent by server itself), are skipped
+func (s *PresetServer) applyMidiCC(samplerChn int, cc int, value int) {
+	s.mu.Lock()
+	if s.loadedPreset == nil || s.ctrlHandler == nil {
+		s.mu.Unlock()
+		return
+	}
+	// channel of preloaded preset
+	chnlKey, ok := s.ctrlHandler.channelKey(samplerChn)
+	if !ok || !s.ctrlHandler.received(samplerChn, cc, value) {
+		s.mu.Unlock()
+		return
+	}
+	changed := s.loadedPreset.SetControlMidiValue(chnlKey, cc, value)
+	for _, ctrl := range changed {
+		val, _, _ := ctrl.GetNormalizedValue()
+		s.controls.publish(ctrl.Key, ctrl.Address, roundFloat(float64(val), 3), nil, 0)
+		slog.Debug("control changed by MIDI CC", slog.String("key", ctrl.Key), slog.Int("cc", cc), slog.Int("value", value))
+	}
+	if len(changed) > 0 {
+		s.markChanged()
+	}
+	s.mu.Unlock()
+
+	if len(changed) > 0 && s.autosave != nil {
+		s.autosave.Trigger()
+	}
+}
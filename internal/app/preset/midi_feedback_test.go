@@ -0,0 +1,115 @@
+package preset
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raspidrum-srv/internal/app/audio"
+	m "github.com/raspidrum-srv/internal/model"
+	pb "github.com/raspidrum-srv/internal/pkg/grpc"
+	"github.com/raspidrum-srv/internal/repo"
+)
+
+func TestPresetServer_applyMidiCC(t *testing.T) {
+	sampler := &fakeRestoreSampler{}
+	s := NewPresetServer(nil, sampler, audio.NewSettings(repo.AudioOutput{Driver: "ALSA"}), afero.NewMemMapFs())
+	// preset isn't loaded
+	s.applyMidiCC(3, 30, 100)
+
+	pst := loadPresetFromYAML(t, "single_instrument.yaml")
+	require.NoError(t, pst.PrepareToLoad([]m.MIDIDevice{&MockMMIDIDevice{}}))
+	s.loadedPreset = pst
+	s.ctrlHandler = NewSamplerControlHandler(sampler, repo.SamplerChannels{"ch1": 3})
+	cs := s.controls.add(nil)
+
+	// sampler notifies about CC sent by server
+	require.NoError(t, s.loadedPreset.SetControlValue("i0volume", 0.5, s.ctrlHandler))
+	s.applyMidiCC(3, 30, 64)
+	// channel of preloaded preset
+	s.applyMidiCC(5, 30, 10)
+	assert.Empty(t, cs.ch)
+	assert.False(t, s.dirty)
+
+	s.applyMidiCC(3, 30, 100)
+	require.Len(t, cs.ch, 1)
+	v := <-cs.ch
+	assert.Equal(t, "i0volume", v.Key)
+	assert.Equal(t, 0.787, v.Value)
+	assert.True(t, s.dirty)
+
+	// knob is turned back to value sent by server
+	s.applyMidiCC(3, 30, 64)
+	require.Len(t, cs.ch, 1)
+	assert.Equal(t, &pb.ControlValue{Key: "i0volume", Address: "ch1/kick/volume", Seq: 2, Value: 0.504, Status: pb.ControlStatus_CONTROL_STATUS_OK}, controlValuesOnly([]*pb.ControlValue{<-cs.ch})[0])
+}
+
+func TestMidiCCQueue_next(t *testing.T) {
+	q := newMidiCCQueue(nil)
+	// knob turning
+	for i := 1; i <= 100; i++ {
+		q.add(3, 30, i)
+	}
+	q.add(3, 31, 10)
+	q.add(3, 30, 101)
+
+	// the latest value wins, order of queueing is kept
+	key, value, ok := q.next()
+	require.True(t, ok)
+	assert.Equal(t, midiCC{3, 30}, key)
+	assert.Equal(t, 101, value)
+	key, value, ok = q.next()
+	require.True(t, ok)
+	assert.Equal(t, midiCC{3, 31}, key)
+	assert.Equal(t, 10, value)
+	_, _, ok = q.next()
+	assert.False(t, ok)
+}
+
+func TestPresetServer_HandleMidiCC(t *testing.T) {
+	sampler := &fakeRestoreSampler{}
+	s := NewPresetServer(nil, sampler, audio.NewSettings(repo.AudioOutput{Driver: "ALSA"}), afero.NewMemMapFs())
+	pst := loadPresetFromYAML(t, "single_instrument.yaml")
+	require.NoError(t, pst.PrepareToLoad([]m.MIDIDevice{&MockMMIDIDevice{}}))
+	s.loadedPreset = pst
+	s.ctrlHandler = NewSamplerControlHandler(sampler, repo.SamplerChannels{"ch1": 3})
+	cs := s.controls.add(nil)
+
+	// event reader isn't blocked while preset is locked, i.e. by applying of control
+	s.mu.Lock()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i <= 100; i++ {
+			s.HandleMidiCC(3, 30, i)
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("HandleMidiCC waits for preset lock")
+	}
+	s.mu.Unlock()
+
+	require.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.dirty
+	}, time.Second, 10*time.Millisecond)
+	// the latest value is applied last
+	require.Eventually(t, func() bool {
+		for {
+			select {
+			case v := <-cs.ch:
+				if v.Value == 0.787 {
+					return true
+				}
+			default:
+				return false
+			}
+		}
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,91 @@
+package preset
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/raspidrum-srv/internal/repo"
+)
+
+// part of repo.SamplerRepo, which is used by preloader
+type preloadSampler interface {
+	Session() (session repo.SamplerSession, ok bool)
+	PreloadedPresets() []int64
+	ReleasePreset(presetId int64) error
+}
+
+// preloader keeps the loaded and preloaded presets within memory budget.
+// Least recently used preloaded presets are released first
+type preloader struct {
+	mu     sync.Mutex
+	budget int64
+	// estimated memory of presets in sampler. Key - preset id
+	sizes map[int64]int64
+	// preset ids, least recently used first
+	used []int64
+}
+
+func newPreloader(budget int64) *preloader {
+	return &preloader{
+		budget: budget,
+		sizes:  map[int64]int64{},
+	}
+}
+
+// enabled reports if presets can be kept in sampler besides the loaded one
+func (p *preloader) enabled() bool {
+	return p.budget > 0
+}
+
+// touch registers preset in sampler as the most recently used
+func (p *preloader) touch(presetId int64, size int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.used = slices.DeleteFunc(p.used, func(id int64) bool { return id == presetId })
+	p.used = append(p.used, presetId)
+	p.sizes[presetId] = size
+}
+
+// shrink releases preloaded presets until presets in sampler and extra memory fit budget.
+// The loaded preset and presets from keep aren't released. Returns false if budget can't be reached
+func (p *preloader) shrink(sampler preloadSampler, extra int64, keep ...int64) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	inSampler := map[int64]bool{}
+	sess, loaded := sampler.Session()
+	if loaded {
+		inSampler[sess.PresetId] = true
+	}
+	for _, id := range sampler.PreloadedPresets() {
+		inSampler[id] = true
+	}
+	// forget presets removed from sampler, i.e. by CloseSession
+	p.used = slices.DeleteFunc(p.used, func(id int64) bool {
+		if !inSampler[id] {
+			delete(p.sizes, id)
+			return true
+		}
+		return false
+	})
+
+	total := extra
+	for _, id := range p.used {
+		total += p.sizes[id]
+	}
+	for i := 0; total > p.budget && i < len(p.used); {
+		id := p.used[i]
+		if (loaded && id == sess.PresetId) || slices.Contains(keep, id) {
+			i++
+			continue
+		}
+		if err := sampler.ReleasePreset(id); err != nil {
+			return false, fmt.Errorf("failed release preloaded preset: %w", err)
+		}
+		total -= p.sizes[id]
+		delete(p.sizes, id)
+		p.used = slices.Delete(p.used, i, i+1)
+	}
+	return total <= p.budget, nil
+}
@@ -0,0 +1,90 @@
+package preset
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	m "github.com/raspidrum-srv/internal/model"
+	"github.com/raspidrum-srv/internal/repo"
+)
+
+type fakePreloadSampler struct {
+	loaded    int64
+	preloaded map[int64]bool
+	released  []int64
+}
+
+func (f *fakePreloadSampler) Session() (repo.SamplerSession, bool) {
+	return repo.SamplerSession{PresetId: f.loaded}, f.loaded != 0
+}
+
+func (f *fakePreloadSampler) PreloadedPresets() []int64 {
+	return slices.Sorted(maps.Keys(f.preloaded))
+}
+
+func (f *fakePreloadSampler) ReleasePreset(presetId int64) error {
+	delete(f.preloaded, presetId)
+	f.released = append(f.released, presetId)
+	return nil
+}
+
+func TestPreloader_Shrink(t *testing.T) {
+	s := &fakePreloadSampler{loaded: 1, preloaded: map[int64]bool{2: true, 3: true, 4: true}}
+	p := newPreloader(100)
+	p.touch(2, 30)
+	p.touch(3, 30)
+	p.touch(4, 30)
+	p.touch(1, 40)
+	// used again, so it's released after 3
+	p.touch(2, 30)
+
+	ok, err := p.shrink(s, 0)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []int64{3}, s.released)
+
+	// the loaded preset and kept presets aren't released
+	ok, err = p.shrink(s, 50, 2)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, []int64{3, 4}, s.released)
+
+	ok, err = p.shrink(s, 50)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []int64{3, 4, 2}, s.released)
+}
+
+func TestPreloader_ForgetsRemovedPresets(t *testing.T) {
+	s := &fakePreloadSampler{loaded: 1, preloaded: map[int64]bool{}}
+	p := newPreloader(100)
+	p.touch(2, 90)
+	p.touch(1, 10)
+
+	// preset 2 was removed from sampler, i.e. by CloseSession
+	ok, err := p.shrink(s, 90)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Empty(t, s.released)
+	assert.Equal(t, []int64{1}, p.used)
+}
+
+func TestPresetServer_keepPreloaded(t *testing.T) {
+	prev := &m.KitPreset{Id: 2}
+	s := &PresetServer{preload: newPreloader(0)}
+	// preloading is disabled, previous preset is always released
+	assert.False(t, s.keepPreloaded(2, prev, false))
+	assert.False(t, s.keepPreloaded(2, nil, false))
+
+	s.preload = newPreloader(100)
+	assert.True(t, s.keepPreloaded(2, prev, false))
+	assert.True(t, s.keepPreloaded(2, nil, false))
+	// unsaved changes
+	assert.False(t, s.keepPreloaded(2, prev, true))
+	// saved as new preset
+	assert.False(t, s.keepPreloaded(3, prev, false))
+}
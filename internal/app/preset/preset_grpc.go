@@ -2,9 +2,16 @@ package preset
 
 import (
 	"context"
-	"io"
+	"errors"
+	"fmt"
+	"log/slog"
 	"math"
+	"slices"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	pb "github.com/raspidrum-srv/internal/pkg/grpc"
 	"github.com/spf13/afero"
@@ -12,6 +19,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/raspidrum-srv/internal/app/audio"
 	"github.com/raspidrum-srv/internal/model"
 	"github.com/raspidrum-srv/internal/repo"
 	d "github.com/raspidrum-srv/internal/repo/db"
@@ -20,31 +28,141 @@ import (
 type PresetServer struct {
 	pb.UnimplementedKitPresetServer
 	pb.UnimplementedChannelControlServer
-	db           *d.Sqlite
-	sampler      repo.SamplerRepo
-	ctrlHandler  *SamplerControlHandler
-	fs           afero.Fs
+	db          *d.Sqlite
+	sampler     repo.SamplerRepo
+	audio       *audio.Settings
+	ctrlHandler *SamplerControlHandler
+	fs          afero.Fs
+	// serializes loading and preloading of presets
+	loadMu sync.Mutex
+	// guards loadedPreset, ctrlHandler, dirty and changes
+	mu           sync.Mutex
 	loadedPreset *model.KitPreset
+	// loaded preset has unsaved control changes
+	dirty bool
+	// count of control changes. Saving clears dirty only if there were no changes during it
+	changes  int64
+	autosave *autosaver
+	preload  *preloader
+	watchers presetWatchers
+	controls controlHub
+	activity activityWatchers
+	queue    *controlQueue
+	midiCC   *midiCCQueue
+	// keys of activity events of the loaded preset
+	activityKeys atomic.Pointer[activityKeys]
 }
 
-func NewPresetServer(db *d.Sqlite, sampler repo.SamplerRepo, fs afero.Fs) *PresetServer {
-	return &PresetServer{
+func NewPresetServer(db *d.Sqlite, sampler repo.SamplerRepo, audio *audio.Settings, fs afero.Fs) *PresetServer {
+	s := &PresetServer{
 		db:      db,
 		sampler: sampler,
+		audio:   audio,
 		fs:      fs,
+		preload: newPreloader(0),
 	}
+	s.queue = newControlQueue(controlInterval, s.applyControl)
+	s.midiCC = newMidiCCQueue(s.applyMidiCC)
+	return s
+}
+
+// EnableAutosave turns on saving of the loaded preset after delay since the last control change
+func (s *PresetServer) EnableAutosave(delay time.Duration) {
+	s.autosave = newAutosaver(delay, s.autosavePreset)
+}
+
+// EnablePreload allows to keep presets in sampler within memory budget in bytes
+func (s *PresetServer) EnablePreload(budget int64) {
+	s.preload = newPreloader(budget)
 }
 
 func (s *PresetServer) LoadPreset(ctx context.Context, req *pb.GetPresetRequest) (*pb.PresetResponse, error) {
-	preset, chnls, err := LoadPreset(req.PresetId, s.db, s.sampler, s.fs)
+	return s.loadPreset(ctx, req.PresetId, false, nil)
+}
+
+func (s *PresetServer) LoadPresetAsync(req *pb.GetPresetRequest, stream grpc.ServerStreamingServer[pb.LoadPresetProgress]) error {
+	var sendErr error
+	progress := func(p repo.ChannelProgress) {
+		if sendErr != nil {
+			return
+		}
+		sendErr = stream.Send(&pb.LoadPresetProgress{
+			ChannelKey: p.ChannelKey,
+			Percent:    int32(p.Percent),
+		})
+		if sendErr != nil {
+			slog.Warn("failed send preset loading progress", slog.Any("error", sendErr))
+		}
+	}
+	resp, err := s.loadPreset(stream.Context(), req.PresetId, false, progress)
 	if err != nil {
+		return err
+	}
+	if sendErr != nil {
+		return sendErr
+	}
+	return stream.Send(&pb.LoadPresetProgress{Preset: resp.Preset})
+}
+
+// reset removes all presets and devices from sampler before loading.
+// If progress is set, instruments are loaded in background and loading is canceled with ctx
+func (s *PresetServer) loadPreset(ctx context.Context, presetId int64, reset bool, progress func(repo.ChannelProgress)) (*pb.PresetResponse, error) {
+	s.loadMu.Lock()
+	defer s.loadMu.Unlock()
+
+	// don't lose pending changes of the current preset
+	if s.autosave != nil {
+		s.autosave.Flush()
+	}
+	if reset {
+		if err := s.sampler.CloseSession(); err != nil {
+			slog.Warn("failed close sampler session", slog.Any("error", err))
+		}
+	}
+
+	prevSess, prevLoaded := s.sampler.Session()
+	var preset *model.KitPreset
+	var chnls repo.SamplerChannels
+	var err error
+	if progress == nil {
+		preset, chnls, err = LoadPreset(presetId, s.db, s.sampler, s.audio.Get(), s.fs)
+	} else {
+		preset, chnls, err = LoadPresetAsync(ctx, presetId, s.db, s.sampler, s.audio.Get(), s.fs, progress)
+	}
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
 		return nil, status.Errorf(codes.Internal, "failed to load preset: %v", err)
 	}
 
+	s.mu.Lock()
+	prev, dirty := s.loadedPreset, s.dirty
 	s.loadedPreset = preset
 	s.ctrlHandler = NewSamplerControlHandler(s.sampler, chnls)
+	s.activityKeys.Store(newActivityKeys(preset, chnls))
+	s.dirty = false
+	err = preset.ApplyMuteState(s.ctrlHandler)
+	ferr := s.applyEffects(preset)
+	s.mu.Unlock()
+	if err != nil {
+		slog.Warn("failed apply mute state of preset", slog.Int64("presetId", preset.Id), slog.Any("error", err))
+	}
+	if ferr != nil {
+		slog.Warn("failed apply effects of preset", slog.Int64("presetId", preset.Id), slog.Any("error", ferr))
+	}
 
+	if prevLoaded && prevSess.PresetId != preset.Id && !s.keepPreloaded(prevSess.PresetId, prev, dirty) {
+		s.releasePreset(prevSess.PresetId)
+	}
+	s.usePreset(preset)
+
+	s.mu.Lock()
 	pbPreset, err := convertPresetToProto(preset)
+	if err == nil {
+		s.controls.reset(controlValues(pbPreset))
+	}
+	s.mu.Unlock()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to convert preset: %v", err)
 	}
@@ -54,12 +172,100 @@ func (s *PresetServer) LoadPreset(ctx context.Context, req *pb.GetPresetRequest)
 	}, nil
 }
 
+// keepPreloaded reports if channels of previously loaded preset can stay in sampler.
+// It's only if preloading is enabled and its sampler channels match db.
+// Unsaved changes exist only in sampler channels, preset saved as new one has other id in db.
+// With disabled preloading channels are removed regardless of estimated preset memory
+func (s *PresetServer) keepPreloaded(presetId int64, prev *model.KitPreset, dirty bool) bool {
+	if !s.preload.enabled() {
+		return false
+	}
+	return prev == nil || !dirty && prev.Id == presetId
+}
+
+// ReloadPreset loads the loaded preset from db into new sampler devices, i.e. to apply new audio output.
+// Preloaded presets are released. Does nothing if preset isn't loaded
+func (s *PresetServer) ReloadPreset() error {
+	s.mu.Lock()
+	loaded := s.loadedPreset
+	s.mu.Unlock()
+	if loaded == nil {
+		return nil
+	}
+	_, err := s.loadPreset(context.Background(), loaded.Id, true, nil)
+	return err
+}
+
+func (s *PresetServer) PreloadPresets(ctx context.Context, req *pb.PreloadPresetsRequest) (*pb.PreloadPresetsResponse, error) {
+	s.loadMu.Lock()
+	defer s.loadMu.Unlock()
+
+	sess, ok := s.sampler.Session()
+	if !ok {
+		return nil, presetStatusErr("failed to preload presets", ErrPresetNotLoaded)
+	}
+	preloaded := s.sampler.PreloadedPresets()
+	var keep []int64
+	for _, id := range req.PresetIds {
+		if id == sess.PresetId || slices.Contains(preloaded, id) {
+			keep = append(keep, id)
+			continue
+		}
+		pst, err := getPreset(id, s.db)
+		if err != nil {
+			return nil, presetStatusErr("failed to preload presets", err)
+		}
+		size, err := s.sampler.PresetMemory(pst, s.fs)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to preload presets: %v", err)
+		}
+		fits, err := s.preload.shrink(s.sampler, size, keep...)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to preload presets: %v", err)
+		}
+		if !fits {
+			slog.Warn("preset doesn't fit preload budget", slog.Int64("presetId", id), slog.Int64("size", size))
+			continue
+		}
+		if err := PreloadPreset(pst, s.db, s.sampler, s.fs); err != nil {
+			return nil, presetStatusErr("failed to preload presets", err)
+		}
+		s.preload.touch(id, size)
+		keep = append(keep, id)
+	}
+	return &pb.PreloadPresetsResponse{PresetIds: s.sampler.PreloadedPresets()}, nil
+}
+
+// usePreset marks the loaded preset as the most recently used and releases preloaded presets over budget
+func (s *PresetServer) usePreset(pst *model.KitPreset) {
+	size, err := s.sampler.PresetMemory(pst, s.fs)
+	if err != nil {
+		slog.Warn("failed estimate preset memory", slog.Int64("presetId", pst.Id), slog.Any("error", err))
+	}
+	s.preload.touch(pst.Id, size)
+	if _, err := s.preload.shrink(s.sampler, 0); err != nil {
+		slog.Error(fmt.Sprintln(err))
+	}
+}
+
+// releasePreset removes preloaded copy of preset, which doesn't match db anymore
+func (s *PresetServer) releasePreset(presetId int64) {
+	if err := s.sampler.ReleasePreset(presetId); err != nil {
+		slog.Error(fmt.Sprintln(err))
+	}
+}
+
 func (s *PresetServer) GetPreset(ctx context.Context, req *pb.GetPresetRequest) (*pb.PresetResponse, error) {
-	if s.loadedPreset == nil || s.loadedPreset.Id != req.PresetId {
+	s.mu.Lock()
+	loaded := s.loadedPreset
+	s.mu.Unlock()
+	if loaded == nil || loaded.Id != req.PresetId {
 		return s.LoadPreset(ctx, req)
 	}
 
-	pbPreset, err := convertPresetToProto(s.loadedPreset)
+	s.mu.Lock()
+	pbPreset, err := convertPresetToProto(loaded)
+	s.mu.Unlock()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to convert preset: %v", err)
 	}
@@ -69,30 +275,153 @@ func (s *PresetServer) GetPreset(ctx context.Context, req *pb.GetPresetRequest)
 	}, nil
 }
 
-func (s *PresetServer) SetValue(stream grpc.BidiStreamingServer[pb.ControlValue, pb.ControlValue]) error {
-	for {
-		in, err := stream.Recv()
-		if err == io.EOF {
-			return nil
-		}
-		if err != nil {
-			return err
-		}
-		// set value
-		err = s.loadedPreset.SetControlValue(in.Key, float32(in.Value), s.ctrlHandler)
-		if err != nil {
-			return status.Errorf(codes.Internal, "failed to set control value: %v", err)
-		}
-		// send response
-		out := &pb.ControlValue{
-			Key:   in.Key,
-			Seq:   in.Seq,
-			Value: in.Value,
-		}
-		if err := stream.Send(out); err != nil {
-			return err
-		}
+func (s *PresetServer) CreatePreset(ctx context.Context, req *pb.CreatePresetRequest) (*pb.PresetRefResponse, error) {
+	pst := convertPresetDefToModel(req.Preset)
+	id, err := CreatePreset(pst, s.db)
+	if err != nil {
+		return nil, presetStatusErr("failed to create preset", err)
+	}
+	return &pb.PresetRefResponse{PresetId: id, Key: pst.Uid, Name: pst.Name}, nil
+}
+
+func (s *PresetServer) UpdatePreset(ctx context.Context, req *pb.UpdatePresetRequest) (*pb.PresetRefResponse, error) {
+	pst := convertPresetDefToModel(req.Preset)
+	err := UpdatePreset(req.PresetId, pst, s.db)
+	if err != nil {
+		return nil, presetStatusErr("failed to update preset", err)
 	}
+	s.releasePreset(req.PresetId)
+	return &pb.PresetRefResponse{PresetId: pst.Id, Key: pst.Uid, Name: pst.Name}, nil
+}
+
+func (s *PresetServer) RenamePreset(ctx context.Context, req *pb.RenamePresetRequest) (*pb.PresetRefResponse, error) {
+	err := RenamePreset(req.PresetId, req.Name, s.db)
+	if err != nil {
+		return nil, presetStatusErr("failed to rename preset", err)
+	}
+	resp := &pb.PresetRefResponse{PresetId: req.PresetId, Name: req.Name}
+	s.mu.Lock()
+	if s.loadedPreset != nil && s.loadedPreset.Id == req.PresetId {
+		s.loadedPreset.Name = req.Name
+		resp.Key = s.loadedPreset.Uid
+	}
+	s.mu.Unlock()
+	return resp, nil
+}
+
+func (s *PresetServer) ClonePreset(ctx context.Context, req *pb.ClonePresetRequest) (*pb.PresetRefResponse, error) {
+	pst, err := ClonePreset(req.PresetId, req.Name, s.db)
+	if err != nil {
+		return nil, presetStatusErr("failed to clone preset", err)
+	}
+	return &pb.PresetRefResponse{PresetId: pst.Id, Key: pst.Uid, Name: pst.Name}, nil
+}
+
+func (s *PresetServer) DeletePreset(ctx context.Context, req *pb.DeletePresetRequest) (*pb.DeletePresetResponse, error) {
+	err := DeletePreset(req.PresetId, s.db)
+	if err != nil {
+		return nil, presetStatusErr("failed to delete preset", err)
+	}
+	s.releasePreset(req.PresetId)
+	return &pb.DeletePresetResponse{}, nil
+}
+
+func (s *PresetServer) SavePreset(ctx context.Context, req *pb.SavePresetRequest) (*pb.PresetRefResponse, error) {
+	pst, changes := s.loadedToStore()
+	if pst == nil {
+		return nil, status.Error(codes.FailedPrecondition, "preset isn't loaded")
+	}
+	err := UpdatePreset(pst.Id, pst, s.db)
+	if err != nil {
+		return nil, presetStatusErr("failed to save preset", err)
+	}
+	s.markSaved(changes)
+	return &pb.PresetRefResponse{PresetId: pst.Id, Key: pst.Uid, Name: pst.Name}, nil
+}
+
+func (s *PresetServer) SavePresetAs(ctx context.Context, req *pb.SavePresetAsRequest) (*pb.PresetRefResponse, error) {
+	s.mu.Lock()
+	loaded := s.loadedPreset
+	s.mu.Unlock()
+	pst, changes := s.loadedToStore()
+	if pst == nil {
+		return nil, status.Error(codes.FailedPrecondition, "preset isn't loaded")
+	}
+	pst.Id = 0
+	pst.Uid = ""
+	pst.Name = req.Name
+	id, err := CreatePreset(pst, s.db)
+	if err != nil {
+		return nil, presetStatusErr("failed to save preset", err)
+	}
+
+	// saved copy becomes the loaded preset
+	s.mu.Lock()
+	if s.loadedPreset == loaded {
+		s.loadedPreset.Id = id
+		s.loadedPreset.Uid = pst.Uid
+		s.loadedPreset.Name = pst.Name
+	}
+	s.mu.Unlock()
+	s.markSaved(changes)
+	return &pb.PresetRefResponse{PresetId: id, Key: pst.Uid, Name: pst.Name}, nil
+}
+
+// loadedToStore returns storable copy of the loaded preset and count of control changes at the time of copying.
+// Returns nil preset if preset isn't loaded
+func (s *PresetServer) loadedToStore() (*model.KitPreset, int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.loadedPreset == nil {
+		return nil, 0
+	}
+	return s.loadedPreset.ToStore(), s.changes
+}
+
+// markChanged marks the loaded preset as having unsaved changes. Must be called with mu held
+func (s *PresetServer) markChanged() {
+	s.dirty = true
+	s.changes++
+}
+
+// markSaved marks the loaded preset as saved, unless controls were changed since copying it for store
+func (s *PresetServer) markSaved(changes int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.changes == changes {
+		s.dirty = false
+	}
+}
+
+func (s *PresetServer) autosavePreset() {
+	pst, changes := s.loadedToStore()
+	if pst == nil {
+		return
+	}
+	if err := UpdatePreset(pst.Id, pst, s.db); err != nil {
+		slog.Error("failed autosave preset", slog.Int64("presetId", pst.Id), slog.Any("error", err))
+		// preset stays dirty, saving is retried after delay or on flush
+		s.autosave.Trigger()
+		return
+	}
+	s.markSaved(changes)
+	slog.Debug("preset autosaved", slog.Int64("presetId", pst.Id))
+}
+
+// presetStatusErr maps preset store errors to grpc status codes
+func presetStatusErr(msg string, err error) error {
+	code := codes.Internal
+	switch {
+	case errors.Is(err, ErrInvalidPreset):
+		code = codes.InvalidArgument
+	case errors.Is(err, d.ErrNotFound):
+		code = codes.NotFound
+	case errors.Is(err, d.ErrAlreadyExists):
+		code = codes.AlreadyExists
+	case errors.Is(err, ErrPresetNotLoaded):
+		code = codes.FailedPrecondition
+	}
+	return status.Errorf(code, "%s: %v", msg, err)
 }
 
 // convertPresetToProto converts internal KitPreset model to protobuf Preset message
@@ -124,12 +453,27 @@ func convertPresetToProto(kitPreset *model.KitPreset) (*pb.Preset, error) {
 		for ctrl := range ch.GetControls() {
 			val, min, max := ctrl.GetNormalizedValue()
 			if ctrl.Type == model.CtrlVolume {
-				pbChannel.Volume = &pb.BaseControl{Key: string(ctrl.Key), Name: ctrl.Name, Value: roundFloat(float64(val), 3), Min: makeFloat64Ptr(min), Max: makeFloat64Ptr(max)}
+				pbChannel.Volume = &pb.BaseControl{Key: string(ctrl.Key), Address: ctrl.Address, Name: ctrl.Name, Value: roundFloat(float64(val), 3), Min: makeFloat64Ptr(min), Max: makeFloat64Ptr(max)}
 			}
 			if ctrl.Type == model.CtrlPan {
-				pbChannel.Pan = &pb.BaseControl{Key: string(ctrl.Key), Name: ctrl.Name, Value: roundFloat(float64(val), 3), Min: makeFloat64Ptr(min), Max: makeFloat64Ptr(max)}
+				pbChannel.Pan = &pb.BaseControl{Key: string(ctrl.Key), Address: ctrl.Address, Name: ctrl.Name, Value: roundFloat(float64(val), 3), Min: makeFloat64Ptr(min), Max: makeFloat64Ptr(max)}
+			}
+			if ctrl.Type == model.CtrlMute {
+				pbChannel.Mute = &pb.BaseControl{Key: ctrl.Key, Address: ctrl.Address, Name: ctrl.Name, Value: float64(val), Min: makeFloat64Ptr(min), Max: makeFloat64Ptr(max)}
+			}
+			if ctrl.Type == model.CtrlSolo {
+				pbChannel.Solo = &pb.BaseControl{Key: ctrl.Key, Address: ctrl.Address, Name: ctrl.Name, Value: float64(val), Min: makeFloat64Ptr(min), Max: makeFloat64Ptr(max)}
+			}
+			if ctrl.Type == model.CtrlSend {
+				pbChannel.Sends = append(pbChannel.Sends, &pb.BaseControl{Key: ctrl.Key, Address: ctrl.Address, Name: ctrl.Name, Value: roundFloat(float64(val), 3), Min: makeFloat64Ptr(min), Max: makeFloat64Ptr(max)})
 			}
 		}
+		slices.SortFunc(pbChannel.Sends, func(a, b *pb.BaseControl) int { return strings.Compare(a.Key, b.Key) })
+
+		// effects of audio output are effects of sampler channel
+		if ch.Key == model.SamplerChannelKey {
+			pbChannel.Fxs = convertFxsToProto(kitPreset.Fxs)
+		}
 
 		// Convert instruments
 		pbChannel.Instruments = convertInstrumentToProto(instruments)
@@ -157,9 +501,13 @@ func convertInstrumentToProto(instruments []*model.PresetInstrument) []*pb.Instr
 			val, min, max := ctrl.GetNormalizedValue()
 			switch ctrl.Type {
 			case model.CtrlVolume:
-				pbInstrument.Volume = &pb.BaseControl{Key: ctrl.Key, Name: ctrl.Name, Value: roundFloat(float64(val), 3), Min: makeFloat64Ptr(min), Max: makeFloat64Ptr(max)}
+				pbInstrument.Volume = &pb.BaseControl{Key: ctrl.Key, Address: ctrl.Address, Name: ctrl.Name, Value: roundFloat(float64(val), 3), Min: makeFloat64Ptr(min), Max: makeFloat64Ptr(max)}
 			case model.CtrlPan:
-				pbInstrument.Pan = &pb.BaseControl{Key: ctrl.Key, Name: ctrl.Name, Value: roundFloat(float64(val), 3), Min: makeFloat64Ptr(min), Max: makeFloat64Ptr(max)}
+				pbInstrument.Pan = &pb.BaseControl{Key: ctrl.Key, Address: ctrl.Address, Name: ctrl.Name, Value: roundFloat(float64(val), 3), Min: makeFloat64Ptr(min), Max: makeFloat64Ptr(max)}
+			case model.CtrlMute:
+				pbInstrument.Mute = &pb.BaseControl{Key: ctrl.Key, Address: ctrl.Address, Name: ctrl.Name, Value: float64(val), Min: makeFloat64Ptr(min), Max: makeFloat64Ptr(max)}
+			case model.CtrlSolo:
+				pbInstrument.Solo = &pb.BaseControl{Key: ctrl.Key, Address: ctrl.Address, Name: ctrl.Name, Value: float64(val), Min: makeFloat64Ptr(min), Max: makeFloat64Ptr(max)}
 			default:
 				// Convert other controls to tunes
 				tune := &pb.FX{
@@ -168,12 +516,13 @@ func convertInstrumentToProto(instruments []*model.PresetInstrument) []*pb.Instr
 					// TODO: sort by control key
 					Order: int32(len(pbInstrument.Tunes)),
 					Params: []*pb.FXParam{{
-						Key:   ctrl.Key,
-						Name:  ctrl.Name,
-						Type:  pb.FXParamType_FX_PARAM_TYPE_RANGE,
-						Value: roundFloat(float64(val), 3),
-						Min:   makeFloat64Ptr(min),
-						Max:   makeFloat64Ptr(max),
+						Key:     ctrl.Key,
+						Address: ctrl.Address,
+						Name:    ctrl.Name,
+						Type:    pb.FXParamType_FX_PARAM_TYPE_RANGE,
+						Value:   roundFloat(float64(val), 3),
+						Min:     makeFloat64Ptr(min),
+						Max:     makeFloat64Ptr(max),
 					}},
 				}
 				pbInstrument.Tunes = append(pbInstrument.Tunes, tune)
@@ -190,9 +539,13 @@ func convertInstrumentToProto(instruments []*model.PresetInstrument) []*pb.Instr
 				val, min, max := ctrl.GetNormalizedValue()
 				switch ctrl.Type {
 				case model.CtrlVolume:
-					pbLayer.Volume = &pb.BaseControl{Key: ctrl.Key, Name: ctrl.Name, Value: roundFloat(float64(val), 3), Min: makeFloat64Ptr(min), Max: makeFloat64Ptr(max)}
+					pbLayer.Volume = &pb.BaseControl{Key: ctrl.Key, Address: ctrl.Address, Name: ctrl.Name, Value: roundFloat(float64(val), 3), Min: makeFloat64Ptr(min), Max: makeFloat64Ptr(max)}
 				case model.CtrlPan:
-					pbLayer.Pan = &pb.BaseControl{Key: ctrl.Key, Name: ctrl.Name, Value: roundFloat(float64(val), 3), Min: makeFloat64Ptr(min), Max: makeFloat64Ptr(max)}
+					pbLayer.Pan = &pb.BaseControl{Key: ctrl.Key, Address: ctrl.Address, Name: ctrl.Name, Value: roundFloat(float64(val), 3), Min: makeFloat64Ptr(min), Max: makeFloat64Ptr(max)}
+				case model.CtrlMute:
+					pbLayer.Mute = &pb.BaseControl{Key: ctrl.Key, Address: ctrl.Address, Name: ctrl.Name, Value: float64(val), Min: makeFloat64Ptr(min), Max: makeFloat64Ptr(max)}
+				case model.CtrlSolo:
+					pbLayer.Solo = &pb.BaseControl{Key: ctrl.Key, Address: ctrl.Address, Name: ctrl.Name, Value: float64(val), Min: makeFloat64Ptr(min), Max: makeFloat64Ptr(max)}
 				}
 			}
 
@@ -207,6 +560,124 @@ func convertInstrumentToProto(instruments []*model.PresetInstrument) []*pb.Instr
 	return res
 }
 
+// convertFxsToProto converts effects of all chains in order of chains. Effect has params only if sampler built it
+func convertFxsToProto(chains []model.PresetFxChain) []*pb.FX {
+	var res []*pb.FX
+	for _, chain := range chains {
+		for _, fx := range chain.Effects {
+			pbFx := &pb.FX{
+				Key:   fx.Key,
+				Name:  fx.Name,
+				Order: int32(len(res)),
+			}
+			if pbFx.Name == "" {
+				pbFx.Name = fx.Label
+			}
+			for i, ctrl := range fx.ParamControls() {
+				prm := ctrl.FxParam()
+				pbParam := &pb.FXParam{
+					Key:     ctrl.Key,
+					Address: ctrl.Address,
+					Name:    ctrl.Name,
+					Order:   int32(i),
+					Type:    pb.FXParamType_FX_PARAM_TYPE_RANGE,
+					Value:   roundFloat(float64(ctrl.Value), 3),
+				}
+				if prm.Min != nil {
+					pbParam.Min = makeFloat64Ptr(*prm.Min)
+				}
+				if prm.Max != nil {
+					pbParam.Max = makeFloat64Ptr(*prm.Max)
+				}
+				if len(prm.Possibilities) > 0 {
+					pbParam.Type = pb.FXParamType_FX_PARAM_TYPE_FIXED
+					for _, v := range prm.Possibilities {
+						pbParam.DiscreteVals = append(pbParam.DiscreteVals, &pb.FXParamDiscreteVal{Val: float64(v)})
+					}
+				}
+				pbFx.Params = append(pbFx.Params, pbParam)
+			}
+			res = append(res, pbFx)
+		}
+	}
+	return res
+}
+
+// convertPresetDefToModel converts protobuf PresetDef message to internal KitPreset model
+func convertPresetDefToModel(def *pb.PresetDef) *model.KitPreset {
+	pst := &model.KitPreset{
+		Kit:  model.KitRef{Uid: def.GetKitKey()},
+		Name: def.GetName(),
+	}
+	for _, ch := range def.GetChannels() {
+		pst.Channels = append(pst.Channels, model.PresetChannel{
+			Key:      ch.Key,
+			Name:     ch.Name,
+			Controls: convertControlDefsToModel(ch.Controls),
+		})
+	}
+	for _, instr := range def.GetInstruments() {
+		pi := model.PresetInstrument{
+			Instrument: model.InstrumentRef{Uid: instr.InstrumentKey},
+			Name:       instr.Name,
+			ChannelKey: instr.ChannelKey,
+			MidiKey:    instr.GetMidiKey(),
+			Controls:   convertControlDefsToModel(instr.Controls),
+		}
+		if len(instr.Layers) > 0 {
+			pi.Layers = make(map[string]model.PresetLayer, len(instr.Layers))
+			for k, l := range instr.Layers {
+				pi.Layers[k] = model.PresetLayer{
+					Name:     l.GetName(),
+					MidiKey:  l.GetMidiKey(),
+					Controls: convertControlDefsToModel(l.Controls),
+				}
+			}
+		}
+		pst.Instruments = append(pst.Instruments, pi)
+	}
+	for _, chain := range def.GetFxs() {
+		pc := model.PresetFxChain{
+			Key:  chain.Key,
+			Name: chain.GetName(),
+		}
+		for _, fx := range chain.Effects {
+			pf := model.PresetFx{
+				Key:    fx.Key,
+				Name:   fx.GetName(),
+				System: fx.GetSystem(),
+				Module: fx.Module,
+				Label:  fx.Label,
+			}
+			if len(fx.Params) > 0 {
+				pf.Params = make(model.ControlMap, len(fx.Params))
+				for k, v := range fx.Params {
+					pf.Params[k] = &model.PresetControl{Type: model.CtrlFxParam, Value: float32(v)}
+				}
+			}
+			pc.Effects = append(pc.Effects, pf)
+		}
+		pst.Fxs = append(pst.Fxs, pc)
+	}
+	return pst
+}
+
+func convertControlDefsToModel(defs map[string]*pb.PresetControlDef) model.ControlMap {
+	if len(defs) == 0 {
+		return nil
+	}
+	ctrls := make(model.ControlMap, len(defs))
+	for k, c := range defs {
+		ctrls[k] = &model.PresetControl{
+			Name:   c.GetName(),
+			Type:   c.GetType(),
+			MidiCC: int(c.GetMidiCc()),
+			Value:  float32(c.GetValue()),
+		}
+	}
+	return ctrls
+}
+
 func makeFloat64Ptr(v float32) *float64 {
 	res := float64(v)
 	return &res
@@ -1,13 +1,18 @@
 package preset
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/raspidrum-srv/internal/model"
 	pb "github.com/raspidrum-srv/internal/pkg/grpc"
+	d "github.com/raspidrum-srv/internal/repo/db"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestConvertPresetToProto(t *testing.T) {
@@ -38,18 +43,22 @@ func TestConvertPresetToProto(t *testing.T) {
 						Key:  "sampler",
 						Name: "Kit",
 						Type: pb.ChannelType_CHANNEL_TYPE_SAMPLER,
-						Volume: &pb.BaseControl{Key: "s0volume", Name: "Volume", Value: 1.0, Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
+						Volume: &pb.BaseControl{Key: "s0volume", Address: "sampler/volume", Name: "Volume", Value: 1.0, Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
 					},
 					{
 						Key:    "ch1",
 						Name:   "Kick",
 						Type:   pb.ChannelType_CHANNEL_TYPE_INSTRUMENT,
-						Volume: &pb.BaseControl{Key: "i0volume", Name: "Volume", Value: 0.748, Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
-						Pan:    &pb.BaseControl{Key: "i0pan", Name: "Pan", Value: -0.15, Min: makeFloat64Ptr(-1), Max: makeFloat64Ptr(1)},
+						Volume: &pb.BaseControl{Key: "i0volume", Address: "ch1/kick/volume", Name: "Volume", Value: 0.748, Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
+						Pan:    &pb.BaseControl{Key: "i0pan", Address: "ch1/kick/pan", Name: "Pan", Value: -0.15, Min: makeFloat64Ptr(-1), Max: makeFloat64Ptr(1)},
+						Mute:   &pb.BaseControl{Key: "c0mute", Address: "ch1/mute", Name: "Mute", Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
+						Solo:   &pb.BaseControl{Key: "c0solo", Address: "ch1/solo", Name: "Solo", Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
 						Instruments: []*pb.Instrument{
 							{
 								Key:  "0",
 								Name: "Kick",
+								Mute: &pb.BaseControl{Key: "i0mute", Address: "ch1/kick/mute", Name: "Mute", Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
+								Solo: &pb.BaseControl{Key: "i0solo", Address: "ch1/kick/solo", Name: "Solo", Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
 							},
 						},
 					},
@@ -72,19 +81,21 @@ func TestConvertPresetToProto(t *testing.T) {
 						Key:  "sampler",
 						Name: "Kit",
 						Type: pb.ChannelType_CHANNEL_TYPE_SAMPLER,
-						Volume: &pb.BaseControl{Key: "s0volume", Name: "Volume", Value: 1.0, Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
+						Volume: &pb.BaseControl{Key: "s0volume", Address: "sampler/volume", Name: "Volume", Value: 1.0, Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
 					},
 					{
 						Key:    "ch1",
 						Name:   "Kick",
 						Type:   pb.ChannelType_CHANNEL_TYPE_INSTRUMENT,
-						Volume: &pb.BaseControl{Key: "c0volume", Name: "Volume", Value: 1.00, Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
+						Volume: &pb.BaseControl{Key: "c0volume", Address: "ch1/volume", Name: "Volume", Value: 1.00, Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
+						Mute:   &pb.BaseControl{Key: "c0mute", Address: "ch1/mute", Name: "Mute", Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
+						Solo:   &pb.BaseControl{Key: "c0solo", Address: "ch1/solo", Name: "Solo", Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
 						Instruments: []*pb.Instrument{
 							{
 								Key:    "0",
 								Name:   "Kick",
-								Volume: &pb.BaseControl{Key: "i0volume", Name: "Volume", Value: 0.95, Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
-								Pan:    &pb.BaseControl{Key: "i0pan", Name: "Pan", Value: -0.2, Min: makeFloat64Ptr(-1), Max: makeFloat64Ptr(1)},
+								Volume: &pb.BaseControl{Key: "i0volume", Address: "ch1/kick/volume", Name: "Volume", Value: 0.95, Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
+								Pan:    &pb.BaseControl{Key: "i0pan", Address: "ch1/kick/pan", Name: "Pan", Value: -0.2, Min: makeFloat64Ptr(-1), Max: makeFloat64Ptr(1)},
 							},
 						},
 					},
@@ -107,26 +118,32 @@ func TestConvertPresetToProto(t *testing.T) {
 						Key:  "sampler",
 						Name: "Kit",
 						Type: pb.ChannelType_CHANNEL_TYPE_SAMPLER,
-						Volume: &pb.BaseControl{Key: "s0volume", Name: "Volume", Value: 1.0, Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
+						Volume: &pb.BaseControl{Key: "s0volume", Address: "sampler/volume", Name: "Volume", Value: 1.0, Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
 					},
 					{
 						Key:    "ch1",
 						Name:   "Drums",
 						Type:   pb.ChannelType_CHANNEL_TYPE_INSTRUMENT,
-						Volume: &pb.BaseControl{Key: "c0volume", Name: "Volume", Value: 1.00, Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
-						Pan:    &pb.BaseControl{Key: "c0pan", Name: "Pan", Value: 0.00, Min: makeFloat64Ptr(-1), Max: makeFloat64Ptr(1)},
+						Volume: &pb.BaseControl{Key: "c0volume", Address: "ch1/volume", Name: "Volume", Value: 1.00, Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
+						Pan:    &pb.BaseControl{Key: "c0pan", Address: "ch1/pan", Name: "Pan", Value: 0.00, Min: makeFloat64Ptr(-1), Max: makeFloat64Ptr(1)},
+						Mute:   &pb.BaseControl{Key: "c0mute", Address: "ch1/mute", Name: "Mute", Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
+						Solo:   &pb.BaseControl{Key: "c0solo", Address: "ch1/solo", Name: "Solo", Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
 						Instruments: []*pb.Instrument{
 							{
 								Key:    "0",
 								Name:   "Kick",
-								Volume: &pb.BaseControl{Key: "i0volume", Name: "Volume", Value: 0.748, Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
-								Pan:    &pb.BaseControl{Key: "i0pan", Name: "Pan", Value: -0.15, Min: makeFloat64Ptr(-1), Max: makeFloat64Ptr(1)},
+								Volume: &pb.BaseControl{Key: "i0volume", Address: "ch1/kick/volume", Name: "Volume", Value: 0.748, Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
+								Pan:    &pb.BaseControl{Key: "i0pan", Address: "ch1/kick/pan", Name: "Pan", Value: -0.15, Min: makeFloat64Ptr(-1), Max: makeFloat64Ptr(1)},
+								Mute:   &pb.BaseControl{Key: "i0mute", Address: "ch1/kick/mute", Name: "Mute", Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
+								Solo:   &pb.BaseControl{Key: "i0solo", Address: "ch1/kick/solo", Name: "Solo", Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
 							},
 							{
 								Key:    "1",
 								Name:   "Tom",
-								Volume: &pb.BaseControl{Key: "i1volume", Name: "Volume", Value: 0.685, Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
-								Pan:    &pb.BaseControl{Key: "i1pan", Name: "Pan", Value: 0.37, Min: makeFloat64Ptr(-1), Max: makeFloat64Ptr(1)},
+								Volume: &pb.BaseControl{Key: "i1volume", Address: "ch1/tom1/volume", Name: "Volume", Value: 0.685, Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
+								Pan:    &pb.BaseControl{Key: "i1pan", Address: "ch1/tom1/pan", Name: "Pan", Value: 0.37, Min: makeFloat64Ptr(-1), Max: makeFloat64Ptr(1)},
+								Mute:   &pb.BaseControl{Key: "i1mute", Address: "ch1/tom1/mute", Name: "Mute", Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
+								Solo:   &pb.BaseControl{Key: "i1solo", Address: "ch1/tom1/solo", Name: "Solo", Min: makeFloat64Ptr(0), Max: makeFloat64Ptr(1)},
 							},
 						},
 					},
@@ -161,3 +178,97 @@ func TestConvertPresetToProto(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertPresetDefToModel(t *testing.T) {
+	name := "Top"
+	midiKey := "KEY2"
+	cc := int32(30)
+	def := &pb.PresetDef{
+		KitKey: "kit-1",
+		Name:   "My preset",
+		Channels: []*pb.PresetChannelDef{
+			{
+				Key:  "ch1",
+				Name: "Drums",
+				Controls: map[string]*pb.PresetControlDef{
+					"volume": {Type: "volume", Value: 0.5},
+				},
+			},
+		},
+		Instruments: []*pb.PresetInstrumentDef{
+			{
+				InstrumentKey: "instr-1",
+				Name:          "Snare",
+				ChannelKey:    "ch1",
+				MidiKey:       &midiKey,
+				Controls: map[string]*pb.PresetControlDef{
+					"volume": {Type: "volume", MidiCc: &cc, Value: 95},
+				},
+				Layers: map[string]*pb.PresetLayerDef{
+					"top": {
+						Name: &name,
+						Controls: map[string]*pb.PresetControlDef{
+							"pitch": {Type: "pitch", Value: 1},
+						},
+					},
+				},
+			},
+		},
+	}
+	want := &model.KitPreset{
+		Kit:  model.KitRef{Uid: "kit-1"},
+		Name: "My preset",
+		Channels: []model.PresetChannel{
+			{
+				Key:  "ch1",
+				Name: "Drums",
+				Controls: model.ControlMap{
+					"volume": {Type: "volume", Value: 0.5},
+				},
+			},
+		},
+		Instruments: []model.PresetInstrument{
+			{
+				Instrument: model.InstrumentRef{Uid: "instr-1"},
+				Name:       "Snare",
+				ChannelKey: "ch1",
+				MidiKey:    "KEY2",
+				Controls: model.ControlMap{
+					"volume": {Type: "volume", MidiCC: 30, Value: 95},
+				},
+				Layers: map[string]model.PresetLayer{
+					"top": {
+						Name: "Top",
+						Controls: model.ControlMap{
+							"pitch": {Type: "pitch", Value: 1},
+						},
+					},
+				},
+			},
+		},
+	}
+	got := convertPresetDefToModel(def)
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(model.KitPreset{}, model.PresetChannel{}, model.PresetControl{}, model.PresetInstrument{}, model.PresetLayer{})); diff != "" {
+		t.Errorf("convertPresetDefToModel() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPresetStatusErr(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{name: "invalid", err: fmt.Errorf("%w: name is required", ErrInvalidPreset), want: codes.InvalidArgument},
+		{name: "not found", err: fmt.Errorf("preset 1: %w", d.ErrNotFound), want: codes.NotFound},
+		{name: "already exists", err: fmt.Errorf("failed store: %w", d.ErrAlreadyExists), want: codes.AlreadyExists},
+		{name: "not loaded", err: fmt.Errorf("failed preload preset 1: %w", ErrPresetNotLoaded), want: codes.FailedPrecondition},
+		{name: "other", err: errors.New("boom"), want: codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := presetStatusErr("failed", tt.err)
+			assert.Equal(t, tt.want, status.Code(err))
+		})
+	}
+}
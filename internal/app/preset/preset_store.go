@@ -0,0 +1,120 @@
+package preset
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	u "github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	m "github.com/raspidrum-srv/internal/model"
+	"github.com/raspidrum-srv/internal/repo/db"
+)
+
+// ErrInvalidPreset is returned when preset doesn't pass validation
+var ErrInvalidPreset = errors.New("invalid preset")
+
+// Validates and stores new preset. Uuid is generated if missing
+func CreatePreset(pst *m.KitPreset, db *db.Sqlite) (presetId int64, err error) {
+	if err = validatePreset(pst); err != nil {
+		return 0, err
+	}
+	err = db.RunInTx(func(tx *sqlx.Tx) error {
+		if len(pst.Uid) == 0 {
+			uuid, err := u.NewV7()
+			if err != nil {
+				return fmt.Errorf("failed gen uuid for preset: %w", err)
+			}
+			pst.Uid = uuid.String()
+		}
+		presetId, err = db.StorePreset(tx, pst)
+		return err
+	})
+	return presetId, err
+}
+
+// Replaces name, channels and instruments of existing preset. Kit of preset can't be changed
+func UpdatePreset(presetId int64, pst *m.KitPreset, d *db.Sqlite) error {
+	if err := validatePreset(pst); err != nil {
+		return err
+	}
+	cur, err := getPreset(presetId, d)
+	if err != nil {
+		return err
+	}
+	if pst.Kit.Uid != cur.Kit.Uid {
+		return fmt.Errorf("%w: kit of preset can't be changed", ErrInvalidPreset)
+	}
+	pst.Id = cur.Id
+	pst.Uid = cur.Uid
+	return d.RunInTx(func(tx *sqlx.Tx) error {
+		if err := d.ClearPreset(tx, presetId); err != nil {
+			return err
+		}
+		_, err := d.StorePreset(tx, pst)
+		return err
+	})
+}
+
+func RenamePreset(presetId int64, name string, d *db.Sqlite) error {
+	if err := validatePresetName(name); err != nil {
+		return err
+	}
+	return d.RunInTx(func(tx *sqlx.Tx) error {
+		return d.RenamePreset(tx, presetId, name)
+	})
+}
+
+// Makes copy of preset with new name. Returns copy
+func ClonePreset(presetId int64, name string, d *db.Sqlite) (*m.KitPreset, error) {
+	pst, err := getPreset(presetId, d)
+	if err != nil {
+		return nil, err
+	}
+	pst.Id = 0
+	pst.Uid = ""
+	pst.Name = name
+	pst.Id, err = CreatePreset(pst, d)
+	if err != nil {
+		return nil, err
+	}
+	return pst, nil
+}
+
+func DeletePreset(presetId int64, d *db.Sqlite) error {
+	return d.RunInTx(func(tx *sqlx.Tx) error {
+		return d.DeletePreset(tx, presetId)
+	})
+}
+
+func getPreset(presetId int64, d *db.Sqlite) (*m.KitPreset, error) {
+	pst, err := d.GetPreset(db.ById(presetId))
+	if err != nil {
+		return nil, err
+	}
+	if pst == nil {
+		return nil, fmt.Errorf("preset %d: %w", presetId, db.ErrNotFound)
+	}
+	return pst, nil
+}
+
+func validatePreset(pst *m.KitPreset) error {
+	if err := validatePresetName(pst.Name); err != nil {
+		return err
+	}
+	if len(pst.Kit.Uid) == 0 {
+		return fmt.Errorf("%w: kit is required", ErrInvalidPreset)
+	}
+	if err := pst.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidPreset, err)
+	}
+	return nil
+}
+
+func validatePresetName(name string) error {
+	if len(strings.TrimSpace(name)) == 0 {
+		return fmt.Errorf("%w: name is required", ErrInvalidPreset)
+	}
+	return nil
+}
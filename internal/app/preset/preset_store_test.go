@@ -0,0 +1,54 @@
+//go:build integration
+
+package preset
+
+import (
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	db "github.com/raspidrum-srv/internal/repo/db"
+	f "github.com/raspidrum-srv/internal/repo/file"
+)
+
+func TestPresetStore(t *testing.T) {
+	d, err := db.NewSqlite(getDBPath())
+	require.NoError(t, err)
+	defer d.Close()
+
+	pst, err := f.ParsePreset(path.Join(getProjectPath(), "testdata/preset_import/kit_preset_1.yaml"))
+	require.NoError(t, err)
+	pst.Uid = ""
+	pst.Name = "crud test preset"
+
+	id, err := CreatePreset(pst, d)
+	require.NoError(t, err)
+	defer DeletePreset(id, d)
+
+	pst.Uid = ""
+	_, err = CreatePreset(pst, d)
+	assert.ErrorIs(t, err, db.ErrAlreadyExists, "same kit and name")
+
+	cln, err := ClonePreset(id, "crud test preset clone", d)
+	require.NoError(t, err)
+	assert.NotEqual(t, pst.Uid, cln.Uid)
+
+	err = RenamePreset(cln.Id, pst.Name, d)
+	assert.ErrorIs(t, err, db.ErrAlreadyExists)
+	err = RenamePreset(cln.Id, "  ", d)
+	assert.ErrorIs(t, err, ErrInvalidPreset)
+
+	pst.Name = "crud test preset updated"
+	pst.Instruments = pst.Instruments[:1]
+	require.NoError(t, UpdatePreset(id, pst, d))
+	got, err := d.GetPreset(db.ById(id))
+	require.NoError(t, err)
+	assert.Equal(t, pst.Name, got.Name)
+	assert.Len(t, got.Instruments, 1)
+
+	require.NoError(t, DeletePreset(cln.Id, d))
+	assert.ErrorIs(t, DeletePreset(cln.Id, d), db.ErrNotFound)
+	assert.ErrorIs(t, RenamePreset(cln.Id, "any", d), db.ErrNotFound)
+}
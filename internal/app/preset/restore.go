@@ -0,0 +1,125 @@
+package preset
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+
+	pb "github.com/raspidrum-srv/internal/pkg/grpc"
+	"google.golang.org/grpc"
+
+	midi "github.com/raspidrum-srv/internal/app/mididevice"
+)
+
+// size of event buffer of WatchPreset stream. Events of slow streams are dropped
+const watchBufferSize = 8
+
+// presetWatchers delivers preset events to WatchPreset streams
+type presetWatchers struct {
+	mu    sync.Mutex
+	chans map[chan *pb.PresetEvent]struct{}
+}
+
+func (w *presetWatchers) add() chan *pb.PresetEvent {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.chans == nil {
+		w.chans = map[chan *pb.PresetEvent]struct{}{}
+	}
+	ch := make(chan *pb.PresetEvent, watchBufferSize)
+	w.chans[ch] = struct{}{}
+	return ch
+}
+
+func (w *presetWatchers) remove(ch chan *pb.PresetEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.chans, ch)
+}
+
+func (w *presetWatchers) publish(evt *pb.PresetEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for ch := range w.chans {
+		select {
+		case ch <- evt:
+		default:
+			slog.Warn("preset event dropped for slow watcher", slog.String("type", evt.Type.String()))
+		}
+	}
+}
+
+func (s *PresetServer) WatchPreset(req *pb.WatchPresetRequest, stream grpc.ServerStreamingServer[pb.PresetEvent]) error {
+	ch := s.watchers.add()
+	defer s.watchers.remove(ch)
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case evt := <-ch:
+			if err := stream.Send(evt); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// RestorePreset loads the loaded preset into restarted sampler: creates devices and channels,
+// loads generated sfz files and sends current control values. Watchers are notified about restoring.
+// Does nothing if preset isn't loaded
+func (s *PresetServer) RestorePreset() error {
+	s.loadMu.Lock()
+	defer s.loadMu.Unlock()
+
+	s.mu.Lock()
+	loaded := s.loadedPreset
+	s.mu.Unlock()
+	if loaded == nil {
+		return nil
+	}
+
+	mdevs, err := midi.ListAlsaDevices(s.fs)
+	if err != nil {
+		return fmt.Errorf("failed restore preset %d: %w", loaded.Id, err)
+	}
+	audioDevId, midiDevId, err := InitSampler(s.sampler, s.audio.Get(), mdevs)
+	if err != nil {
+		return fmt.Errorf("failed restore preset %d: %w", loaded.Id, err)
+	}
+	// sfz files are generated with current control values
+	chnls, err := s.sampler.LoadPreset(audioDevId, midiDevId, loaded, s.fs)
+	if err != nil {
+		return fmt.Errorf("failed restore preset %d: %w", loaded.Id, err)
+	}
+
+	s.mu.Lock()
+	s.ctrlHandler = NewSamplerControlHandler(s.sampler, chnls)
+	s.activityKeys.Store(newActivityKeys(loaded, chnls))
+	err = loaded.ApplyControlValues(s.ctrlHandler)
+	merr := loaded.ApplyMuteState(s.ctrlHandler)
+	ferr := s.applyEffects(loaded)
+	pbPreset, cerr := convertPresetToProto(loaded)
+	if cerr == nil {
+		s.controls.reset(controlValues(pbPreset))
+	}
+	s.mu.Unlock()
+	if err != nil {
+		slog.Warn("failed apply control values of restored preset", slog.Int64("presetId", loaded.Id), slog.Any("error", err))
+	}
+	if merr != nil {
+		slog.Warn("failed apply mute state of restored preset", slog.Int64("presetId", loaded.Id), slog.Any("error", merr))
+	}
+	if ferr != nil {
+		slog.Warn("failed apply effects of restored preset", slog.Int64("presetId", loaded.Id), slog.Any("error", ferr))
+	}
+	if cerr != nil {
+		return fmt.Errorf("failed restore preset %d: %w", loaded.Id, cerr)
+	}
+
+	slog.Info("preset restored after sampler restart", slog.Int64("presetId", loaded.Id))
+	s.watchers.publish(&pb.PresetEvent{
+		Type:   pb.PresetEventType_PRESET_EVENT_TYPE_RESTORED,
+		Preset: pbPreset,
+	})
+	return nil
+}
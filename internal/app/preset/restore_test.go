@@ -0,0 +1,96 @@
+package preset
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raspidrum-srv/internal/app/audio"
+	m "github.com/raspidrum-srv/internal/model"
+	pb "github.com/raspidrum-srv/internal/pkg/grpc"
+	"github.com/raspidrum-srv/internal/repo"
+)
+
+// fakeRestoreSampler records calls made while restoring preset
+type fakeRestoreSampler struct {
+	repo.SamplerRepo
+	calls []string
+	ccErr error
+}
+
+func (f *fakeRestoreSampler) ConnectAudioOutput(out repo.AudioOutput) (int, error) {
+	f.calls = append(f.calls, "audio "+out.Driver)
+	return 0, nil
+}
+
+func (f *fakeRestoreSampler) ConnectMidiInput(driver string, params []repo.Param[string]) (int, error) {
+	f.calls = append(f.calls, "midi "+driver)
+	return 0, nil
+}
+
+func (f *fakeRestoreSampler) LoadPreset(audioDevId, midiDevId int, preset *m.KitPreset, fs afero.Fs) (repo.SamplerChannels, error) {
+	f.calls = append(f.calls, "load "+preset.Name)
+	return repo.SamplerChannels{"ch1": 3}, nil
+}
+
+func (f *fakeRestoreSampler) SetChannelVolume(samplerChn int, volume float32) error {
+	f.calls = append(f.calls, "volume")
+	return nil
+}
+
+func (f *fakeRestoreSampler) SendMidiCC(samplerChn int, cc int, value float32) error {
+	f.calls = append(f.calls, "cc")
+	return f.ccErr
+}
+
+func (f *fakeRestoreSampler) SetChannelMute(samplerChn int, mute bool) error {
+	f.calls = append(f.calls, fmt.Sprintf("mute %t", mute))
+	return nil
+}
+
+func (f *fakeRestoreSampler) SetChannelSolo(samplerChn int, solo bool) error {
+	f.calls = append(f.calls, fmt.Sprintf("solo %t", solo))
+	return nil
+}
+
+func (f *fakeRestoreSampler) SetGlobalVolume(volume float32) error {
+	f.calls = append(f.calls, "global volume")
+	return nil
+}
+
+func TestPresetServer_RestorePreset(t *testing.T) {
+	sampler := &fakeRestoreSampler{}
+	s := NewPresetServer(nil, sampler, audio.NewSettings(repo.AudioOutput{Driver: "ALSA"}), afero.NewMemMapFs())
+
+	// nothing to restore
+	require.NoError(t, s.RestorePreset())
+	assert.Empty(t, sampler.calls)
+
+	pst := loadPresetFromYAML(t, "single_instrument.yaml")
+	require.NoError(t, pst.PrepareToLoad([]m.MIDIDevice{&MockMMIDIDevice{}}))
+	s.loadedPreset = pst
+	events := s.watchers.add()
+
+	require.NoError(t, s.RestorePreset())
+	assert.Equal(t, []string{
+		"audio ALSA",
+		"midi ALSA",
+		"load Single Instrument",
+		// c0volume, i0pan, i0volume, s0volume
+		"volume", "cc", "cc", "global volume",
+		// mute state of ch1
+		"mute false", "solo false",
+	}, sampler.calls)
+	assert.Equal(t, repo.SamplerChannels{"ch1": 3}, s.ctrlHandler.samplerChannels)
+
+	select {
+	case evt := <-events:
+		assert.Equal(t, pb.PresetEventType_PRESET_EVENT_TYPE_RESTORED, evt.Type)
+		assert.Equal(t, "Single Instrument", evt.Preset.Name)
+	default:
+		t.Error("watcher should be notified about restoring")
+	}
+}
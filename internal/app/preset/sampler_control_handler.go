@@ -2,6 +2,7 @@ package preset
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/raspidrum-srv/internal/model"
 	"github.com/raspidrum-srv/internal/repo"
@@ -10,6 +11,16 @@ import (
 type SamplerControlHandler struct {
 	sampler         repo.SamplerRepo
 	samplerChannels repo.SamplerChannels
+	// effect instances of the loaded preset
+	effects repo.SamplerEffects
+	// the last CC values of sampler channels: sent by handler or received from MIDI device
+	mu    sync.Mutex
+	known map[midiCC]int
+}
+
+type midiCC struct {
+	samplerChn int
+	cc         int
 }
 
 func NewSamplerControlHandler(sampler repo.SamplerRepo, samplerChannels repo.SamplerChannels) *SamplerControlHandler {
@@ -19,12 +30,22 @@ func NewSamplerControlHandler(sampler repo.SamplerRepo, samplerChannels repo.Sam
 	}
 }
 
+// SendChannelMidiCC skips value, which sampler channel already has
 func (s *SamplerControlHandler) SendChannelMidiCC(channelKey string, cc int, value float32) error {
 	chnlId, ok := s.samplerChannels[channelKey]
 	if !ok {
 		return fmt.Errorf("failed send MIDI CC to channel. invalid channel: %s", channelKey)
 	}
-	return s.sampler.SendMidiCC(chnlId, cc, value)
+	if !s.received(chnlId, cc, int(value)) {
+		return nil
+	}
+	err := s.sampler.SendMidiCC(chnlId, cc, value)
+	if err != nil {
+		s.mu.Lock()
+		delete(s.known, midiCC{chnlId, cc})
+		s.mu.Unlock()
+	}
+	return err
 }
 func (s *SamplerControlHandler) SetChannelVolume(channelKey string, value float32) error {
 	if channelKey == model.SamplerChannelKey {
@@ -36,3 +57,53 @@ func (s *SamplerControlHandler) SetChannelVolume(channelKey string, value float3
 	}
 	return s.sampler.SetChannelVolume(chnlId, value)
 }
+
+func (s *SamplerControlHandler) SetChannelMute(channelKey string, mute bool) error {
+	chnlId, ok := s.samplerChannels[channelKey]
+	if !ok {
+		return fmt.Errorf("failed set channel mute. invalid channel: %s", channelKey)
+	}
+	return s.sampler.SetChannelMute(chnlId, mute)
+}
+
+func (s *SamplerControlHandler) SetChannelSolo(channelKey string, solo bool) error {
+	chnlId, ok := s.samplerChannels[channelKey]
+	if !ok {
+		return fmt.Errorf("failed set channel solo. invalid channel: %s", channelKey)
+	}
+	return s.sampler.SetChannelSolo(chnlId, solo)
+}
+
+func (s *SamplerControlHandler) SetFxParam(fxKey string, param int, value float32) error {
+	eff, ok := s.effects[fxKey]
+	if !ok {
+		return fmt.Errorf("failed set effect param. invalid effect: %s", fxKey)
+	}
+	return s.sampler.SetEffectParam(eff.InstanceId, param, value)
+}
+
+// received stores CC value of sampler channel. Returns false if channel already has the value,
+// i.e. sampler notifies about CC sent by handler
+func (s *SamplerControlHandler) received(samplerChn int, cc int, value int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := midiCC{samplerChn, cc}
+	if v, ok := s.known[key]; ok && v == value {
+		return false
+	}
+	if s.known == nil {
+		s.known = map[midiCC]int{}
+	}
+	s.known[key] = value
+	return true
+}
+
+// channelKey returns preset channel key of sampler channel
+func (s *SamplerControlHandler) channelKey(samplerChn int) (string, bool) {
+	for k, v := range s.samplerChannels {
+		if v == samplerChn {
+			return k, true
+		}
+	}
+	return "", false
+}
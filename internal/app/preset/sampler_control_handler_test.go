@@ -0,0 +1,29 @@
+package preset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raspidrum-srv/internal/repo"
+)
+
+func TestSamplerControlHandler_SendChannelMidiCC(t *testing.T) {
+	sampler := &fakeRestoreSampler{}
+	h := NewSamplerControlHandler(sampler, repo.SamplerChannels{"ch1": 3})
+
+	require.NoError(t, h.SendChannelMidiCC("ch1", 10, 64))
+	// duplicate value is skipped
+	require.NoError(t, h.SendChannelMidiCC("ch1", 10, 64))
+	require.NoError(t, h.SendChannelMidiCC("ch1", 11, 64))
+	require.NoError(t, h.SendChannelMidiCC("ch1", 10, 70))
+	assert.Equal(t, []string{"cc", "cc", "cc"}, sampler.calls)
+
+	// value got from MIDI device
+	assert.True(t, h.received(3, 10, 20))
+	require.NoError(t, h.SendChannelMidiCC("ch1", 10, 20))
+	assert.Len(t, sampler.calls, 3)
+
+	assert.Error(t, h.SendChannelMidiCC("ch2", 10, 64))
+}
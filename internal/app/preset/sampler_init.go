@@ -2,30 +2,57 @@ package preset
 
 import (
 	"fmt"
+	"log/slog"
+	"strings"
 
+	midi "github.com/raspidrum-srv/internal/app/mididevice"
 	"github.com/raspidrum-srv/internal/repo"
 )
 
-// TODO: убрать хардкод
-const audioDriver = "COREAUDIO"
-const midiDriver = "COREMIDI"
+const midiDriver = "ALSA"
 
-// TODO: может сделать тип Sampler, в который сохранять созданные идентификаторы устройств и каналов
-
-func InitSampler(sampler repo.SamplerRepo) (audioDevId, midiDevId int, err error) {
-	audioId, err := sampler.ConnectAudioOutput(audioDriver, nil)
+// Creates audio output and MIDI input devices. Devices are owned by sampler session and removed by CloseSession
+func InitSampler(sampler repo.SamplerRepo, audio repo.AudioOutput, mdevs []midi.USBMIDIDevice) (audioDevId, midiDevId int, err error) {
+	audioId, err := sampler.ConnectAudioOutput(audio)
 	if err != nil {
 		return 0, 0, fmt.Errorf("failed init sampler: %w", err)
 	}
 
-	// TODO: убрать хардкод
-	midiBindings := repo.Param[string]{
-		Name:  "CORE_MIDI_BINDINGS",
-		Value: "vmpk vmpk out",
+	var params []repo.Param[string]
+	if len(mdevs) == 0 {
+		slog.Warn("MIDI devices not found. MIDI input isn't bound")
+	} else {
+		params = append(params, alsaSeqBindings(mdevs))
 	}
-	midiId, err := sampler.ConnectMidiInput(midiDriver, []repo.Param[string]{midiBindings})
+	midiId, err := sampler.ConnectMidiInput(midiDriver, params)
 	if err != nil {
 		return 0, 0, fmt.Errorf("failed init sampler: %w", err)
 	}
 	return audioId, midiId, nil
 }
+
+// ALSA_SEQ_BINDINGS is a list of quoted addresses: '24:0','28:0'.
+// String parameter value is quoted by lscp, so only inner quotes are added
+func alsaSeqBindings(mdevs []midi.USBMIDIDevice) repo.Param[string] {
+	ids := make([]string, len(mdevs))
+	for i := range mdevs {
+		ids[i] = mdevs[i].DevID()
+	}
+	return repo.Param[string]{
+		Name:  "ALSA_SEQ_BINDINGS",
+		Value: strings.Join(ids, "','"),
+	}
+}
+
+// RebindMidiDevices binds sampler MIDI inputs to currently connected MIDI devices.
+// Preset isn't reloaded, so MIDI Keys mapping of new device is applied on next preset loading
+func RebindMidiDevices(sampler repo.SamplerRepo, mdevs []midi.USBMIDIDevice) error {
+	if len(mdevs) == 0 {
+		// ALSA removes subscriptions of disconnected ports itself
+		return nil
+	}
+	if err := sampler.RebindMidiInputs([]repo.Param[string]{alsaSeqBindings(mdevs)}); err != nil {
+		return fmt.Errorf("failed rebind MIDI devices: %w", err)
+	}
+	return nil
+}
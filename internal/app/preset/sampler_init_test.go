@@ -37,7 +37,7 @@ func TestInitSampler(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotAudioDevId, gotMidiDevId, err := InitSampler(tt.sampler)
+			gotAudioDevId, gotMidiDevId, err := InitSampler(tt.sampler, repo.AudioOutput{Driver: "COREAUDIO"}, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("InitSampler() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -24,3 +24,16 @@ func MapMidiKey(mkey string, mdevs []MIDIDevice) (int, error) {
 	}
 	return 0, fmt.Errorf("MIDI devices %s doen't have mapping for MIDI Key %s", devlist, mkey)
 }
+
+// DefaultMidiMapProfile is used for MIDI devices without assigned profile
+const DefaultMidiMapProfile = "Raspidrum"
+
+// MidiMapProfile maps MIDI Keys of presets to MIDI notes of drum module
+type MidiMapProfile struct {
+	Id   int64
+	Name string
+	// builtin profiles can't be changed
+	Builtin bool
+	// MIDI Key : MIDI note
+	Notes map[string]int
+}
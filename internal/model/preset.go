@@ -1,8 +1,17 @@
 package model
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrControlNotFound = errors.New("control not found")
+	ErrValueOutOfRange = errors.New("control value out of range")
 )
 
 const SamplerChannelKey = "sampler"
@@ -20,7 +29,9 @@ type KitPreset struct {
 	Name        string                `yaml:"name"`
 	Channels    []PresetChannel       `yaml:"channels"`
 	Instruments []PresetInstrument    `yaml:"instruments"`
+	Fxs         []PresetFxChain       `yaml:"fxs,omitempty"`
 	controls    map[string]controlRef // key - control.Key
+	addresses   map[string]string     // key - control.Address, value - control.Key
 }
 
 type KitRef struct {
@@ -30,10 +41,13 @@ type KitRef struct {
 	IsCustom bool   `yaml:"-"`
 }
 
+// Mute and Solo are stored state. State of the loaded preset is in generated mute and solo controls
 type PresetChannel struct {
 	Key         string              `yaml:"key"`
 	Name        string              `yaml:"name"`
 	Controls    ControlMap          `yaml:"controls"`
+	Mute        bool                `yaml:"mute,omitempty"`
+	Solo        bool                `yaml:"solo,omitempty"`
 	instruments []*PresetInstrument `yaml:"-"`
 }
 
@@ -46,6 +60,9 @@ type PresetInstrument struct {
 	MidiNote   int                    `yaml:"-"`
 	Controls   ControlMap             `yaml:"controls"`
 	Layers     map[string]PresetLayer `yaml:"layers"`
+	Mute       bool                   `yaml:"mute,omitempty"`
+	Solo       bool                   `yaml:"solo,omitempty"`
+	channel    *PresetChannel         `yaml:"-"`
 }
 
 type InstrumentRef struct {
@@ -64,6 +81,9 @@ type PresetLayer struct {
 	CfgMidiKey string     `yaml:"-" json:"-"`
 	MidiNote   int        `yaml:"-"`
 	Controls   ControlMap `yaml:"controls" json:"controls"`
+	Mute       bool       `yaml:"mute,omitempty" json:"mute,omitempty"`
+	Solo       bool       `yaml:"solo,omitempty" json:"solo,omitempty"`
+	instrument *PresetInstrument
 }
 
 // for sampler channel return empty slice
@@ -145,9 +165,68 @@ func (p *KitPreset) PrepareToLoad(mididevs []MIDIDevice) error {
 	if err := p.prepareInstruments(cnlsIndex, mididevs); err != nil {
 		return err
 	}
+	p.indexAddresses()
 	return nil
 }
 
+// indexAddresses makes control addresses, which don't depend on order of channels and instruments:
+// <channel key>/<control key> for channel controls, <channel key>/<instrument uid>/<control key> for instrument controls
+// and <channel key>/<instrument uid>/<layer key>/<control key> for layer controls.
+// Instrument without uid has no addresses of its controls
+func (p *KitPreset) indexAddresses() {
+	p.addresses = make(map[string]string, len(p.controls))
+	add := func(ctrl *PresetControl, parts ...string) {
+		addr := strings.Join(parts, "/")
+		if key, ok := p.addresses[addr]; ok {
+			slog.Warn("duplicate control address", slog.String("address", addr), slog.String("key", ctrl.Key), slog.String("used", key))
+			return
+		}
+		ctrl.Address = addr
+		p.addresses[addr] = ctrl.Key
+	}
+	for _, ch := range p.Channels {
+		for _, k := range slices.Sorted(maps.Keys(ch.Controls)) {
+			add(ch.Controls[k], ch.Key, k)
+		}
+	}
+	for _, instr := range p.Instruments {
+		if instr.Instrument.Uid == "" {
+			continue
+		}
+		for _, k := range slices.Sorted(maps.Keys(instr.Controls)) {
+			add(instr.Controls[k], instr.ChannelKey, instr.Instrument.Uid, k)
+		}
+		for _, lkey := range slices.Sorted(maps.Keys(instr.Layers)) {
+			lr := instr.Layers[lkey]
+			for _, k := range slices.Sorted(maps.Keys(lr.Controls)) {
+				add(lr.Controls[k], instr.ChannelKey, instr.Instrument.Uid, lkey, k)
+			}
+		}
+	}
+}
+
+// control finds control by key or by address
+func (p *KitPreset) control(keyOrAddress string) (controlRef, bool) {
+	if strings.Contains(keyOrAddress, "/") {
+		key, ok := p.addresses[keyOrAddress]
+		if !ok {
+			return controlRef{}, false
+		}
+		keyOrAddress = key
+	}
+	ref, ok := p.controls[keyOrAddress]
+	return ref, ok
+}
+
+// GetControl returns control by key or by address
+func (p *KitPreset) GetControl(keyOrAddress string) (*PresetControl, error) {
+	ref, ok := p.control(keyOrAddress)
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", ErrControlNotFound, keyOrAddress)
+	}
+	return ref.control, nil
+}
+
 
 func (p *KitPreset) prepareChannels() map[string]*PresetChannel {
 	cnlsIndex := make(map[string]*PresetChannel, len(p.Channels))	
@@ -185,9 +264,10 @@ func (p *KitPreset) prepareChannels() map[string]*PresetChannel {
 					if ictrl, ok := ch.instruments[0].Controls.FindControlByType(CtrlPan); ok {
 						if ictrl.MidiCC != 0 {
 							ctrl := &PresetControl{
-								Name:  ictrl.Name,
-								Type:  ictrl.Type,
-								owner: ch,
+								Name:      ictrl.Name,
+								Type:      ictrl.Type,
+								owner:     ch,
+								generated: true,
 							}
 							key := fmt.Sprintf("c%d%s", channelIdx, CtrlPan)
 							ctrl.Key = key
@@ -200,9 +280,10 @@ func (p *KitPreset) prepareChannels() map[string]*PresetChannel {
 				} else {
 					// In case many instruments in channel pan is virtual and linked with pan of all instruments in channel
 					ctrl := &PresetControl{
-						Name:  "Pan",
-						Type:  CtrlPan,
-						owner: ch,
+						Name:      "Pan",
+						Type:      CtrlPan,
+						owner:     ch,
+						generated: true,
 					}
 					key := fmt.Sprintf("c%d%s", channelIdx, CtrlPan)
 					ctrl.Key = key
@@ -216,6 +297,9 @@ func (p *KitPreset) prepareChannels() map[string]*PresetChannel {
 					p.controls[key] = controlRef{channel: ch, control: ctrl}
 				}
 			}
+			for _, ctrl := range ch.Controls.addSwitchControls(fmt.Sprintf("c%d", channelIdx), ch.Mute, ch.Solo, ch) {
+				p.controls[ctrl.Key] = controlRef{channel: ch, control: ctrl}
+			}
 			channelIdx++
 		}
 
@@ -254,6 +338,7 @@ func (p *KitPreset) prepareInstruments(cnlsIndex map[string]*PresetChannel, midi
 	for i := range p.Instruments {
 		instr := &p.Instruments[i]
 		ch := cnlsIndex[instr.ChannelKey]
+		instr.channel = ch
 		// instrument MIDI Key
 		if len(instr.MidiKey) > 0 {
 			mkeyid, err := MapMidiKey(instr.MidiKey, mididevs)
@@ -289,8 +374,15 @@ func (p *KitPreset) prepareInstruments(cnlsIndex map[string]*PresetChannel, midi
 			ctrl.Key = key
 			p.controls[key] = controlRef{channel: ch, control: ctrl}
 		}
+		// instrument is silenced by volume MIDI CC of instrument or of its layers
+		if instr.hasVolumeCC() {
+			for _, ctrl := range instr.Controls.addSwitchControls(fmt.Sprintf("i%d", instrumentIdx), instr.Mute, instr.Solo, instr) {
+				p.controls[ctrl.Key] = controlRef{channel: ch, control: ctrl}
+			}
+		}
 
 		for lkey, lv := range instr.Layers {
+			lv.instrument = instr
 			if len(lv.MidiKey) > 0 {
 				mkeyid, err := MapMidiKey(lv.MidiKey, mididevs)
 				if err != nil {
@@ -317,6 +409,11 @@ func (p *KitPreset) prepareInstruments(cnlsIndex map[string]*PresetChannel, midi
 				ctrl.Key = key
 				p.controls[key] = controlRef{channel: ch, control: ctrl}
 			}
+			if vol, ok := lv.Controls.FindControlByType(CtrlVolume); ok && vol.MidiCC != 0 {
+				for _, ctrl := range lv.Controls.addSwitchControls(fmt.Sprintf("i%d%s", instrumentIdx, lkey), lv.Mute, lv.Solo, &lv) {
+					p.controls[ctrl.Key] = controlRef{channel: ch, control: ctrl}
+				}
+			}
 			instr.Layers[lkey] = lv
 		}
 		instrumentIdx++
@@ -324,24 +421,192 @@ func (p *KitPreset) prepareInstruments(cnlsIndex map[string]*PresetChannel, midi
 	return nil
 }
 
+// SetControlValue sets normalized value of control found by key or by address. Value is rounded to 3 decimal places,
+// rounded value out of control range isn't set
 func (p *KitPreset) SetControlValue(controlKey string, value float32, csetter SamplerControlSetter) error {
 	// find control by key
 	if p.controls == nil {
 		return fmt.Errorf("controls not initialized")
 	}
-	ctrl, ok := p.controls[controlKey]
+	ctrl, ok := p.control(controlKey)
 	if !ok {
-		return fmt.Errorf("control '%s' not found", controlKey)
+		return fmt.Errorf("%w: '%s'", ErrControlNotFound, controlKey)
+	}
+	value = roundFloat(value, 3)
+	if _, min, max := ctrl.control.GetNormalizedValue(); !(value >= min && value <= max) {
+		return fmt.Errorf("%w: control '%s' value %v isn't in %v..%v", ErrValueOutOfRange, controlKey, value, min, max)
 	}
 	return ctrl.control.SetValue(value, ctrl.channel.Key, csetter)
 }
 
+// GetControlValue returns normalized value of control found by key or by address
+func (p *KitPreset) GetControlValue(controlKey string) (float32, error) {
+	ctrl, ok := p.control(controlKey)
+	if !ok {
+		return 0, fmt.Errorf("%w: '%s'", ErrControlNotFound, controlKey)
+	}
+	val, _, _ := ctrl.control.GetNormalizedValue()
+	return val, nil
+}
+
+// ApplyControlValues sends current values of all controls to sampler, i.e. after sampler restart.
+// Virtual controls (channel pan, instrument controls without MIDI CC) have no own sampler parameter,
+// their values are applied through linked controls
+func (p *KitPreset) ApplyControlValues(csetter SamplerControlSetter) error {
+	if p.controls == nil {
+		return fmt.Errorf("controls not initialized")
+	}
+	var errs []error
+	for _, key := range slices.Sorted(maps.Keys(p.controls)) {
+		ref := p.controls[key]
+		ctrl := ref.control
+		if _, ok := ctrl.owner.(*PresetChannel); ok {
+			if ctrl.Type != CtrlVolume {
+				continue
+			}
+		} else if ctrl.MidiCC == 0 {
+			continue
+		}
+		if err := ctrl.owner.HandleControlValue(ref.channel.Key, ctrl, ctrl.Value, csetter); err != nil {
+			errs = append(errs, fmt.Errorf("failed apply value of control '%s': %w", key, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ApplyMuteState sends mute and solo state to sampler after loading of preset. Sampler channels are muted and soloed,
+// silenced instruments and layers get 0 volume by MIDI CC. Sampler already has volume of the others
+func (p *KitPreset) ApplyMuteState(csetter SamplerControlSetter) error {
+	var errs []error
+	for i := range p.Channels {
+		ch := &p.Channels[i]
+		if ch.Key == SamplerChannelKey {
+			continue
+		}
+		if err := ch.applyMuteState(csetter, true); err != nil {
+			errs = append(errs, fmt.Errorf("failed apply mute state of channel '%s': %w", ch.Key, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SetControlMidiValue stores value of MIDI CC received by sampler channel, i.e. from knob of drum module,
+// into controls of the channel bound to the CC. Value isn't sent to sampler, it's already got it.
+// Value of layer control regulated by virtual instrument control is divided by instrument value,
+// because sampler gets their product. Returns controls with changed value
+func (p *KitPreset) SetControlMidiValue(channelKey string, cc int, value int) []*PresetControl {
+	var res []*PresetControl
+	for _, key := range slices.Sorted(maps.Keys(p.controls)) {
+		ref := p.controls[key]
+		ctrl := ref.control
+		if ctrl.MidiCC == 0 || ctrl.MidiCC != cc || ref.channel.Key != channelKey {
+			continue
+		}
+		val := float32(value)
+		if _, ok := ctrl.owner.(*PresetLayer); ok && ctrl.linkedWith != nil && (ctrl.Type == CtrlVolume || ctrl.Type == CtrlPan) {
+			corr := ctrl.linkedWith.Value
+			if corr <= 0 {
+				// layer value can't be restored
+				continue
+			}
+			val = min(roundFloat(val/corr, 0), 127)
+		}
+		if ctrl.Value == val {
+			continue
+		}
+		ctrl.Value = val
+		res = append(res, ctrl)
+	}
+	return res
+}
+
+// FindByMidiNote returns instrument of channel played by MIDI note and key of its layer.
+// Layer key is empty if note plays instrument itself
+func (p *KitPreset) FindByMidiNote(channelKey string, note int) (instr *PresetInstrument, layerKey string, ok bool) {
+	for i := range p.Instruments {
+		instr := &p.Instruments[i]
+		if instr.ChannelKey != channelKey {
+			continue
+		}
+		for _, k := range slices.Sorted(maps.Keys(instr.Layers)) {
+			if l := instr.Layers[k]; len(l.MidiKey) > 0 && l.MidiNote == note {
+				return instr, k, true
+			}
+		}
+		if len(instr.MidiKey) > 0 && instr.MidiNote == note {
+			return instr, "", true
+		}
+	}
+	return nil, "", false
+}
+
+// ToStore returns copy of preset with current control values.
+// Sampler channel and controls generated by PrepareToLoad are skipped
+func (p *KitPreset) ToStore() *KitPreset {
+	res := &KitPreset{
+		Id:          p.Id,
+		Uid:         p.Uid,
+		Kit:         p.Kit,
+		Name:        p.Name,
+		Channels:    make([]PresetChannel, 0, len(p.Channels)),
+		Instruments: make([]PresetInstrument, 0, len(p.Instruments)),
+		Fxs:         p.fxsToStore(),
+	}
+	for _, ch := range p.Channels {
+		if ch.Key == SamplerChannelKey {
+			continue
+		}
+		res.Channels = append(res.Channels, PresetChannel{
+			Key:      ch.Key,
+			Name:     ch.Name,
+			Controls: ch.Controls.toStore(),
+			Mute:     ch.IsMuted(),
+			Solo:     ch.Controls.switchState(CtrlSolo, ch.Solo),
+		})
+	}
+	for _, instr := range p.Instruments {
+		ri := PresetInstrument{
+			Instrument: instr.Instrument,
+			Id:         instr.Id,
+			Name:       instr.Name,
+			ChannelKey: instr.ChannelKey,
+			MidiKey:    instr.MidiKey,
+			Controls:   instr.Controls.toStore(),
+			Mute:       instr.Controls.switchState(CtrlMute, instr.Mute),
+			Solo:       instr.Controls.switchState(CtrlSolo, instr.Solo),
+		}
+		if instr.Layers != nil {
+			ri.Layers = make(map[string]PresetLayer, len(instr.Layers))
+			for k, lr := range instr.Layers {
+				ri.Layers[k] = PresetLayer{
+					Name:     lr.Name,
+					MidiKey:  lr.MidiKey,
+					Controls: lr.Controls.toStore(),
+					Mute:     lr.Controls.switchState(CtrlMute, lr.Mute),
+					Solo:     lr.Controls.switchState(CtrlSolo, lr.Solo),
+				}
+			}
+		}
+		res.Instruments = append(res.Instruments, ri)
+	}
+	return res
+}
+
 // Volume in channel sets by Sampler API
 // Pan in channel virtual (in case many instruments in channel).
 // In case one instrument in channel, pan is linked to instrument pan. Pan will be regulated in instrument
-// Other controls except volume and pan are not supported in channel
+// Send level is regulated by MIDI CC of FX send.
+// Other controls except volume, pan and send are not supported in channel
 func (c *PresetChannel) HandleControlValue(channelKey string, control *PresetControl, value float32, csetter SamplerControlSetter) error {
 	slog.Debug("HandleControlValue", "control", control, "value", value)
+	if control.Type == CtrlMute || control.Type == CtrlSolo {
+		control.Value = switchValue(value)
+		return c.applyMuteState(csetter, false)
+	}
+	if control.Type == CtrlSend {
+		control.Value = value
+		return csetter.SendChannelMidiCC(c.Key, control.MidiCC, control.Value)
+	}
 	if control.Type == CtrlVolume {
 		control.Value = value
 		if control.MidiCC == 0 {
@@ -367,6 +632,53 @@ func (c *PresetChannel) HandleControlValue(channelKey string, control *PresetCon
 	return nil
 }
 
+// Sends returns FX send controls of channel. Key - key of effect chain
+func (c *PresetChannel) Sends() map[string]*PresetControl {
+	res := map[string]*PresetControl{}
+	for k, ctrl := range c.Controls {
+		if ctrl.Type == CtrlSend {
+			res[k] = ctrl
+		}
+	}
+	return res
+}
+
+// IsMuted returns mute state of channel
+func (c *PresetChannel) IsMuted() bool {
+	return c.Controls.switchState(CtrlMute, c.Mute)
+}
+
+// IsSoloed reports whether channel, its instrument or layer is soloed.
+// Sampler channel is soloed then, so sampler silences other channels
+func (c *PresetChannel) IsSoloed() bool {
+	if c.Controls.switchState(CtrlSolo, c.Solo) {
+		return true
+	}
+	for _, instr := range c.instruments {
+		if instr.soloed() {
+			return true
+		}
+	}
+	return false
+}
+
+// applyMuteState sends mute and solo state of channel and volume of its instruments and layers,
+// silenced ones get 0. If onlySilenced, volume of sounding ones isn't sent
+func (c *PresetChannel) applyMuteState(csetter SamplerControlSetter, onlySilenced bool) error {
+	if err := csetter.SetChannelMute(c.Key, c.IsMuted()); err != nil {
+		return err
+	}
+	if err := csetter.SetChannelSolo(c.Key, c.IsSoloed()); err != nil {
+		return err
+	}
+	for _, instr := range c.instruments {
+		if err := instr.sendVolume(c.Key, csetter, onlySilenced); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // If channel has linked control (linked to corresponding instrument control)
 // then substitute instrument control as channel control, but with channel control key
 func (c *PresetChannel) GetControls() func(func(*PresetControl) bool) {
@@ -392,16 +704,32 @@ func (c *PresetChannel) GetControls() func(func(*PresetControl) bool) {
 // Other controls always regulated by MIDI CC
 func (p *PresetInstrument) HandleControlValue(channelKey string, control *PresetControl, value float32, csetter SamplerControlSetter) error {
 	slog.Debug("HandleControlValue", "control", control, "value", value)
+	if control.Type == CtrlMute || control.Type == CtrlSolo {
+		control.Value = switchValue(value)
+		if p.channel == nil {
+			return nil
+		}
+		return p.channel.applyMuteState(csetter, false)
+	}
 	control.Value = value
 	if control.MidiCC != 0 {
-		return csetter.SendChannelMidiCC(channelKey, control.MidiCC, roundFloat(control.Value, 0))
+		val := roundFloat(control.Value, 0)
+		// silenced instrument keeps value, sampler gets it on unmute
+		if control.Type == CtrlVolume && p.silenced() {
+			val = 0
+		}
+		return csetter.SendChannelMidiCC(channelKey, control.MidiCC, val)
 	} else {
 		if (control.Type == CtrlVolume || control.Type == CtrlPan) && len(control.linkedTo) > 0 {
 			// do control via layers controls
 			for _, lr := range p.Layers {
 				if lctrl, ok := lr.Controls.FindControlByType(control.Type); ok {
 					// don't call layer HandleControlValue, because it store layer control value. It's not needed
-					err := csetter.SendChannelMidiCC(channelKey, lctrl.MidiCC, roundFloat(control.Value*lctrl.Value, 0))
+					val := roundFloat(control.Value*lctrl.Value, 0)
+					if control.Type == CtrlVolume && lr.silenced() {
+						val = 0
+					}
+					err := csetter.SendChannelMidiCC(channelKey, lctrl.MidiCC, val)
 					if err != nil {
 						return err
 					}
@@ -413,6 +741,87 @@ func (p *PresetInstrument) HandleControlValue(channelKey string, control *Preset
 	return nil
 }
 
+// hasVolumeCC reports whether instrument or its layer has volume regulated by MIDI CC, so it can be silenced
+func (p *PresetInstrument) hasVolumeCC() bool {
+	if ctrl, ok := p.Controls.FindControlByType(CtrlVolume); ok && ctrl.MidiCC != 0 {
+		return true
+	}
+	for _, lr := range p.Layers {
+		if ctrl, ok := lr.Controls.FindControlByType(CtrlVolume); ok && ctrl.MidiCC != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// soloed reports whether instrument or its layer is soloed
+func (p *PresetInstrument) soloed() bool {
+	if p.Controls.switchState(CtrlSolo, p.Solo) {
+		return true
+	}
+	for _, lr := range p.Layers {
+		if lr.Controls.switchState(CtrlSolo, lr.Solo) {
+			return true
+		}
+	}
+	return false
+}
+
+// silenced reports whether instrument is muted or other instrument of the channel is soloed
+func (p *PresetInstrument) silenced() bool {
+	if p.Controls.switchState(CtrlMute, p.Mute) {
+		return true
+	}
+	if p.channel == nil || p.soloed() {
+		return false
+	}
+	for _, instr := range p.channel.instruments {
+		if instr.soloed() {
+			return true
+		}
+	}
+	return false
+}
+
+// sendVolume sends volume MIDI CC of instrument and of its layers, silenced ones get 0.
+// If onlySilenced, volume of sounding ones isn't sent
+func (p *PresetInstrument) sendVolume(channelKey string, csetter SamplerControlSetter, onlySilenced bool) error {
+	silenced := p.silenced()
+	vol, ok := p.Controls.FindControlByType(CtrlVolume)
+	if ok && vol.MidiCC != 0 && (silenced || !onlySilenced) {
+		val := roundFloat(vol.Value, 0)
+		if silenced {
+			val = 0
+		}
+		if err := csetter.SendChannelMidiCC(channelKey, vol.MidiCC, val); err != nil {
+			return err
+		}
+	}
+	for _, k := range slices.Sorted(maps.Keys(p.Layers)) {
+		lr := p.Layers[k]
+		lctrl, ok := lr.Controls.FindControlByType(CtrlVolume)
+		if !ok || lctrl.MidiCC == 0 {
+			continue
+		}
+		lrSilenced := lr.silenced()
+		if onlySilenced && !lrSilenced {
+			continue
+		}
+		corr := float32(1.0)
+		if lctrl.linkedWith != nil {
+			corr = lctrl.linkedWith.Value
+		}
+		val := roundFloat(lctrl.Value*corr, 0)
+		if lrSilenced {
+			val = 0
+		}
+		if err := csetter.SendChannelMidiCC(channelKey, lctrl.MidiCC, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (c *PresetInstrument) GetControls() func(func(*PresetControl) bool) {
 	return func(yield func(*PresetControl) bool) {
 		for _, c := range c.Controls {
@@ -429,6 +838,13 @@ func (c *PresetInstrument) GetControls() func(func(*PresetControl) bool) {
 
 func (p *PresetLayer) HandleControlValue(channelKey string, control *PresetControl, value float32, csetter SamplerControlSetter) error {
 	slog.Debug("HandleControlValue", "control", control, "value", value)
+	if control.Type == CtrlMute || control.Type == CtrlSolo {
+		control.Value = switchValue(value)
+		if p.instrument == nil || p.instrument.channel == nil {
+			return nil
+		}
+		return p.instrument.channel.applyMuteState(csetter, false)
+	}
 	instrCorr := float32(1.0)
 	if control.Type == CtrlVolume || control.Type == CtrlPan {
 		// get instrument correction value
@@ -438,11 +854,37 @@ func (p *PresetLayer) HandleControlValue(channelKey string, control *PresetContr
 	}
 	control.Value = value
 	if control.MidiCC != 0 {
-		return csetter.SendChannelMidiCC(channelKey, control.MidiCC, roundFloat(control.Value*instrCorr, 0))
+		val := roundFloat(control.Value*instrCorr, 0)
+		if control.Type == CtrlVolume && p.silenced() {
+			val = 0
+		}
+		return csetter.SendChannelMidiCC(channelKey, control.MidiCC, val)
 	}
 	return nil
 }
 
+// silenced reports whether layer is muted, its instrument is silenced or other layer of instrument is soloed
+func (p *PresetLayer) silenced() bool {
+	if p.Controls.switchState(CtrlMute, p.Mute) {
+		return true
+	}
+	if p.instrument == nil {
+		return false
+	}
+	if p.instrument.silenced() {
+		return true
+	}
+	if p.Controls.switchState(CtrlSolo, p.Solo) {
+		return false
+	}
+	for _, lr := range p.instrument.Layers {
+		if lr.Controls.switchState(CtrlSolo, lr.Solo) {
+			return true
+		}
+	}
+	return false
+}
+
 // Layer can be in instrument with virtual controls of volume or pan
 // In that case its required to calculate correction value by linked instrument control
 func (c *PresetLayer) GetControls() func(func(*PresetControl) bool) {
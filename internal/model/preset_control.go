@@ -10,30 +10,54 @@ const (
 	CTPan
 	CTPitch
 	CTOther
+	CTMute
+	CTSolo
+	CTFxParam
+	CTSend
 )
 
 var ControlTypeToString = map[ControlType]string{
-	CTVolume: "volume",
-	CTPan:    "pan",
-	CTPitch:  "pitch",
-	CTOther:  "other",
+	CTVolume:  "volume",
+	CTPan:     "pan",
+	CTPitch:   "pitch",
+	CTOther:   "other",
+	CTMute:    "mute",
+	CTSolo:    "solo",
+	CTFxParam: "fxparam",
+	CTSend:    "send",
 }
 
 var ControlTypeFromString = map[string]ControlType{
-	"volume": CTVolume,
-	"pan":    CTPan,
-	"pitch":  CTPitch,
-	"other":  CTOther,
+	"volume":  CTVolume,
+	"pan":     CTPan,
+	"pitch":   CTPitch,
+	"other":   CTOther,
+	"mute":    CTMute,
+	"solo":    CTSolo,
+	"fxparam": CTFxParam,
+	"send":    CTSend,
 }
 
 var (
 	CtrlVolume = ControlTypeToString[CTVolume]
 	CtrlPan    = ControlTypeToString[CTPan]
+	// mute and solo controls are generated by PrepareToLoad from state of channel, instrument and layer.
+	// Value 1 - on, 0 - off
+	CtrlMute = ControlTypeToString[CTMute]
+	CtrlSolo = ControlTypeToString[CTSolo]
+	// value of effect param isn't normalized, range is read from sampler
+	CtrlFxParam = ControlTypeToString[CTFxParam]
+	// level of FX send of channel to effect chain with the same key as control. Regulated by MIDI CC
+	CtrlSend = ControlTypeToString[CTSend]
 )
 
 type SamplerControlSetter interface {
 	SendChannelMidiCC(channelKey string, cc int, value float32) error
 	SetChannelVolume(channelKey string, value float32) error
+	SetChannelMute(channelKey string, mute bool) error
+	SetChannelSolo(channelKey string, solo bool) error
+	// param - index of param of effect plugin
+	SetFxParam(fxKey string, param int, value float32) error
 }
 
 type ControlOwner interface {
@@ -44,6 +68,7 @@ type ControlMap map[string]*PresetControl
 
 // CfgKey - sfz-variable key, same value as Instrument.Controls
 // Key - unique id across preset. Used for identification control for communication between srv and ui
+// Address - id of control, which doesn't change on adding or reordering of channels and instruments
 // linkedTo - ref to control, example: channel volume control linked to instrument volume control
 // linkedWith - ref from control, example: instrument volume control linked from channel volume control
 type PresetControl struct {
@@ -53,9 +78,14 @@ type PresetControl struct {
 	CfgKey     string  `yaml:"-" json:"-"`
 	Value      float32 `yaml:"value" json:"value"`
 	Key        string  `yaml:"-" json:"-"`
+	Address    string  `yaml:"-" json:"-"`
 	owner      ControlOwner
 	linkedTo   []*PresetControl
 	linkedWith *PresetControl
+	// control is created by PrepareToLoad and isn't stored
+	generated bool
+	// param of effect read from sampler
+	fxParam *FxParam
 }
 
 func (c ControlMap) GetControlByType(t string) (*PresetControl, bool) {
@@ -85,6 +115,66 @@ func (c ControlMap) FindControlByType(t string) (*PresetControl, bool) {
 	return nil, false
 }
 
+// switchState returns state of mute or solo control. def is returned if there is no such control
+func (c ControlMap) switchState(t string, def bool) bool {
+	if ctrl, ok := c.FindControlByType(t); ok {
+		return ctrl.Value != 0
+	}
+	return def
+}
+
+// addSwitchControls adds generated mute and solo controls. Existing controls keep their state
+func (c ControlMap) addSwitchControls(keyPrefix string, mute, solo bool, owner ControlOwner) []*PresetControl {
+	res := make([]*PresetControl, 0, 2)
+	for _, sw := range []struct {
+		t    string
+		name string
+		on   bool
+	}{{CtrlMute, "Mute", mute}, {CtrlSolo, "Solo", solo}} {
+		ctrl := &PresetControl{
+			Name:      sw.name,
+			Type:      sw.t,
+			Key:       keyPrefix + sw.t,
+			owner:     owner,
+			generated: true,
+		}
+		if c.switchState(sw.t, sw.on) {
+			ctrl.Value = 1
+		}
+		c[sw.t] = ctrl
+		res = append(res, ctrl)
+	}
+	return res
+}
+
+// switchValue converts normalized value of mute or solo control to its state
+func switchValue(value float32) float32 {
+	if value >= 0.5 {
+		return 1
+	}
+	return 0
+}
+
+// toStore returns copy of controls with current values. Generated controls are skipped
+func (c ControlMap) toStore() ControlMap {
+	if c == nil {
+		return nil
+	}
+	res := make(ControlMap, len(c))
+	for k, ctrl := range c {
+		if ctrl.generated {
+			continue
+		}
+		res[k] = &PresetControl{
+			Name:   ctrl.Name,
+			Type:   ctrl.Type,
+			MidiCC: ctrl.MidiCC,
+			Value:  ctrl.Value,
+		}
+	}
+	return res
+}
+
 func (c *PresetControl) SetValue(value float32, channelKey string, csetter SamplerControlSetter) error {
 	var val float32
 	if c.Type == CtrlPan {
@@ -96,6 +186,9 @@ func (c *PresetControl) SetValue(value float32, channelKey string, csetter Sampl
 }
 
 func (c *PresetControl) GetNormalizedValue() (val float32, min float32, max float32) {
+	if c.fxParam != nil {
+		return c.normalizeFxParam()
+	}
 	if c.Type == CtrlPan {
 		return c.normalizePan()
 	}
@@ -9,9 +9,14 @@ import (
 type callParam struct {
 	VolumeCall bool
 	MidiCCCall bool
+	MuteCall   bool
+	SoloCall   bool
+	FxCall     bool
 	Value      float32
 	MidiCC     int
 	ChannelKey string
+	FxKey      string
+	FxParam    int
 }
 
 // MockSamplerControlSetter implements SamplerControlSetter interface for testing
@@ -40,6 +45,28 @@ func (m *MockSamplerControlSetter) SendChannelMidiCC(channelKey string, cc int,
 	return nil
 }
 
+func (m *MockSamplerControlSetter) SetChannelMute(channelKey string, mute bool) error {
+	m.CallParams = append(m.CallParams, callParam{MuteCall: true, Value: boolValue(mute), ChannelKey: channelKey})
+	return nil
+}
+
+func (m *MockSamplerControlSetter) SetChannelSolo(channelKey string, solo bool) error {
+	m.CallParams = append(m.CallParams, callParam{SoloCall: true, Value: boolValue(solo), ChannelKey: channelKey})
+	return nil
+}
+
+func (m *MockSamplerControlSetter) SetFxParam(fxKey string, param int, value float32) error {
+	m.CallParams = append(m.CallParams, callParam{FxCall: true, Value: value, FxKey: fxKey, FxParam: param})
+	return nil
+}
+
+func boolValue(b bool) float32 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
 func (m *MockSamplerControlSetter) Compare(t *testing.T, wants []callParam) {
 	wl := len(wants)
 	if len(m.CallParams) != wl {
@@ -320,3 +347,253 @@ func Test_SetControlValue(t *testing.T) {
 		})
 	}
 }
+
+func Test_ApplyControlValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		testData string
+		wants    []callParam
+	}{
+		{
+			name:     "channel volume and instrument MIDI CC",
+			testData: "single_instrument.yaml",
+			wants: []callParam{
+				{VolumeCall: true, Value: 1, ChannelKey: "ch1"},
+				{MidiCCCall: true, Value: 54, MidiCC: 10, ChannelKey: "ch1"},
+				{MidiCCCall: true, Value: 95, MidiCC: 30, ChannelKey: "ch1"},
+				{VolumeCall: true, Value: 1, ChannelKey: SamplerChannelKey},
+			},
+		},
+		{
+			// instrument virtual volume is applied through layers
+			name:     "layers with instrument correction",
+			testData: "single instr_with_layers.yaml",
+			wants: []callParam{
+				{VolumeCall: true, Value: 0.65, ChannelKey: "ch1"},
+				{MidiCCCall: true, Value: 76, MidiCC: 104, ChannelKey: "ch1"},
+				{MidiCCCall: true, Value: 86, MidiCC: 103, ChannelKey: "ch1"},
+				{MidiCCCall: true, Value: 75, MidiCC: 105, ChannelKey: "ch1"},
+				{MidiCCCall: true, Value: 120, MidiCC: 16, ChannelKey: "ch1"},
+				{VolumeCall: true, Value: 1, ChannelKey: SamplerChannelKey},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			preset := loadPresetFromYAML(t, tt.testData)
+			if err := preset.PrepareToLoad([]MIDIDevice{&MockMMIDIDevice{}}); err != nil {
+				t.Fatalf("PrepareToLoad() error = %v", err)
+			}
+			mockSetter := &MockSamplerControlSetter{}
+			if err := preset.ApplyControlValues(mockSetter); err != nil {
+				t.Fatalf("ApplyControlValues() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.wants, mockSetter.CallParams); diff != "" {
+				t.Errorf("callParams mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if err := (&KitPreset{}).ApplyControlValues(&MockSamplerControlSetter{}); err == nil {
+		t.Error("ApplyControlValues() of not prepared preset should fail")
+	}
+}
+
+func Test_SetControlMidiValue(t *testing.T) {
+	preset := loadPresetFromYAML(t, "single instr_with_layers.yaml")
+	if err := preset.PrepareToLoad([]MIDIDevice{&MockMMIDIDevice{}}); err != nil {
+		t.Fatalf("PrepareToLoad() error = %v", err)
+	}
+	changedKeys := func(ctrls []*PresetControl) []string {
+		var keys []string
+		for _, c := range ctrls {
+			keys = append(keys, c.Key)
+		}
+		return keys
+	}
+
+	tests := []struct {
+		name       string
+		channelKey string
+		cc         int
+		value      int
+		wantKeys   []string
+		wantValue  float32
+	}{
+		{name: "instrument control", channelKey: "ch1", cc: 105, value: 64, wantKeys: []string{"i0pan"}, wantValue: 64},
+		{name: "same value", channelKey: "ch1", cc: 105, value: 64},
+		// sampler got bell volume 80 * instrument volume 0.95
+		{name: "layer value corrected by instrument", channelKey: "ch1", cc: 104, value: 57, wantKeys: []string{"i0bellvolume"}, wantValue: 60},
+		{name: "layer value isn't more than max", channelKey: "ch1", cc: 103, value: 127, wantKeys: []string{"i0edgevolume"}, wantValue: 127},
+		{name: "other channel", channelKey: "ch2", cc: 105, value: 10},
+		{name: "unbound CC", channelKey: "ch1", cc: 1, value: 10},
+		{name: "CC 0 isn't bound", channelKey: "ch1", cc: 0, value: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed := preset.SetControlMidiValue(tt.channelKey, tt.cc, tt.value)
+			if diff := cmp.Diff(tt.wantKeys, changedKeys(changed)); diff != "" {
+				t.Fatalf("changed controls mismatch (-want +got):\n%s", diff)
+			}
+			for _, c := range changed {
+				if c.Value != tt.wantValue {
+					t.Errorf("value of %s = %v, want %v", c.Key, c.Value, tt.wantValue)
+				}
+			}
+		})
+	}
+}
+
+// Steps are applied to the same preset one by one
+func Test_MuteSolo(t *testing.T) {
+	type step struct {
+		name       string
+		controlKey string
+		value      float32
+		wants      []callParam
+	}
+	tests := []struct {
+		name     string
+		testData string
+		steps    []step
+	}{
+		{
+			name:     "instruments",
+			testData: "two_instruments_channel_virtual_pan.yaml",
+			steps: []step{
+				{
+					name:       "mute instrument",
+					controlKey: "i0mute",
+					value:      1,
+					wants: []callParam{
+						{MuteCall: true, ChannelKey: "ch1"},
+						{SoloCall: true, ChannelKey: "ch1"},
+						{MidiCCCall: true, Value: 0, MidiCC: 30, ChannelKey: "ch1"},
+						{MidiCCCall: true, Value: 87, MidiCC: 31, ChannelKey: "ch1"},
+					},
+				},
+				{
+					// sampler channel is soloed with instrument
+					name:       "solo other instrument",
+					controlKey: "i1solo",
+					value:      1,
+					wants: []callParam{
+						{MuteCall: true, ChannelKey: "ch1"},
+						{SoloCall: true, Value: 1, ChannelKey: "ch1"},
+						{MidiCCCall: true, Value: 0, MidiCC: 30, ChannelKey: "ch1"},
+						{MidiCCCall: true, Value: 87, MidiCC: 31, ChannelKey: "ch1"},
+					},
+				},
+				{
+					name:       "unmuted instrument is silenced by solo",
+					controlKey: "i0mute",
+					value:      0,
+					wants: []callParam{
+						{MuteCall: true, ChannelKey: "ch1"},
+						{SoloCall: true, Value: 1, ChannelKey: "ch1"},
+						{MidiCCCall: true, Value: 0, MidiCC: 30, ChannelKey: "ch1"},
+						{MidiCCCall: true, Value: 87, MidiCC: 31, ChannelKey: "ch1"},
+					},
+				},
+				{
+					name:       "unsolo instrument",
+					controlKey: "i1solo",
+					value:      0.2,
+					wants: []callParam{
+						{MuteCall: true, ChannelKey: "ch1"},
+						{SoloCall: true, ChannelKey: "ch1"},
+						{MidiCCCall: true, Value: 95, MidiCC: 30, ChannelKey: "ch1"},
+						{MidiCCCall: true, Value: 87, MidiCC: 31, ChannelKey: "ch1"},
+					},
+				},
+				{
+					name:       "mute channel",
+					controlKey: "c0mute",
+					value:      1,
+					wants: []callParam{
+						{MuteCall: true, Value: 1, ChannelKey: "ch1"},
+						{SoloCall: true, ChannelKey: "ch1"},
+						{MidiCCCall: true, Value: 95, MidiCC: 30, ChannelKey: "ch1"},
+						{MidiCCCall: true, Value: 87, MidiCC: 31, ChannelKey: "ch1"},
+					},
+				},
+			},
+		},
+		{
+			name:     "layers",
+			testData: "single instr_with_layers.yaml",
+			steps: []step{
+				{
+					// bell volume 80 * instrument volume 0.95
+					name:       "solo layer",
+					controlKey: "i0bellsolo",
+					value:      1,
+					wants: []callParam{
+						{MuteCall: true, ChannelKey: "ch1"},
+						{SoloCall: true, Value: 1, ChannelKey: "ch1"},
+						{MidiCCCall: true, Value: 76, MidiCC: 104, ChannelKey: "ch1"},
+						{MidiCCCall: true, Value: 0, MidiCC: 103, ChannelKey: "ch1"},
+					},
+				},
+				{
+					name:       "silenced layer keeps 0 volume",
+					controlKey: "i0edgevolume",
+					value:      0.5,
+					wants: []callParam{
+						{MidiCCCall: true, Value: 0, MidiCC: 103, ChannelKey: "ch1"},
+					},
+				},
+				{
+					name:       "mute instrument",
+					controlKey: "i0mute",
+					value:      1,
+					wants: []callParam{
+						{MuteCall: true, ChannelKey: "ch1"},
+						{SoloCall: true, Value: 1, ChannelKey: "ch1"},
+						{MidiCCCall: true, Value: 0, MidiCC: 104, ChannelKey: "ch1"},
+						{MidiCCCall: true, Value: 0, MidiCC: 103, ChannelKey: "ch1"},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			preset := loadPresetFromYAML(t, tt.testData)
+			if err := preset.PrepareToLoad([]MIDIDevice{&MockMMIDIDevice{}}); err != nil {
+				t.Fatalf("PrepareToLoad() error = %v", err)
+			}
+			for _, st := range tt.steps {
+				mockSetter := &MockSamplerControlSetter{}
+				if err := preset.SetControlValue(st.controlKey, st.value, mockSetter); err != nil {
+					t.Fatalf("%s: SetControlValue() error = %v", st.name, err)
+				}
+				if diff := cmp.Diff(st.wants, mockSetter.CallParams); diff != "" {
+					t.Errorf("%s: callParams mismatch (-want +got):\n%s", st.name, diff)
+				}
+			}
+		})
+	}
+}
+
+func Test_ApplyMuteState(t *testing.T) {
+	preset := loadPresetFromYAML(t, "two_instruments_channel_virtual_pan.yaml")
+	preset.Channels[0].Mute = true
+	preset.Instruments[1].Mute = true
+	if err := preset.PrepareToLoad([]MIDIDevice{&MockMMIDIDevice{}}); err != nil {
+		t.Fatalf("PrepareToLoad() error = %v", err)
+	}
+	mockSetter := &MockSamplerControlSetter{}
+	if err := preset.ApplyMuteState(mockSetter); err != nil {
+		t.Fatalf("ApplyMuteState() error = %v", err)
+	}
+	// sampler has volume of sounding instrument
+	wants := []callParam{
+		{MuteCall: true, Value: 1, ChannelKey: "ch1"},
+		{SoloCall: true, ChannelKey: "ch1"},
+		{MidiCCCall: true, Value: 0, MidiCC: 31, ChannelKey: "ch1"},
+	}
+	if diff := cmp.Diff(wants, mockSetter.CallParams); diff != "" {
+		t.Errorf("callParams mismatch (-want +got):\n%s", diff)
+	}
+}
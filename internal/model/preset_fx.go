@@ -0,0 +1,191 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const FxSystemLADSPA = "LADSPA"
+
+// PresetFxChain is send effect chain of sampler audio output. Effects of chain process sound one by one.
+// Chain is built in sampler on loading of preset
+type PresetFxChain struct {
+	Key     string     `yaml:"key" json:"key"`
+	Name    string     `yaml:"name,omitempty" json:"name,omitempty"`
+	Effects []PresetFx `yaml:"effects" json:"effects"`
+}
+
+// PresetFx is effect plugin of chain. Key is unique across preset.
+// Module - plugin file, i.e. /usr/lib/ladspa/caps.so, Label - plugin in module, i.e. Plate.
+// Params - stored values of plugin params, key - p<param index>. Params without stored value get default value of plugin
+type PresetFx struct {
+	Key    string     `yaml:"key" json:"key"`
+	Name   string     `yaml:"name,omitempty" json:"name,omitempty"`
+	System string     `yaml:"system,omitempty" json:"system,omitempty"`
+	Module string     `yaml:"module" json:"module"`
+	Label  string     `yaml:"label" json:"label"`
+	Params ControlMap `yaml:"params,omitempty" json:"params,omitempty"`
+	// controls of params in order of plugin. Made by SetFxParams
+	params []*PresetControl
+}
+
+// FxParam is param of effect instance read from sampler. Min and Max are nil for unbounded param
+type FxParam struct {
+	Description   string
+	Value         float32
+	Min           *float32
+	Max           *float32
+	Possibilities []float32
+}
+
+// GetSystem returns effect system of plugin, LADSPA by default
+func (fx *PresetFx) GetSystem() string {
+	if fx.System == "" {
+		return FxSystemLADSPA
+	}
+	return fx.System
+}
+
+// ParamValues returns stored values of params. Key - param index
+func (fx *PresetFx) ParamValues() map[int]float32 {
+	res := make(map[int]float32, len(fx.Params))
+	for k, ctrl := range fx.Params {
+		idx, ok := fxParamIndex(k)
+		if !ok {
+			continue
+		}
+		res[idx] = ctrl.Value
+	}
+	return res
+}
+
+// ParamControls returns controls of params in order of plugin. Controls exist after SetFxParams
+func (fx *PresetFx) ParamControls() []*PresetControl {
+	return fx.params
+}
+
+// FxParam returns param of effect instance, which control regulates. Nil for controls of other types
+func (ctrl *PresetControl) FxParam() *FxParam {
+	return ctrl.fxParam
+}
+
+// Params of effects are set by sampler, value isn't converted
+func (fx *PresetFx) HandleControlValue(channelKey string, control *PresetControl, value float32, csetter SamplerControlSetter) error {
+	idx := slices.Index(fx.params, control)
+	if idx < 0 {
+		return fmt.Errorf("control '%s' isn't param of effect '%s'", control.Key, fx.Key)
+	}
+	control.Value = value
+	return csetter.SetFxParam(fx.Key, idx, value)
+}
+
+func fxParamKey(idx int) string {
+	return fmt.Sprintf("p%d", idx)
+}
+
+func fxParamIndex(key string) (int, bool) {
+	s, ok := strings.CutPrefix(key, "p")
+	if !ok {
+		return 0, false
+	}
+	idx, err := strconv.Atoi(s)
+	if err != nil || idx < 0 {
+		return 0, false
+	}
+	return idx, true
+}
+
+// SetFxParams makes controls of effect params read from sampler after building of effect chain.
+// Params keep stored values, the others get value of sampler. Controls of params are controls of sampler channel,
+// control key is x<chain index><effect key>p<param index>, address is sampler/<chain key>/<effect key>/p<param index>
+func (p *KitPreset) SetFxParams(fxKey string, params []FxParam) error {
+	if p.controls == nil {
+		return fmt.Errorf("controls not initialized")
+	}
+	var sampler *PresetChannel
+	for i := range p.Channels {
+		if p.Channels[i].Key == SamplerChannelKey {
+			sampler = &p.Channels[i]
+		}
+	}
+	if sampler == nil {
+		return fmt.Errorf("sampler channel not found")
+	}
+	for ci := range p.Fxs {
+		chain := &p.Fxs[ci]
+		for fi := range chain.Effects {
+			fx := &chain.Effects[fi]
+			if fx.Key != fxKey {
+				continue
+			}
+			if fx.Params == nil {
+				fx.Params = ControlMap{}
+			}
+			// controls of the previous loading
+			for _, ctrl := range fx.params {
+				delete(p.controls, ctrl.Key)
+				delete(p.addresses, ctrl.Address)
+			}
+			fx.params = make([]*PresetControl, len(params))
+			for i, prm := range params {
+				pkey := fxParamKey(i)
+				ctrl, ok := fx.Params[pkey]
+				if !ok {
+					ctrl = &PresetControl{Type: CtrlFxParam, Value: prm.Value}
+					fx.Params[pkey] = ctrl
+				}
+				if ctrl.Name == "" {
+					ctrl.Name = prm.Description
+				}
+				ctrl.Key = fmt.Sprintf("x%d%s%s", ci, fx.Key, pkey)
+				ctrl.Address = strings.Join([]string{SamplerChannelKey, chain.Key, fx.Key, pkey}, "/")
+				ctrl.owner = fx
+				ctrl.fxParam = &prm
+				fx.params[i] = ctrl
+				p.controls[ctrl.Key] = controlRef{channel: sampler, control: ctrl}
+				if p.addresses != nil {
+					p.addresses[ctrl.Address] = ctrl.Key
+				}
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("effect '%s' not found", fxKey)
+}
+
+// range of effect param. Unbounded param has range of float32
+func (ctrl *PresetControl) normalizeFxParam() (val float32, min float32, max float32) {
+	min, max = -math.MaxFloat32, math.MaxFloat32
+	if ctrl.fxParam.Min != nil {
+		min = *ctrl.fxParam.Min
+	}
+	if ctrl.fxParam.Max != nil {
+		max = *ctrl.fxParam.Max
+	}
+	return roundFloat(ctrl.Value, 3), min, max
+}
+
+// fxsToStore returns copy of effect chains with current values of params
+func (p *KitPreset) fxsToStore() []PresetFxChain {
+	if p.Fxs == nil {
+		return nil
+	}
+	res := make([]PresetFxChain, len(p.Fxs))
+	for i, chain := range p.Fxs {
+		res[i] = PresetFxChain{Key: chain.Key, Name: chain.Name}
+		for _, fx := range chain.Effects {
+			res[i].Effects = append(res[i].Effects, PresetFx{
+				Key:    fx.Key,
+				Name:   fx.Name,
+				System: fx.System,
+				Module: fx.Module,
+				Label:  fx.Label,
+				Params: fx.Params.toStore(),
+			})
+		}
+	}
+	return res
+}
@@ -0,0 +1,171 @@
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func makeFxParams() []FxParam {
+	var min, max float32 = 0, 1
+	return []FxParam{
+		{Description: "bandwidth", Value: 0.5, Min: &min, Max: &max},
+		{Description: "tail", Value: 0.3, Min: &min, Max: &max},
+		{Description: "blend", Value: 0.25},
+	}
+}
+
+func TestKitPreset_SetFxParams(t *testing.T) {
+	preset := loadPresetFromYAML(t, "single_instrument_with_fxs.yaml")
+	if err := preset.PrepareToLoad([]MIDIDevice{&MockMMIDIDevice{}}); err != nil {
+		t.Fatalf("PrepareToLoad() error = %v", err)
+	}
+	if err := preset.SetFxParams("plate", makeFxParams()); err != nil {
+		t.Fatalf("SetFxParams() error = %v", err)
+	}
+
+	ctrls := preset.Fxs[0].Effects[0].ParamControls()
+	if len(ctrls) != 3 {
+		t.Fatalf("ParamControls() = %d controls, want 3", len(ctrls))
+	}
+	// stored value is kept, the others get value of sampler
+	for i, want := range []struct {
+		key, address, name string
+		value              float32
+	}{
+		{"x0platep0", "sampler/reverb/plate/p0", "bandwidth", 0.5},
+		{"x0platep1", "sampler/reverb/plate/p1", "tail", 0.7},
+		{"x0platep2", "sampler/reverb/plate/p2", "blend", 0.25},
+	} {
+		ctrl, err := preset.GetControl(want.address)
+		if err != nil || ctrl != ctrls[i] {
+			t.Fatalf("GetControl(%s) = %v, %v, want param %d", want.address, ctrl, err, i)
+		}
+		if ctrl.Key != want.key || ctrl.Name != want.name || ctrl.Value != want.value {
+			t.Errorf("param %d = %s %s %v, want %s %s %v", i, ctrl.Key, ctrl.Name, ctrl.Value, want.key, want.name, want.value)
+		}
+	}
+
+	mockSetter := &MockSamplerControlSetter{}
+	if err := preset.SetControlValue("sampler/reverb/plate/p0", 0.8, mockSetter); err != nil {
+		t.Fatalf("SetControlValue() error = %v", err)
+	}
+	if err := preset.SetControlValue("x0platep1", 1.5, mockSetter); !errors.Is(err, ErrValueOutOfRange) {
+		t.Errorf("SetControlValue() out of range error = %v, want %v", err, ErrValueOutOfRange)
+	}
+	// unbounded param
+	if err := preset.SetControlValue("x0platep2", -10, mockSetter); err != nil {
+		t.Fatalf("SetControlValue() of unbounded param error = %v", err)
+	}
+	wants := []callParam{
+		{FxCall: true, Value: 0.8, FxKey: "plate", FxParam: 0},
+		{FxCall: true, Value: -10, FxKey: "plate", FxParam: 2},
+	}
+	if diff := cmp.Diff(wants, mockSetter.CallParams); diff != "" {
+		t.Errorf("callParams mismatch (-want +got):\n%s", diff)
+	}
+
+	stored := preset.ToStore().Fxs[0].Effects[0].Params
+	for k, want := range map[string]float32{"p0": 0.8, "p1": 0.7, "p2": -10} {
+		if stored[k] == nil || stored[k].Value != want {
+			t.Errorf("stored param %s = %v, want %v", k, stored[k], want)
+		}
+	}
+
+	// controls of the previous building are replaced
+	if err := preset.SetFxParams("plate", makeFxParams()[:1]); err != nil {
+		t.Fatalf("SetFxParams() error = %v", err)
+	}
+	if _, err := preset.GetControl("x0platep2"); !errors.Is(err, ErrControlNotFound) {
+		t.Errorf("GetControl() of removed param error = %v, want %v", err, ErrControlNotFound)
+	}
+	if err := preset.SetFxParams("hall", makeFxParams()); err == nil {
+		t.Errorf("SetFxParams() of unknown effect error = nil")
+	}
+}
+
+func TestKitPreset_ValidateFxs(t *testing.T) {
+	tests := []struct {
+		name    string
+		fxs     []PresetFxChain
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			fxs: []PresetFxChain{
+				{Key: "reverb", Effects: []PresetFx{{Key: "plate", Module: "caps.so", Label: "Plate", Params: ControlMap{"p1": {Value: 0.5}}}}},
+				{Key: "comp", Effects: []PresetFx{{Key: "comp", Module: "caps.so", Label: "Compress"}}},
+			},
+		},
+		{
+			name: "duplicate effect key",
+			fxs: []PresetFxChain{
+				{Key: "reverb", Effects: []PresetFx{{Key: "plate", Module: "caps.so", Label: "Plate"}}},
+				{Key: "reverb2", Effects: []PresetFx{{Key: "plate", Module: "caps.so", Label: "Plate"}}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "chain without key",
+			fxs:     []PresetFxChain{{Effects: []PresetFx{{Key: "plate", Module: "caps.so", Label: "Plate"}}}},
+			wantErr: true,
+		},
+		{
+			name:    "effect without label",
+			fxs:     []PresetFxChain{{Key: "reverb", Effects: []PresetFx{{Key: "plate", Module: "caps.so"}}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid param key",
+			fxs:     []PresetFxChain{{Key: "reverb", Effects: []PresetFx{{Key: "plate", Module: "caps.so", Label: "Plate", Params: ControlMap{"tail": {Value: 0.5}}}}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &KitPreset{Fxs: tt.fxs}
+			if errs := p.validateFxs(); (len(errs) > 0) != tt.wantErr {
+				t.Errorf("validateFxs() = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKitPreset_Send(t *testing.T) {
+	preset := loadPresetFromYAML(t, "single_instrument_with_fxs.yaml")
+	if err := preset.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if err := preset.PrepareToLoad([]MIDIDevice{&MockMMIDIDevice{}}); err != nil {
+		t.Fatalf("PrepareToLoad() error = %v", err)
+	}
+	sends := preset.Channels[0].Sends()
+	if len(sends) != 1 || sends["reverb"] == nil {
+		t.Fatalf("Sends() = %v, want send to reverb", sends)
+	}
+
+	mockSetter := &MockSamplerControlSetter{}
+	if err := preset.SetControlValue("ch1/reverb", 0.5, mockSetter); err != nil {
+		t.Fatalf("SetControlValue() error = %v", err)
+	}
+	wants := []callParam{{MidiCCCall: true, Value: 64, MidiCC: 91, ChannelKey: "ch1"}}
+	if diff := cmp.Diff(wants, mockSetter.CallParams); diff != "" {
+		t.Errorf("callParams mismatch (-want +got):\n%s", diff)
+	}
+	// level changed by knob of drum module
+	if changed := preset.SetControlMidiValue("ch1", 91, 100); len(changed) != 1 || changed[0] != sends["reverb"] {
+		t.Errorf("SetControlMidiValue() = %v, want send control", changed)
+	}
+	if got := preset.ToStore().Channels[0].Controls["reverb"]; got == nil || got.Value != 100 || got.Type != CtrlSend {
+		t.Errorf("stored send = %v, want value 100", got)
+	}
+
+	// send without MIDI CC and to unknown chain
+	preset = loadPresetFromYAML(t, "single_instrument_with_fxs.yaml")
+	preset.Channels[0].Controls["hall"] = &PresetControl{Type: CtrlSend, Value: 10}
+	var errs MultiValidationError
+	if err := preset.Validate(); !errors.As(err, &errs) || len(errs) != 2 {
+		t.Errorf("Validate() error = %v, want 2 errors of send", err)
+	}
+}
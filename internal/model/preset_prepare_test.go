@@ -37,15 +37,17 @@ func Test_PrepareToLoad(t *testing.T) {
 						Key:  "ch1",
 						Name: "Kick",
 						Controls: map[string]*PresetControl{
-							"volume": {Key: "c0volume", Name: "Volume", Type: "volume", Value: 1.00},
-							"pan":    {Key: "c0pan", Name: "Pan", Type: "pan"},
+							"volume": {Key: "c0volume", Address: "ch1/volume", Name: "Volume", Type: "volume", Value: 1.00},
+							"pan":    {Key: "c0pan", Address: "ch1/pan", Name: "Pan", Type: "pan"},
+							"mute":   {Key: "c0mute", Address: "ch1/mute", Name: "Mute", Type: "mute"},
+							"solo":   {Key: "c0solo", Address: "ch1/solo", Name: "Solo", Type: "solo"},
 						},
 					},
 					{
 						Key:  "sampler",
 						Name: "Kit",
 						Controls: map[string]*PresetControl{
-							"volume": {Key: "s0volume", Name: "Volume", Type: "volume", Value: 1.00},
+							"volume": {Key: "s0volume", Address: "sampler/volume", Name: "Volume", Type: "volume", Value: 1.00},
 						},
 					},
 				},
@@ -56,19 +58,25 @@ func Test_PrepareToLoad(t *testing.T) {
 						MidiKey:    "kick1",
 						MidiNote:   36,
 						Controls: map[string]*PresetControl{
-							"volume": {Key: "i0volume", Name: "Volume", MidiCC: 30, CfgKey: "KICKV", Type: "volume", Value: 95},
-							"pan":    {Key: "i0pan", Name: "Pan", MidiCC: 10, CfgKey: "KICKP", Type: "pan", Value: 54},
+							"volume": {Key: "i0volume", Address: "ch1/kick/volume", Name: "Volume", MidiCC: 30, CfgKey: "KICKV", Type: "volume", Value: 95},
+							"pan":    {Key: "i0pan", Address: "ch1/kick/pan", Name: "Pan", MidiCC: 10, CfgKey: "KICKP", Type: "pan", Value: 54},
+							"mute":   {Key: "i0mute", Address: "ch1/kick/mute", Name: "Mute", Type: "mute"},
+							"solo":   {Key: "i0solo", Address: "ch1/kick/solo", Name: "Solo", Type: "solo"},
 						},
 					},
 				},
 			},
 			wantErr: false,
 			expectedControls: ExpectedControls{
-				"s0volume": {Key: "s0volume", Name: "Volume", Type: "volume", Value: 1.00},
-				"c0volume": {Key: "c0volume", Name: "Volume", Type: "volume", Value: 1.00},
-				"c0pan":    {Key: "c0pan", Name: "Pan", Type: "pan"},
-				"i0volume": {Key: "i0volume", Name: "Volume", Type: "volume", MidiCC: 30, CfgKey: "KICKV", Value: 95},
-				"i0pan":    {Key: "i0pan", Name: "Pan", Type: "pan", MidiCC: 10, CfgKey: "KICKP", Value: 54},
+				"s0volume": {Key: "s0volume", Address: "sampler/volume", Name: "Volume", Type: "volume", Value: 1.00},
+				"c0volume": {Key: "c0volume", Address: "ch1/volume", Name: "Volume", Type: "volume", Value: 1.00},
+				"c0pan":    {Key: "c0pan", Address: "ch1/pan", Name: "Pan", Type: "pan"},
+				"c0mute":   {Key: "c0mute", Address: "ch1/mute", Name: "Mute", Type: "mute"},
+				"c0solo":   {Key: "c0solo", Address: "ch1/solo", Name: "Solo", Type: "solo"},
+				"i0volume": {Key: "i0volume", Address: "ch1/kick/volume", Name: "Volume", Type: "volume", MidiCC: 30, CfgKey: "KICKV", Value: 95},
+				"i0pan":    {Key: "i0pan", Address: "ch1/kick/pan", Name: "Pan", Type: "pan", MidiCC: 10, CfgKey: "KICKP", Value: 54},
+				"i0mute":   {Key: "i0mute", Address: "ch1/kick/mute", Name: "Mute", Type: "mute"},
+				"i0solo":   {Key: "i0solo", Address: "ch1/kick/solo", Name: "Solo", Type: "solo"},
 			},
 		},
 		{
@@ -84,15 +92,17 @@ func Test_PrepareToLoad(t *testing.T) {
 					{
 						Key: "ch1",
 						Controls: map[string]*PresetControl{
-							"volume": {Key: "c0volume", Type: "volume", Value: 0.65},
-							"pan":    {Key: "c0pan", Name: "Pan", Type: "pan"},
+							"volume": {Key: "c0volume", Address: "ch1/volume", Type: "volume", Value: 0.65},
+							"pan":    {Key: "c0pan", Address: "ch1/pan", Name: "Pan", Type: "pan"},
+							"mute":   {Key: "c0mute", Address: "ch1/mute", Name: "Mute", Type: "mute"},
+							"solo":   {Key: "c0solo", Address: "ch1/solo", Name: "Solo", Type: "solo"},
 						},
 					},
 					{
 						Key:  "sampler",
 						Name: "Kit",
 						Controls: map[string]*PresetControl{
-							"volume": {Key: "s0volume", Name: "Volume", Type: "volume", Value: 1.00},
+							"volume": {Key: "s0volume", Address: "sampler/volume", Name: "Volume", Type: "volume", Value: 1.00},
 						},
 					},
 				},
@@ -101,9 +111,11 @@ func Test_PrepareToLoad(t *testing.T) {
 						Name:       "Ride",
 						ChannelKey: "ch1",
 						Controls: map[string]*PresetControl{
-							"pan":    {Key: "i0pan", Name: "Pan", MidiCC: 105, CfgKey: "RI17P", Type: "pan", Value: 75},
-							"pitch":  {Key: "i0pitch", MidiCC: 16, CfgKey: "RI17T", Type: "pitch", Value: 120},
-							"volume": {Key: "i0volume", Type: "volume", Value: 0.95},
+							"pan":    {Key: "i0pan", Address: "ch1/ride1/pan", Name: "Pan", MidiCC: 105, CfgKey: "RI17P", Type: "pan", Value: 75},
+							"pitch":  {Key: "i0pitch", Address: "ch1/ride1/pitch", MidiCC: 16, CfgKey: "RI17T", Type: "pitch", Value: 120},
+							"volume": {Key: "i0volume", Address: "ch1/ride1/volume", Type: "volume", Value: 0.95},
+							"mute":   {Key: "i0mute", Address: "ch1/ride1/mute", Name: "Mute", Type: "mute"},
+							"solo":   {Key: "i0solo", Address: "ch1/ride1/solo", Name: "Solo", Type: "solo"},
 						},
 						Layers: map[string]PresetLayer{
 							"bell": {
@@ -111,7 +123,9 @@ func Test_PrepareToLoad(t *testing.T) {
 								CfgMidiKey: "RI17BKEY",
 								MidiNote:   53,
 								Controls: map[string]*PresetControl{
-									"volume": {Key: "i0bellvolume", MidiCC: 104, CfgKey: "RI17BV", Type: "volume", Value: 80},
+									"volume": {Key: "i0bellvolume", Address: "ch1/ride1/bell/volume", MidiCC: 104, CfgKey: "RI17BV", Type: "volume", Value: 80},
+									"mute":   {Key: "i0bellmute", Address: "ch1/ride1/bell/mute", Name: "Mute", Type: "mute"},
+									"solo":   {Key: "i0bellsolo", Address: "ch1/ride1/bell/solo", Name: "Solo", Type: "solo"},
 								},
 							},
 							"edge": {
@@ -119,7 +133,9 @@ func Test_PrepareToLoad(t *testing.T) {
 								CfgMidiKey: "RI17EKEY",
 								MidiNote:   51,
 								Controls: map[string]*PresetControl{
-									"volume": {Key: "i0edgevolume", MidiCC: 103, CfgKey: "RI17EV", Type: "volume", Value: 90},
+									"volume": {Key: "i0edgevolume", Address: "ch1/ride1/edge/volume", MidiCC: 103, CfgKey: "RI17EV", Type: "volume", Value: 90},
+									"mute":   {Key: "i0edgemute", Address: "ch1/ride1/edge/mute", Name: "Mute", Type: "mute"},
+									"solo":   {Key: "i0edgesolo", Address: "ch1/ride1/edge/solo", Name: "Solo", Type: "solo"},
 								},
 							},
 						},
@@ -128,14 +144,22 @@ func Test_PrepareToLoad(t *testing.T) {
 			},
 			wantErr: false,
 			expectedControls: ExpectedControls{
-				"s0volume":     {Key: "s0volume", Name: "Volume", Type: "volume", Value: 1.00},
-				"c0volume":     {Key: "c0volume", Type: "volume", Value: 0.65},
-				"c0pan":        {Key: "c0pan", Name: "Pan", Type: "pan"},
-				"i0pan":        {Key: "i0pan", Name: "Pan", MidiCC: 105, CfgKey: "RI17P", Type: "pan", Value: 75},
-				"i0pitch":      {Key: "i0pitch", MidiCC: 16, CfgKey: "RI17T", Type: "pitch", Value: 120},
-				"i0volume":     {Key: "i0volume", Type: "volume", Value: 0.95},
-				"i0bellvolume": {Key: "i0bellvolume", MidiCC: 104, CfgKey: "RI17BV", Type: "volume", Value: 80},
-				"i0edgevolume": {Key: "i0edgevolume", MidiCC: 103, CfgKey: "RI17EV", Type: "volume", Value: 90},
+				"s0volume":     {Key: "s0volume", Address: "sampler/volume", Name: "Volume", Type: "volume", Value: 1.00},
+				"c0volume":     {Key: "c0volume", Address: "ch1/volume", Type: "volume", Value: 0.65},
+				"c0pan":        {Key: "c0pan", Address: "ch1/pan", Name: "Pan", Type: "pan"},
+				"c0mute":       {Key: "c0mute", Address: "ch1/mute", Name: "Mute", Type: "mute"},
+				"c0solo":       {Key: "c0solo", Address: "ch1/solo", Name: "Solo", Type: "solo"},
+				"i0pan":        {Key: "i0pan", Address: "ch1/ride1/pan", Name: "Pan", MidiCC: 105, CfgKey: "RI17P", Type: "pan", Value: 75},
+				"i0pitch":      {Key: "i0pitch", Address: "ch1/ride1/pitch", MidiCC: 16, CfgKey: "RI17T", Type: "pitch", Value: 120},
+				"i0volume":     {Key: "i0volume", Address: "ch1/ride1/volume", Type: "volume", Value: 0.95},
+				"i0mute":       {Key: "i0mute", Address: "ch1/ride1/mute", Name: "Mute", Type: "mute"},
+				"i0solo":       {Key: "i0solo", Address: "ch1/ride1/solo", Name: "Solo", Type: "solo"},
+				"i0bellvolume": {Key: "i0bellvolume", Address: "ch1/ride1/bell/volume", MidiCC: 104, CfgKey: "RI17BV", Type: "volume", Value: 80},
+				"i0bellmute":   {Key: "i0bellmute", Address: "ch1/ride1/bell/mute", Name: "Mute", Type: "mute"},
+				"i0bellsolo":   {Key: "i0bellsolo", Address: "ch1/ride1/bell/solo", Name: "Solo", Type: "solo"},
+				"i0edgevolume": {Key: "i0edgevolume", Address: "ch1/ride1/edge/volume", MidiCC: 103, CfgKey: "RI17EV", Type: "volume", Value: 90},
+				"i0edgemute":   {Key: "i0edgemute", Address: "ch1/ride1/edge/mute", Name: "Mute", Type: "mute"},
+				"i0edgesolo":   {Key: "i0edgesolo", Address: "ch1/ride1/edge/solo", Name: "Solo", Type: "solo"},
 			},
 		},
 		{
@@ -154,15 +178,17 @@ func Test_PrepareToLoad(t *testing.T) {
 						Key:  "ch1",
 						Name: "Drums",
 						Controls: map[string]*PresetControl{
-							"volume": {Key: "c0volume", Name: "Volume", Type: "volume", Value: 1.00},
-							"pan":    {Key: "c0pan", Name: "Pan", Type: "pan", Value: 0.00},
+							"volume": {Key: "c0volume", Address: "ch1/volume", Name: "Volume", Type: "volume", Value: 1.00},
+							"pan":    {Key: "c0pan", Address: "ch1/pan", Name: "Pan", Type: "pan", Value: 0.00},
+							"mute":   {Key: "c0mute", Address: "ch1/mute", Name: "Mute", Type: "mute"},
+							"solo":   {Key: "c0solo", Address: "ch1/solo", Name: "Solo", Type: "solo"},
 						},
 					},
 					{
 						Key:  "sampler",
 						Name: "Kit",
 						Controls: map[string]*PresetControl{
-							"volume": {Key: "s0volume", Name: "Volume", Type: "volume", Value: 1.00},
+							"volume": {Key: "s0volume", Address: "sampler/volume", Name: "Volume", Type: "volume", Value: 1.00},
 						},
 					},
 				},
@@ -173,8 +199,10 @@ func Test_PrepareToLoad(t *testing.T) {
 						MidiKey:    "kick1",
 						MidiNote:   36,
 						Controls: map[string]*PresetControl{
-							"volume": {Key: "i0volume", Name: "Volume", MidiCC: 30, CfgKey: "KICKV", Type: "volume", Value: 95},
-							"pan":    {Key: "i0pan", Name: "Pan", MidiCC: 10, CfgKey: "KICKP", Type: "pan", Value: 54},
+							"volume": {Key: "i0volume", Address: "ch1/kick/volume", Name: "Volume", MidiCC: 30, CfgKey: "KICKV", Type: "volume", Value: 95},
+							"pan":    {Key: "i0pan", Address: "ch1/kick/pan", Name: "Pan", MidiCC: 10, CfgKey: "KICKP", Type: "pan", Value: 54},
+							"mute":   {Key: "i0mute", Address: "ch1/kick/mute", Name: "Mute", Type: "mute"},
+							"solo":   {Key: "i0solo", Address: "ch1/kick/solo", Name: "Solo", Type: "solo"},
 						},
 					},
 					{
@@ -183,21 +211,29 @@ func Test_PrepareToLoad(t *testing.T) {
 						MidiKey:    "tom1",
 						MidiNote:   48,
 						Controls: map[string]*PresetControl{
-							"volume": {Key: "i1volume", Name: "Volume", MidiCC: 31, CfgKey: "TOM1V", Type: "volume", Value: 87},
-							"pan":    {Key: "i1pan", Name: "Pan", MidiCC: 11, CfgKey: "TOM1P", Type: "pan", Value: 87},
+							"volume": {Key: "i1volume", Address: "ch1/tom1/volume", Name: "Volume", MidiCC: 31, CfgKey: "TOM1V", Type: "volume", Value: 87},
+							"pan":    {Key: "i1pan", Address: "ch1/tom1/pan", Name: "Pan", MidiCC: 11, CfgKey: "TOM1P", Type: "pan", Value: 87},
+							"mute":   {Key: "i1mute", Address: "ch1/tom1/mute", Name: "Mute", Type: "mute"},
+							"solo":   {Key: "i1solo", Address: "ch1/tom1/solo", Name: "Solo", Type: "solo"},
 						},
 					},
 				},
 			},
 			wantErr: false,
 			expectedControls: ExpectedControls{
-				"s0volume": {Key: "s0volume", Name: "Volume", Type: "volume", Value: 1.00},
-				"c0volume": {Key: "c0volume", Name: "Volume", Type: "volume", Value: 1.00},
-				"c0pan":    {Key: "c0pan", Name: "Pan", Type: "pan", Value: 0.00},
-				"i0volume": {Key: "i0volume", Name: "Volume", MidiCC: 30, CfgKey: "KICKV", Type: "volume", Value: 95},
-				"i0pan":    {Key: "i0pan", Name: "Pan", MidiCC: 10, CfgKey: "KICKP", Type: "pan", Value: 54},
-				"i1volume": {Key: "i1volume", Name: "Volume", MidiCC: 31, CfgKey: "TOM1V", Type: "volume", Value: 87},
-				"i1pan":    {Key: "i1pan", Name: "Pan", MidiCC: 11, CfgKey: "TOM1P", Type: "pan", Value: 87},
+				"s0volume": {Key: "s0volume", Address: "sampler/volume", Name: "Volume", Type: "volume", Value: 1.00},
+				"c0volume": {Key: "c0volume", Address: "ch1/volume", Name: "Volume", Type: "volume", Value: 1.00},
+				"c0pan":    {Key: "c0pan", Address: "ch1/pan", Name: "Pan", Type: "pan", Value: 0.00},
+				"c0mute":   {Key: "c0mute", Address: "ch1/mute", Name: "Mute", Type: "mute"},
+				"c0solo":   {Key: "c0solo", Address: "ch1/solo", Name: "Solo", Type: "solo"},
+				"i0volume": {Key: "i0volume", Address: "ch1/kick/volume", Name: "Volume", MidiCC: 30, CfgKey: "KICKV", Type: "volume", Value: 95},
+				"i0pan":    {Key: "i0pan", Address: "ch1/kick/pan", Name: "Pan", MidiCC: 10, CfgKey: "KICKP", Type: "pan", Value: 54},
+				"i0mute":   {Key: "i0mute", Address: "ch1/kick/mute", Name: "Mute", Type: "mute"},
+				"i0solo":   {Key: "i0solo", Address: "ch1/kick/solo", Name: "Solo", Type: "solo"},
+				"i1volume": {Key: "i1volume", Address: "ch1/tom1/volume", Name: "Volume", MidiCC: 31, CfgKey: "TOM1V", Type: "volume", Value: 87},
+				"i1pan":    {Key: "i1pan", Address: "ch1/tom1/pan", Name: "Pan", MidiCC: 11, CfgKey: "TOM1P", Type: "pan", Value: 87},
+				"i1mute":   {Key: "i1mute", Address: "ch1/tom1/mute", Name: "Mute", Type: "mute"},
+				"i1solo":   {Key: "i1solo", Address: "ch1/tom1/solo", Name: "Solo", Type: "solo"},
 			},
 		},
 		{
@@ -216,15 +252,17 @@ func Test_PrepareToLoad(t *testing.T) {
 						Key:  "ch1",
 						Name: "Drums",
 						Controls: map[string]*PresetControl{
-							"volume": {Key: "c0volume", Name: "Volume", Type: "volume", Value: 1.00},
-							"pan":    {Key: "c0pan", Name: "Pan", Type: "pan", Value: 0.00},
+							"volume": {Key: "c0volume", Address: "ch1/volume", Name: "Volume", Type: "volume", Value: 1.00},
+							"pan":    {Key: "c0pan", Address: "ch1/pan", Name: "Pan", Type: "pan", Value: 0.00},
+							"mute":   {Key: "c0mute", Address: "ch1/mute", Name: "Mute", Type: "mute"},
+							"solo":   {Key: "c0solo", Address: "ch1/solo", Name: "Solo", Type: "solo"},
 						},
 					},
 					{
 						Key:  "sampler",
 						Name: "Kit",
 						Controls: map[string]*PresetControl{
-							"volume": {Key: "s0volume", Name: "Volume", Type: "volume", Value: 1.00},
+							"volume": {Key: "s0volume", Address: "sampler/volume", Name: "Volume", Type: "volume", Value: 1.00},
 						},
 					},
 				},
@@ -235,8 +273,10 @@ func Test_PrepareToLoad(t *testing.T) {
 						MidiKey:    "kick1",
 						MidiNote:   36,
 						Controls: map[string]*PresetControl{
-							"volume": {Key: "i0volume", Name: "Volume", MidiCC: 30, CfgKey: "KICKV", Type: "volume", Value: 95},
-							"pan":    {Key: "i0pan", Name: "Pan", MidiCC: 10, CfgKey: "KICKP", Type: "pan", Value: 54},
+							"volume": {Key: "i0volume", Address: "ch1/kick/volume", Name: "Volume", MidiCC: 30, CfgKey: "KICKV", Type: "volume", Value: 95},
+							"pan":    {Key: "i0pan", Address: "ch1/kick/pan", Name: "Pan", MidiCC: 10, CfgKey: "KICKP", Type: "pan", Value: 54},
+							"mute":   {Key: "i0mute", Address: "ch1/kick/mute", Name: "Mute", Type: "mute"},
+							"solo":   {Key: "i0solo", Address: "ch1/kick/solo", Name: "Solo", Type: "solo"},
 						},
 					},
 					{
@@ -245,21 +285,29 @@ func Test_PrepareToLoad(t *testing.T) {
 						MidiKey:    "tom1",
 						MidiNote:   48,
 						Controls: map[string]*PresetControl{
-							"volume": {Key: "i1volume", Name: "Volume", MidiCC: 31, CfgKey: "TOM1V", Type: "volume", Value: 87},
-							"pan":    {Key: "i1pan", Name: "Pan", MidiCC: 11, CfgKey: "TOM1P", Type: "pan", Value: 86},
+							"volume": {Key: "i1volume", Address: "ch1/tom1/volume", Name: "Volume", MidiCC: 31, CfgKey: "TOM1V", Type: "volume", Value: 87},
+							"pan":    {Key: "i1pan", Address: "ch1/tom1/pan", Name: "Pan", MidiCC: 11, CfgKey: "TOM1P", Type: "pan", Value: 86},
+							"mute":   {Key: "i1mute", Address: "ch1/tom1/mute", Name: "Mute", Type: "mute"},
+							"solo":   {Key: "i1solo", Address: "ch1/tom1/solo", Name: "Solo", Type: "solo"},
 						},
 					},
 				},
 			},
 			wantErr: false,
 			expectedControls: ExpectedControls{
-				"s0volume": {Key: "s0volume", Name: "Volume", Type: "volume", Value: 1.00},
-				"c0volume": {Key: "c0volume", Name: "Volume", Type: "volume", Value: 1.00},
-				"c0pan":    {Key: "c0pan", Name: "Pan", Type: "pan", Value: 0.00},
-				"i0volume": {Key: "i0volume", Name: "Volume", MidiCC: 30, CfgKey: "KICKV", Type: "volume", Value: 95},
-				"i0pan":    {Key: "i0pan", Name: "Pan", MidiCC: 10, CfgKey: "KICKP", Type: "pan", Value: 54},
-				"i1volume": {Key: "i1volume", Name: "Volume", MidiCC: 31, CfgKey: "TOM1V", Type: "volume", Value: 87},
-				"i1pan":    {Key: "i1pan", Name: "Pan", MidiCC: 11, CfgKey: "TOM1P", Type: "pan", Value: 86},
+				"s0volume": {Key: "s0volume", Address: "sampler/volume", Name: "Volume", Type: "volume", Value: 1.00},
+				"c0volume": {Key: "c0volume", Address: "ch1/volume", Name: "Volume", Type: "volume", Value: 1.00},
+				"c0pan":    {Key: "c0pan", Address: "ch1/pan", Name: "Pan", Type: "pan", Value: 0.00},
+				"c0mute":   {Key: "c0mute", Address: "ch1/mute", Name: "Mute", Type: "mute"},
+				"c0solo":   {Key: "c0solo", Address: "ch1/solo", Name: "Solo", Type: "solo"},
+				"i0volume": {Key: "i0volume", Address: "ch1/kick/volume", Name: "Volume", MidiCC: 30, CfgKey: "KICKV", Type: "volume", Value: 95},
+				"i0pan":    {Key: "i0pan", Address: "ch1/kick/pan", Name: "Pan", MidiCC: 10, CfgKey: "KICKP", Type: "pan", Value: 54},
+				"i0mute":   {Key: "i0mute", Address: "ch1/kick/mute", Name: "Mute", Type: "mute"},
+				"i0solo":   {Key: "i0solo", Address: "ch1/kick/solo", Name: "Solo", Type: "solo"},
+				"i1volume": {Key: "i1volume", Address: "ch1/tom1/volume", Name: "Volume", MidiCC: 31, CfgKey: "TOM1V", Type: "volume", Value: 87},
+				"i1pan":    {Key: "i1pan", Address: "ch1/tom1/pan", Name: "Pan", MidiCC: 11, CfgKey: "TOM1P", Type: "pan", Value: 86},
+				"i1mute":   {Key: "i1mute", Address: "ch1/tom1/mute", Name: "Mute", Type: "mute"},
+				"i1solo":   {Key: "i1solo", Address: "ch1/tom1/solo", Name: "Solo", Type: "solo"},
 			},
 		},
 	}
@@ -277,6 +325,7 @@ func Test_PrepareToLoad(t *testing.T) {
 				cmpopts.IgnoreUnexported(KitPreset{}),
 				cmpopts.IgnoreUnexported(PresetControl{}),
 				cmpopts.IgnoreUnexported(PresetInstrument{}),
+				cmpopts.IgnoreUnexported(PresetLayer{}),
 				cmpopts.IgnoreUnexported(PresetChannel{}),
 				cmpopts.IgnoreUnexported(InstrumentRef{}),
 				cmpopts.IgnoreUnexported(Layer{}),
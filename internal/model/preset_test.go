@@ -1,8 +1,13 @@
 package model
 
 import (
+	"errors"
+	"math"
 	"reflect"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestKitPreset_IndexInstruments(t *testing.T) {
@@ -126,3 +131,156 @@ func TestKitPreset_GetChannelInstrumentsByKey(t *testing.T) {
 		})
 	}
 }
+
+func TestKitPreset_ToStore(t *testing.T) {
+	preset := loadPresetFromYAML(t, "single_instrument.yaml")
+	if err := preset.PrepareToLoad([]MIDIDevice{&MockMMIDIDevice{}}); err != nil {
+		t.Fatalf("PrepareToLoad() error = %v", err)
+	}
+	csetter := &MockSamplerControlSetter{}
+	if err := preset.SetControlValue("c0volume", 0.5, csetter); err != nil {
+		t.Fatalf("SetControlValue() error = %v", err)
+	}
+	if err := preset.SetControlValue("i0volume", 0.5, csetter); err != nil {
+		t.Fatalf("SetControlValue() error = %v", err)
+	}
+	if err := preset.SetControlValue("i0mute", 1, csetter); err != nil {
+		t.Fatalf("SetControlValue() error = %v", err)
+	}
+
+	got := preset.ToStore()
+
+	want := &KitPreset{
+		Uid:  "preset-1",
+		Name: "Single Instrument",
+		Channels: []PresetChannel{
+			{
+				Key:  "ch1",
+				Name: "Kick",
+				Controls: ControlMap{
+					"volume": {Name: "Volume", Type: "volume", Value: 0.5},
+				},
+			},
+		},
+		Instruments: []PresetInstrument{
+			{
+				Instrument: preset.Instruments[0].Instrument,
+				Name:       "Kick",
+				ChannelKey: "ch1",
+				MidiKey:    "kick1",
+				Controls: ControlMap{
+					"volume": {Name: "Volume", MidiCC: 30, Type: "volume", Value: 64},
+					"pan":    {Name: "Pan", MidiCC: 10, Type: "pan", Value: 54},
+				},
+				Mute: true,
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(KitPreset{}, PresetChannel{}, PresetControl{}, PresetInstrument{})); diff != "" {
+		t.Errorf("ToStore() mismatch (-want +got):\n%s", diff)
+	}
+
+	// stored copy doesn't share controls with loaded preset
+	got.Channels[0].Controls["volume"].Value = 1
+	if preset.Channels[0].Controls["volume"].Value != 0.5 {
+		t.Errorf("ToStore() returned shared control")
+	}
+}
+
+func TestKitPreset_FindByMidiNote(t *testing.T) {
+	preset := loadPresetFromYAML(t, "single instr_with_layers.yaml")
+	if err := preset.PrepareToLoad([]MIDIDevice{&MockMMIDIDevice{}}); err != nil {
+		t.Fatalf("PrepareToLoad() error = %v", err)
+	}
+	tests := []struct {
+		name       string
+		channelKey string
+		note       int
+		wantLayer  string
+		wantOk     bool
+	}{
+		{name: "layer", channelKey: "ch1", note: 53, wantLayer: "bell", wantOk: true},
+		{name: "other layer", channelKey: "ch1", note: 51, wantLayer: "edge", wantOk: true},
+		{name: "instrument without MIDI key", channelKey: "ch1", note: 0},
+		{name: "other channel", channelKey: "ch2", note: 53},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instr, layer, ok := preset.FindByMidiNote(tt.channelKey, tt.note)
+			if ok != tt.wantOk || layer != tt.wantLayer {
+				t.Fatalf("FindByMidiNote() = %q, %v, want %q, %v", layer, ok, tt.wantLayer, tt.wantOk)
+			}
+			if ok && instr != &preset.Instruments[0] {
+				t.Errorf("FindByMidiNote() returned other instrument: %v", instr.Name)
+			}
+		})
+	}
+}
+
+func TestKitPreset_SetControlValue_Range(t *testing.T) {
+	preset := loadPresetFromYAML(t, "single_instrument.yaml")
+	if err := preset.PrepareToLoad([]MIDIDevice{&MockMMIDIDevice{}}); err != nil {
+		t.Fatalf("PrepareToLoad() error = %v", err)
+	}
+	tests := []struct {
+		key     string
+		value   float32
+		wantErr error
+		want    float32
+	}{
+		{key: "i0volume", value: 1.0004, want: 1},
+		{key: "i0volume", value: 1.001, wantErr: ErrValueOutOfRange, want: 1},
+		{key: "i0volume", value: -0.001, wantErr: ErrValueOutOfRange, want: 1},
+		{key: "i0pan", value: -1, want: -1},
+		{key: "i0pan", value: -1.001, wantErr: ErrValueOutOfRange, want: -1},
+		{key: "i0pan", value: float32(math.NaN()), wantErr: ErrValueOutOfRange, want: -1},
+		{key: "unknown", value: 0.5, wantErr: ErrControlNotFound},
+	}
+	for _, tt := range tests {
+		err := preset.SetControlValue(tt.key, tt.value, &MockSamplerControlSetter{})
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("SetControlValue(%s, %v) error = %v, want %v", tt.key, tt.value, err, tt.wantErr)
+		}
+		got, err := preset.GetControlValue(tt.key)
+		if tt.wantErr == ErrControlNotFound {
+			if !errors.Is(err, ErrControlNotFound) {
+				t.Errorf("GetControlValue(%s) error = %v", tt.key, err)
+			}
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("GetControlValue(%s) = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestKitPreset_ControlAddress(t *testing.T) {
+	preset := loadPresetFromYAML(t, "two_instruments.yaml")
+	if err := preset.PrepareToLoad([]MIDIDevice{&MockMMIDIDevice{}}); err != nil {
+		t.Fatalf("PrepareToLoad() error = %v", err)
+	}
+	if err := preset.SetControlValue("ch1/tom1/volume", 0.5, &MockSamplerControlSetter{}); err != nil {
+		t.Fatalf("SetControlValue() error = %v", err)
+	}
+	ctrl, err := preset.GetControl("ch1/tom1/volume")
+	if err != nil || ctrl.Key != "i1volume" {
+		t.Fatalf("GetControl() = %v, %v, want control i1volume", ctrl, err)
+	}
+	if got, _ := preset.GetControlValue("i1volume"); got != 0.504 {
+		t.Errorf("GetControlValue(i1volume) = %v, want 0.504", got)
+	}
+	if err := preset.SetControlValue("ch1/tom2/volume", 0.5, &MockSamplerControlSetter{}); !errors.Is(err, ErrControlNotFound) {
+		t.Errorf("SetControlValue() of unknown address error = %v, want %v", err, ErrControlNotFound)
+	}
+
+	// reordering of instruments changes keys, but not addresses
+	reordered := loadPresetFromYAML(t, "two_instruments.yaml")
+	reordered.Instruments[0], reordered.Instruments[1] = reordered.Instruments[1], reordered.Instruments[0]
+	if err := reordered.PrepareToLoad([]MIDIDevice{&MockMMIDIDevice{}}); err != nil {
+		t.Fatalf("PrepareToLoad() error = %v", err)
+	}
+	ctrl, err = reordered.GetControl("ch1/tom1/volume")
+	if err != nil || ctrl.Key != "i0volume" || ctrl.CfgKey != "TOM1V" {
+		t.Errorf("GetControl() after reordering = %v, %v, want control i0volume of tom", ctrl, err)
+	}
+}
@@ -38,6 +38,9 @@ func (i *PresetInstrument) UnmarshalYAML(data []byte) error {
 		MidiNote   int                    `yaml:"-"`
 		Controls   ControlMap             `yaml:"controls"`
 		Layers     map[string]PresetLayer `yaml:"layers"`
+		Mute       bool                   `yaml:"mute,omitempty"`
+		Solo       bool                   `yaml:"solo,omitempty"`
+		channel    *PresetChannel         `yaml:"-"`
 	}
 	var a alias
 	err := yaml.Unmarshal(data, &a)
@@ -49,13 +52,14 @@ func (i *PresetInstrument) UnmarshalYAML(data []byte) error {
 }
 
 type ExpectedControls map[string]struct {
-	Key    string
-	Name   string
-	Owner  ControlOwner
-	MidiCC int
-	CfgKey string
-	Type   string
-	Value  float32
+	Key     string
+	Address string
+	Name    string
+	Owner   ControlOwner
+	MidiCC  int
+	CfgKey  string
+	Type    string
+	Value   float32
 }
 
 // VerifyControlsForTest is a test helper to verify the internal controls state
@@ -74,6 +78,9 @@ func VerifyControlsForTest(p *KitPreset, expectedControls ExpectedControls) stri
 		if ctrlRef.control.Key != expected.Key {
 			differences = append(differences, fmt.Sprintf("Control %q Key mismatch: got %q, want %q", key, ctrlRef.control.Key, expected.Key))
 		}
+		if ctrlRef.control.Address != expected.Address {
+			differences = append(differences, fmt.Sprintf("Control %q Address mismatch: got %q, want %q", key, ctrlRef.control.Address, expected.Address))
+		}
 		if ctrlRef.control.MidiCC != expected.MidiCC {
 			differences = append(differences, fmt.Sprintf("Control %q MidiCC mismatch: got %d, want %d", key, ctrlRef.control.MidiCC, expected.MidiCC))
 		}
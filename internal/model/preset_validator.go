@@ -3,6 +3,7 @@ package model
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 )
 
@@ -39,7 +40,7 @@ func (p *KitPreset) Validate() error {
 
 	for _, vc := range p.Channels {
 		// validate channel controls
-		for _, vcc := range vc.Controls {
+		for k, vcc := range vc.Controls {
 			var сve MultiValidationError
 			err := vcc.Validate()
 			if err != nil {
@@ -49,6 +50,9 @@ func (p *KitPreset) Validate() error {
 					return err
 				}
 			}
+			if vcc.Type == CtrlSend {
+				errs = append(errs, p.validateSend(vc.Key, k, vcc)...)
+			}
 		}
 
 		isManyInstruments := len(vc.instruments) > 1
@@ -110,12 +114,66 @@ func (p *KitPreset) Validate() error {
 		}
 	}
 
+	errs = append(errs, p.validateFxs()...)
+
 	if len(errs) > 0 {
 		return errs
 	}
 	return nil
 }
 
+// Validations:
+// - send MUST have midiCC, sampler regulates send level by it
+// - send key MUST be key of effect chain
+func (p *KitPreset) validateSend(channelKey, key string, ctrl *PresetControl) MultiValidationError {
+	var errs MultiValidationError
+	field := fmt.Sprintf("channel control '%s.%s'", channelKey, key)
+	if ctrl.MidiCC == 0 {
+		errs = append(errs, ValidationError{field, "midiCC is required and can't be 0"})
+	}
+	if !slices.ContainsFunc(p.Fxs, func(c PresetFxChain) bool { return c.Key == key }) {
+		errs = append(errs, ValidationError{field, "effect chain with same key not found"})
+	}
+	return errs
+}
+
+// Validations:
+// - chain MUST have key, unique across chains
+// - effect MUST have key, unique across preset, module and label
+// - param key MUST be p<param index>
+func (p *KitPreset) validateFxs() MultiValidationError {
+	var errs MultiValidationError
+	chains := map[string]struct{}{}
+	fxs := map[string]struct{}{}
+	for ci, chain := range p.Fxs {
+		if chain.Key == "" {
+			errs = append(errs, ValidationError{fmt.Sprintf("fx chain %d", ci), "key is required"})
+		} else if _, ok := chains[chain.Key]; ok {
+			errs = append(errs, ValidationError{fmt.Sprintf("fx chain '%s'", chain.Key), "duplicate key"})
+		}
+		chains[chain.Key] = struct{}{}
+		for fi, fx := range chain.Effects {
+			field := fmt.Sprintf("fx '%s'", fx.Key)
+			if fx.Key == "" {
+				field = fmt.Sprintf("fx %d of chain '%s'", fi, chain.Key)
+				errs = append(errs, ValidationError{field, "key is required"})
+			} else if _, ok := fxs[fx.Key]; ok {
+				errs = append(errs, ValidationError{field, "duplicate key"})
+			}
+			fxs[fx.Key] = struct{}{}
+			if fx.Module == "" || fx.Label == "" {
+				errs = append(errs, ValidationError{field, "module and label are required"})
+			}
+			for k := range fx.Params {
+				if _, ok := fxParamIndex(k); !ok {
+					errs = append(errs, ValidationError{fmt.Sprintf("%s param '%s'", field, k), "key must be p<param index>"})
+				}
+			}
+		}
+	}
+	return errs
+}
+
 // Validations:
 // - controls MUST have `volume` type control. It control MUST have midiCC
 // - controls MAY have `pan` type control.
@@ -0,0 +1,207 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: audio.proto
+
+package grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AudioSetup_ListAudioDrivers_FullMethodName = "/audio.v1.AudioSetup/ListAudioDrivers"
+	AudioSetup_GetAudioOutput_FullMethodName   = "/audio.v1.AudioSetup/GetAudioOutput"
+	AudioSetup_ApplyAudioOutput_FullMethodName = "/audio.v1.AudioSetup/ApplyAudioOutput"
+)
+
+// AudioSetupClient is the client API for AudioSetup service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Audio output of the sampler: driver, device parameters and channel routing
+type AudioSetupClient interface {
+	// Lists audio output drivers available in the sampler with their parameters
+	ListAudioDrivers(ctx context.Context, in *ListAudioDriversRequest, opts ...grpc.CallOption) (*ListAudioDriversResponse, error)
+	// Returns current audio output settings
+	GetAudioOutput(ctx context.Context, in *GetAudioOutputRequest, opts ...grpc.CallOption) (*AudioOutputResponse, error)
+	// Validates and applies audio output settings. Loaded preset is reloaded with new audio output
+	ApplyAudioOutput(ctx context.Context, in *ApplyAudioOutputRequest, opts ...grpc.CallOption) (*AudioOutputResponse, error)
+}
+
+type audioSetupClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAudioSetupClient(cc grpc.ClientConnInterface) AudioSetupClient {
+	return &audioSetupClient{cc}
+}
+
+func (c *audioSetupClient) ListAudioDrivers(ctx context.Context, in *ListAudioDriversRequest, opts ...grpc.CallOption) (*ListAudioDriversResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAudioDriversResponse)
+	err := c.cc.Invoke(ctx, AudioSetup_ListAudioDrivers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *audioSetupClient) GetAudioOutput(ctx context.Context, in *GetAudioOutputRequest, opts ...grpc.CallOption) (*AudioOutputResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AudioOutputResponse)
+	err := c.cc.Invoke(ctx, AudioSetup_GetAudioOutput_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *audioSetupClient) ApplyAudioOutput(ctx context.Context, in *ApplyAudioOutputRequest, opts ...grpc.CallOption) (*AudioOutputResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AudioOutputResponse)
+	err := c.cc.Invoke(ctx, AudioSetup_ApplyAudioOutput_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AudioSetupServer is the server API for AudioSetup service.
+// All implementations must embed UnimplementedAudioSetupServer
+// for forward compatibility.
+//
+// Audio output of the sampler: driver, device parameters and channel routing
+type AudioSetupServer interface {
+	// Lists audio output drivers available in the sampler with their parameters
+	ListAudioDrivers(context.Context, *ListAudioDriversRequest) (*ListAudioDriversResponse, error)
+	// Returns current audio output settings
+	GetAudioOutput(context.Context, *GetAudioOutputRequest) (*AudioOutputResponse, error)
+	// Validates and applies audio output settings. Loaded preset is reloaded with new audio output
+	ApplyAudioOutput(context.Context, *ApplyAudioOutputRequest) (*AudioOutputResponse, error)
+	mustEmbedUnimplementedAudioSetupServer()
+}
+
+// UnimplementedAudioSetupServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAudioSetupServer struct{}
+
+func (UnimplementedAudioSetupServer) ListAudioDrivers(context.Context, *ListAudioDriversRequest) (*ListAudioDriversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAudioDrivers not implemented")
+}
+func (UnimplementedAudioSetupServer) GetAudioOutput(context.Context, *GetAudioOutputRequest) (*AudioOutputResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAudioOutput not implemented")
+}
+func (UnimplementedAudioSetupServer) ApplyAudioOutput(context.Context, *ApplyAudioOutputRequest) (*AudioOutputResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyAudioOutput not implemented")
+}
+func (UnimplementedAudioSetupServer) mustEmbedUnimplementedAudioSetupServer() {}
+func (UnimplementedAudioSetupServer) testEmbeddedByValue()                    {}
+
+// UnsafeAudioSetupServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AudioSetupServer will
+// result in compilation errors.
+type UnsafeAudioSetupServer interface {
+	mustEmbedUnimplementedAudioSetupServer()
+}
+
+func RegisterAudioSetupServer(s grpc.ServiceRegistrar, srv AudioSetupServer) {
+	// If the following call pancis, it indicates UnimplementedAudioSetupServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AudioSetup_ServiceDesc, srv)
+}
+
+func _AudioSetup_ListAudioDrivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAudioDriversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AudioSetupServer).ListAudioDrivers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AudioSetup_ListAudioDrivers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AudioSetupServer).ListAudioDrivers(ctx, req.(*ListAudioDriversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AudioSetup_GetAudioOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAudioOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AudioSetupServer).GetAudioOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AudioSetup_GetAudioOutput_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AudioSetupServer).GetAudioOutput(ctx, req.(*GetAudioOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AudioSetup_ApplyAudioOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyAudioOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AudioSetupServer).ApplyAudioOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AudioSetup_ApplyAudioOutput_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AudioSetupServer).ApplyAudioOutput(ctx, req.(*ApplyAudioOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AudioSetup_ServiceDesc is the grpc.ServiceDesc for AudioSetup service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AudioSetup_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "audio.v1.AudioSetup",
+	HandlerType: (*AudioSetupServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAudioDrivers",
+			Handler:    _AudioSetup_ListAudioDrivers_Handler,
+		},
+		{
+			MethodName: "GetAudioOutput",
+			Handler:    _AudioSetup_GetAudioOutput_Handler,
+		},
+		{
+			MethodName: "ApplyAudioOutput",
+			Handler:    _AudioSetup_ApplyAudioOutput_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "audio.proto",
+}
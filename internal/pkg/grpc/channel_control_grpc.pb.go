@@ -26,6 +26,12 @@ const (
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ChannelControlClient interface {
+	// Sets control values of the loaded preset. Accepted values are sent to all open streams.
+	// A new stream first receives current values of all controls.
+	// Values of a control are applied not often than every 20 ms: queued value is replaced by the latest one.
+	// Rejected value is answered only to its client with error status and the current value, the stream stays open.
+	// Value, which sampler failed to apply, is stored in preset: its client gets SAMPLER_ERROR status,
+	// other streams get the stored value as accepted one
 	SetValue(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ControlValue, ControlValue], error)
 }
 
@@ -54,6 +60,12 @@ type ChannelControl_SetValueClient = grpc.BidiStreamingClient[ControlValue, Cont
 // All implementations must embed UnimplementedChannelControlServer
 // for forward compatibility.
 type ChannelControlServer interface {
+	// Sets control values of the loaded preset. Accepted values are sent to all open streams.
+	// A new stream first receives current values of all controls.
+	// Values of a control are applied not often than every 20 ms: queued value is replaced by the latest one.
+	// Rejected value is answered only to its client with error status and the current value, the stream stays open.
+	// Value, which sampler failed to apply, is stored in preset: its client gets SAMPLER_ERROR status,
+	// other streams get the stored value as accepted one
 	SetValue(grpc.BidiStreamingServer[ControlValue, ControlValue]) error
 	mustEmbedUnimplementedChannelControlServer()
 }
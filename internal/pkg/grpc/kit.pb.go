@@ -0,0 +1,640 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        v5.29.3
+// source: kit.proto
+
+package grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ImportStage int32
+
+const (
+	ImportStage_IMPORT_STAGE_UNSPECIFIED ImportStage = 0
+	ImportStage_IMPORT_STAGE_RECEIVE     ImportStage = 1
+	ImportStage_IMPORT_STAGE_UNPACK      ImportStage = 2
+	ImportStage_IMPORT_STAGE_PARSE       ImportStage = 3
+	ImportStage_IMPORT_STAGE_STORE       ImportStage = 4
+	ImportStage_IMPORT_STAGE_DONE        ImportStage = 5
+)
+
+// Enum value maps for ImportStage.
+var (
+	ImportStage_name = map[int32]string{
+		0: "IMPORT_STAGE_UNSPECIFIED",
+		1: "IMPORT_STAGE_RECEIVE",
+		2: "IMPORT_STAGE_UNPACK",
+		3: "IMPORT_STAGE_PARSE",
+		4: "IMPORT_STAGE_STORE",
+		5: "IMPORT_STAGE_DONE",
+	}
+	ImportStage_value = map[string]int32{
+		"IMPORT_STAGE_UNSPECIFIED": 0,
+		"IMPORT_STAGE_RECEIVE":     1,
+		"IMPORT_STAGE_UNPACK":      2,
+		"IMPORT_STAGE_PARSE":       3,
+		"IMPORT_STAGE_STORE":       4,
+		"IMPORT_STAGE_DONE":        5,
+	}
+)
+
+func (x ImportStage) Enum() *ImportStage {
+	p := new(ImportStage)
+	*p = x
+	return p
+}
+
+func (x ImportStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_kit_proto_enumTypes[0].Descriptor()
+}
+
+func (ImportStage) Type() protoreflect.EnumType {
+	return &file_kit_proto_enumTypes[0]
+}
+
+func (x ImportStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportStage.Descriptor instead.
+func (ImportStage) EnumDescriptor() ([]byte, []int) {
+	return file_kit_proto_rawDescGZIP(), []int{0}
+}
+
+type ImportKitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Chunk []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	// size of the whole archive. Optional, used for progress. Enough to set in the first message
+	Size          int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportKitRequest) Reset() {
+	*x = ImportKitRequest{}
+	mi := &file_kit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportKitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportKitRequest) ProtoMessage() {}
+
+func (x *ImportKitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportKitRequest.ProtoReflect.Descriptor instead.
+func (*ImportKitRequest) Descriptor() ([]byte, []int) {
+	return file_kit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ImportKitRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ImportKitRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// done and total are bytes for RECEIVE stage, files for UNPACK stage and instruments for STORE stage.
+// total is 0 if unknown
+type ImportKitProgress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Stage ImportStage            `protobuf:"varint,1,opt,name=stage,proto3,enum=kit.v1.ImportStage" json:"stage,omitempty"`
+	Done  int64                  `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	Total int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	// set for DONE stage
+	KitId         int64  `protobuf:"varint,4,opt,name=kit_id,json=kitId,proto3" json:"kit_id,omitempty"`
+	KitKey        string `protobuf:"bytes,5,opt,name=kit_key,json=kitKey,proto3" json:"kit_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportKitProgress) Reset() {
+	*x = ImportKitProgress{}
+	mi := &file_kit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportKitProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportKitProgress) ProtoMessage() {}
+
+func (x *ImportKitProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_kit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportKitProgress.ProtoReflect.Descriptor instead.
+func (*ImportKitProgress) Descriptor() ([]byte, []int) {
+	return file_kit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ImportKitProgress) GetStage() ImportStage {
+	if x != nil {
+		return x.Stage
+	}
+	return ImportStage_IMPORT_STAGE_UNSPECIFIED
+}
+
+func (x *ImportKitProgress) GetDone() int64 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+func (x *ImportKitProgress) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportKitProgress) GetKitId() int64 {
+	if x != nil {
+		return x.KitId
+	}
+	return 0
+}
+
+func (x *ImportKitProgress) GetKitKey() string {
+	if x != nil {
+		return x.KitKey
+	}
+	return ""
+}
+
+type DeleteKitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KitId         int64                  `protobuf:"varint,1,opt,name=kit_id,json=kitId,proto3" json:"kit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteKitRequest) Reset() {
+	*x = DeleteKitRequest{}
+	mi := &file_kit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteKitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteKitRequest) ProtoMessage() {}
+
+func (x *DeleteKitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteKitRequest.ProtoReflect.Descriptor instead.
+func (*DeleteKitRequest) Descriptor() ([]byte, []int) {
+	return file_kit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeleteKitRequest) GetKitId() int64 {
+	if x != nil {
+		return x.KitId
+	}
+	return 0
+}
+
+type DeleteKitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteKitResponse) Reset() {
+	*x = DeleteKitResponse{}
+	mi := &file_kit_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteKitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteKitResponse) ProtoMessage() {}
+
+func (x *DeleteKitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kit_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteKitResponse.ProtoReflect.Descriptor instead.
+func (*DeleteKitResponse) Descriptor() ([]byte, []int) {
+	return file_kit_proto_rawDescGZIP(), []int{3}
+}
+
+type DeleteInstrumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstrumentId  int64                  `protobuf:"varint,1,opt,name=instrument_id,json=instrumentId,proto3" json:"instrument_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInstrumentRequest) Reset() {
+	*x = DeleteInstrumentRequest{}
+	mi := &file_kit_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInstrumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInstrumentRequest) ProtoMessage() {}
+
+func (x *DeleteInstrumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kit_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInstrumentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInstrumentRequest) Descriptor() ([]byte, []int) {
+	return file_kit_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteInstrumentRequest) GetInstrumentId() int64 {
+	if x != nil {
+		return x.InstrumentId
+	}
+	return 0
+}
+
+type DeleteInstrumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInstrumentResponse) Reset() {
+	*x = DeleteInstrumentResponse{}
+	mi := &file_kit_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInstrumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInstrumentResponse) ProtoMessage() {}
+
+func (x *DeleteInstrumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kit_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInstrumentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteInstrumentResponse) Descriptor() ([]byte, []int) {
+	return file_kit_proto_rawDescGZIP(), []int{5}
+}
+
+type CreateCustomKitRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Tags        []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// uuids of instruments
+	InstrumentKeys []string `protobuf:"bytes,4,rep,name=instrument_keys,json=instrumentKeys,proto3" json:"instrument_keys,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateCustomKitRequest) Reset() {
+	*x = CreateCustomKitRequest{}
+	mi := &file_kit_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCustomKitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomKitRequest) ProtoMessage() {}
+
+func (x *CreateCustomKitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kit_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomKitRequest.ProtoReflect.Descriptor instead.
+func (*CreateCustomKitRequest) Descriptor() ([]byte, []int) {
+	return file_kit_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateCustomKitRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCustomKitRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateCustomKitRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateCustomKitRequest) GetInstrumentKeys() []string {
+	if x != nil {
+		return x.InstrumentKeys
+	}
+	return nil
+}
+
+type CreateCustomKitResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	KitId int64                  `protobuf:"varint,1,opt,name=kit_id,json=kitId,proto3" json:"kit_id,omitempty"`
+	// kit uuid
+	KitKey        string `protobuf:"bytes,2,opt,name=kit_key,json=kitKey,proto3" json:"kit_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCustomKitResponse) Reset() {
+	*x = CreateCustomKitResponse{}
+	mi := &file_kit_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCustomKitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomKitResponse) ProtoMessage() {}
+
+func (x *CreateCustomKitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kit_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomKitResponse.ProtoReflect.Descriptor instead.
+func (*CreateCustomKitResponse) Descriptor() ([]byte, []int) {
+	return file_kit_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateCustomKitResponse) GetKitId() int64 {
+	if x != nil {
+		return x.KitId
+	}
+	return 0
+}
+
+func (x *CreateCustomKitResponse) GetKitKey() string {
+	if x != nil {
+		return x.KitKey
+	}
+	return ""
+}
+
+var File_kit_proto protoreflect.FileDescriptor
+
+var file_kit_proto_rawDesc = string([]byte{
+	0x0a, 0x09, 0x6b, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6b, 0x69, 0x74,
+	0x2e, 0x76, 0x31, 0x22, 0x3c, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4b, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x22, 0x98, 0x01, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4b, 0x69, 0x74, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6b, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x15, 0x0a, 0x06,
+	0x6b, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6b, 0x69,
+	0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6b, 0x69, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6b, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x29, 0x0a, 0x10,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x15, 0x0a, 0x06, 0x6b, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x6b, 0x69, 0x74, 0x49, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4b, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x0a, 0x17,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4b, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x17, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4b, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x69, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6b, 0x69, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x6b, 0x69, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6b, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x2a, 0xa5, 0x01, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x17,
+	0x0a, 0x13, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x55,
+	0x4e, 0x50, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4d, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x45, 0x10, 0x03, 0x12,
+	0x16, 0x0a, 0x12, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f,
+	0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4d, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x05, 0x32, 0xb8,
+	0x02, 0x0a, 0x03, 0x4b, 0x69, 0x74, 0x12, 0x44, 0x0a, 0x09, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x4b, 0x69, 0x74, 0x12, 0x18, 0x2e, 0x6b, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x4b, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x6b, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4b, 0x69, 0x74,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x28, 0x01, 0x30, 0x01, 0x12, 0x40, 0x0a, 0x09,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x69, 0x74, 0x12, 0x18, 0x2e, 0x6b, 0x69, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6b, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4b, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55,
+	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x6b, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6b, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4b, 0x69, 0x74, 0x12, 0x1e, 0x2e, 0x6b, 0x69, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4b, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6b, 0x69, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4b, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x73, 0x70, 0x69, 0x64, 0x72, 0x75,
+	0x6d, 0x2d, 0x73, 0x72, 0x76, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_kit_proto_rawDescOnce sync.Once
+	file_kit_proto_rawDescData []byte
+)
+
+func file_kit_proto_rawDescGZIP() []byte {
+	file_kit_proto_rawDescOnce.Do(func() {
+		file_kit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_kit_proto_rawDesc), len(file_kit_proto_rawDesc)))
+	})
+	return file_kit_proto_rawDescData
+}
+
+var file_kit_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_kit_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_kit_proto_goTypes = []any{
+	(ImportStage)(0),                 // 0: kit.v1.ImportStage
+	(*ImportKitRequest)(nil),         // 1: kit.v1.ImportKitRequest
+	(*ImportKitProgress)(nil),        // 2: kit.v1.ImportKitProgress
+	(*DeleteKitRequest)(nil),         // 3: kit.v1.DeleteKitRequest
+	(*DeleteKitResponse)(nil),        // 4: kit.v1.DeleteKitResponse
+	(*DeleteInstrumentRequest)(nil),  // 5: kit.v1.DeleteInstrumentRequest
+	(*DeleteInstrumentResponse)(nil), // 6: kit.v1.DeleteInstrumentResponse
+	(*CreateCustomKitRequest)(nil),   // 7: kit.v1.CreateCustomKitRequest
+	(*CreateCustomKitResponse)(nil),  // 8: kit.v1.CreateCustomKitResponse
+}
+var file_kit_proto_depIdxs = []int32{
+	0, // 0: kit.v1.ImportKitProgress.stage:type_name -> kit.v1.ImportStage
+	1, // 1: kit.v1.Kit.ImportKit:input_type -> kit.v1.ImportKitRequest
+	3, // 2: kit.v1.Kit.DeleteKit:input_type -> kit.v1.DeleteKitRequest
+	5, // 3: kit.v1.Kit.DeleteInstrument:input_type -> kit.v1.DeleteInstrumentRequest
+	7, // 4: kit.v1.Kit.CreateCustomKit:input_type -> kit.v1.CreateCustomKitRequest
+	2, // 5: kit.v1.Kit.ImportKit:output_type -> kit.v1.ImportKitProgress
+	4, // 6: kit.v1.Kit.DeleteKit:output_type -> kit.v1.DeleteKitResponse
+	6, // 7: kit.v1.Kit.DeleteInstrument:output_type -> kit.v1.DeleteInstrumentResponse
+	8, // 8: kit.v1.Kit.CreateCustomKit:output_type -> kit.v1.CreateCustomKitResponse
+	5, // [5:9] is the sub-list for method output_type
+	1, // [1:5] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_kit_proto_init() }
+func file_kit_proto_init() {
+	if File_kit_proto != nil {
+		return
+	}
+	file_kit_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_kit_proto_rawDesc), len(file_kit_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_kit_proto_goTypes,
+		DependencyIndexes: file_kit_proto_depIdxs,
+		EnumInfos:         file_kit_proto_enumTypes,
+		MessageInfos:      file_kit_proto_msgTypes,
+	}.Build()
+	File_kit_proto = out.File
+	file_kit_proto_goTypes = nil
+	file_kit_proto_depIdxs = nil
+}
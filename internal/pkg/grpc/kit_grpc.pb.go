@@ -0,0 +1,266 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: kit.proto
+
+package grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Kit_ImportKit_FullMethodName        = "/kit.v1.Kit/ImportKit"
+	Kit_DeleteKit_FullMethodName        = "/kit.v1.Kit/DeleteKit"
+	Kit_DeleteInstrument_FullMethodName = "/kit.v1.Kit/DeleteInstrument"
+	Kit_CreateCustomKit_FullMethodName  = "/kit.v1.Kit/CreateCustomKit"
+)
+
+// KitClient is the client API for Kit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Management of installed kits
+type KitClient interface {
+	// Imports kit from tar or zip archive. Client sends archive by chunks and closes sending.
+	// Server sends progress of import. The last message has stage DONE and id of the imported kit.
+	// Archive layout:
+	//   *.yaml                  kit file and instrument files
+	//   instruments/<key>.sfz   main sfz file of instrument
+	//   instruments/<key>/      optional files included by main sfz file
+	//   samples/<key>/          samples of instrument
+	ImportKit(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ImportKitRequest, ImportKitProgress], error)
+	// Removes kit with its presets, instruments and their files.
+	// Instruments shared with other stock kits are kept.
+	// Fails with FAILED_PRECONDITION if presets of other kits use the instruments.
+	// Blocking presets are reported in google.rpc.PreconditionFailure details
+	DeleteKit(ctx context.Context, in *DeleteKitRequest, opts ...grpc.CallOption) (*DeleteKitResponse, error)
+	// Removes instrument and its files.
+	// Fails with FAILED_PRECONDITION if presets use the instrument
+	DeleteInstrument(ctx context.Context, in *DeleteInstrumentRequest, opts ...grpc.CallOption) (*DeleteInstrumentResponse, error)
+	// Creates custom kit from instruments of installed stock kits.
+	// Instruments are chosen by uuid and may belong to different kits.
+	// Fails with NOT_FOUND if any of instruments doesn't exist
+	CreateCustomKit(ctx context.Context, in *CreateCustomKitRequest, opts ...grpc.CallOption) (*CreateCustomKitResponse, error)
+}
+
+type kitClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKitClient(cc grpc.ClientConnInterface) KitClient {
+	return &kitClient{cc}
+}
+
+func (c *kitClient) ImportKit(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ImportKitRequest, ImportKitProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Kit_ServiceDesc.Streams[0], Kit_ImportKit_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportKitRequest, ImportKitProgress]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Kit_ImportKitClient = grpc.BidiStreamingClient[ImportKitRequest, ImportKitProgress]
+
+func (c *kitClient) DeleteKit(ctx context.Context, in *DeleteKitRequest, opts ...grpc.CallOption) (*DeleteKitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteKitResponse)
+	err := c.cc.Invoke(ctx, Kit_DeleteKit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kitClient) DeleteInstrument(ctx context.Context, in *DeleteInstrumentRequest, opts ...grpc.CallOption) (*DeleteInstrumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteInstrumentResponse)
+	err := c.cc.Invoke(ctx, Kit_DeleteInstrument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kitClient) CreateCustomKit(ctx context.Context, in *CreateCustomKitRequest, opts ...grpc.CallOption) (*CreateCustomKitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCustomKitResponse)
+	err := c.cc.Invoke(ctx, Kit_CreateCustomKit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KitServer is the server API for Kit service.
+// All implementations must embed UnimplementedKitServer
+// for forward compatibility.
+//
+// Management of installed kits
+type KitServer interface {
+	// Imports kit from tar or zip archive. Client sends archive by chunks and closes sending.
+	// Server sends progress of import. The last message has stage DONE and id of the imported kit.
+	// Archive layout:
+	//   *.yaml                  kit file and instrument files
+	//   instruments/<key>.sfz   main sfz file of instrument
+	//   instruments/<key>/      optional files included by main sfz file
+	//   samples/<key>/          samples of instrument
+	ImportKit(grpc.BidiStreamingServer[ImportKitRequest, ImportKitProgress]) error
+	// Removes kit with its presets, instruments and their files.
+	// Instruments shared with other stock kits are kept.
+	// Fails with FAILED_PRECONDITION if presets of other kits use the instruments.
+	// Blocking presets are reported in google.rpc.PreconditionFailure details
+	DeleteKit(context.Context, *DeleteKitRequest) (*DeleteKitResponse, error)
+	// Removes instrument and its files.
+	// Fails with FAILED_PRECONDITION if presets use the instrument
+	DeleteInstrument(context.Context, *DeleteInstrumentRequest) (*DeleteInstrumentResponse, error)
+	// Creates custom kit from instruments of installed stock kits.
+	// Instruments are chosen by uuid and may belong to different kits.
+	// Fails with NOT_FOUND if any of instruments doesn't exist
+	CreateCustomKit(context.Context, *CreateCustomKitRequest) (*CreateCustomKitResponse, error)
+	mustEmbedUnimplementedKitServer()
+}
+
+// UnimplementedKitServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedKitServer struct{}
+
+func (UnimplementedKitServer) ImportKit(grpc.BidiStreamingServer[ImportKitRequest, ImportKitProgress]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportKit not implemented")
+}
+func (UnimplementedKitServer) DeleteKit(context.Context, *DeleteKitRequest) (*DeleteKitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKit not implemented")
+}
+func (UnimplementedKitServer) DeleteInstrument(context.Context, *DeleteInstrumentRequest) (*DeleteInstrumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInstrument not implemented")
+}
+func (UnimplementedKitServer) CreateCustomKit(context.Context, *CreateCustomKitRequest) (*CreateCustomKitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCustomKit not implemented")
+}
+func (UnimplementedKitServer) mustEmbedUnimplementedKitServer() {}
+func (UnimplementedKitServer) testEmbeddedByValue()             {}
+
+// UnsafeKitServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KitServer will
+// result in compilation errors.
+type UnsafeKitServer interface {
+	mustEmbedUnimplementedKitServer()
+}
+
+func RegisterKitServer(s grpc.ServiceRegistrar, srv KitServer) {
+	// If the following call pancis, it indicates UnimplementedKitServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Kit_ServiceDesc, srv)
+}
+
+func _Kit_ImportKit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(KitServer).ImportKit(&grpc.GenericServerStream[ImportKitRequest, ImportKitProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Kit_ImportKitServer = grpc.BidiStreamingServer[ImportKitRequest, ImportKitProgress]
+
+func _Kit_DeleteKit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteKitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitServer).DeleteKit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Kit_DeleteKit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitServer).DeleteKit(ctx, req.(*DeleteKitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kit_DeleteInstrument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInstrumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitServer).DeleteInstrument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Kit_DeleteInstrument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitServer).DeleteInstrument(ctx, req.(*DeleteInstrumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kit_CreateCustomKit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCustomKitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitServer).CreateCustomKit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Kit_CreateCustomKit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitServer).CreateCustomKit(ctx, req.(*CreateCustomKitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Kit_ServiceDesc is the grpc.ServiceDesc for Kit service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Kit_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kit.v1.Kit",
+	HandlerType: (*KitServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DeleteKit",
+			Handler:    _Kit_DeleteKit_Handler,
+		},
+		{
+			MethodName: "DeleteInstrument",
+			Handler:    _Kit_DeleteInstrument_Handler,
+		},
+		{
+			MethodName: "CreateCustomKit",
+			Handler:    _Kit_CreateCustomKit_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportKit",
+			Handler:       _Kit_ImportKit_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "kit.proto",
+}
@@ -0,0 +1,201 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: library.proto
+
+package grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Library_ListKits_FullMethodName        = "/library.v1.Library/ListKits"
+	Library_ListInstruments_FullMethodName = "/library.v1.Library/ListInstruments"
+	Library_ListPresets_FullMethodName     = "/library.v1.Library/ListPresets"
+)
+
+// LibraryClient is the client API for Library service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Browsing of installed kits, instruments and presets
+type LibraryClient interface {
+	ListKits(ctx context.Context, in *ListKitsRequest, opts ...grpc.CallOption) (*ListKitsResponse, error)
+	ListInstruments(ctx context.Context, in *ListInstrumentsRequest, opts ...grpc.CallOption) (*ListInstrumentsResponse, error)
+	ListPresets(ctx context.Context, in *ListPresetsRequest, opts ...grpc.CallOption) (*ListPresetsResponse, error)
+}
+
+type libraryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLibraryClient(cc grpc.ClientConnInterface) LibraryClient {
+	return &libraryClient{cc}
+}
+
+func (c *libraryClient) ListKits(ctx context.Context, in *ListKitsRequest, opts ...grpc.CallOption) (*ListKitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListKitsResponse)
+	err := c.cc.Invoke(ctx, Library_ListKits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) ListInstruments(ctx context.Context, in *ListInstrumentsRequest, opts ...grpc.CallOption) (*ListInstrumentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInstrumentsResponse)
+	err := c.cc.Invoke(ctx, Library_ListInstruments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) ListPresets(ctx context.Context, in *ListPresetsRequest, opts ...grpc.CallOption) (*ListPresetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPresetsResponse)
+	err := c.cc.Invoke(ctx, Library_ListPresets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LibraryServer is the server API for Library service.
+// All implementations must embed UnimplementedLibraryServer
+// for forward compatibility.
+//
+// Browsing of installed kits, instruments and presets
+type LibraryServer interface {
+	ListKits(context.Context, *ListKitsRequest) (*ListKitsResponse, error)
+	ListInstruments(context.Context, *ListInstrumentsRequest) (*ListInstrumentsResponse, error)
+	ListPresets(context.Context, *ListPresetsRequest) (*ListPresetsResponse, error)
+	mustEmbedUnimplementedLibraryServer()
+}
+
+// UnimplementedLibraryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLibraryServer struct{}
+
+func (UnimplementedLibraryServer) ListKits(context.Context, *ListKitsRequest) (*ListKitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKits not implemented")
+}
+func (UnimplementedLibraryServer) ListInstruments(context.Context, *ListInstrumentsRequest) (*ListInstrumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInstruments not implemented")
+}
+func (UnimplementedLibraryServer) ListPresets(context.Context, *ListPresetsRequest) (*ListPresetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPresets not implemented")
+}
+func (UnimplementedLibraryServer) mustEmbedUnimplementedLibraryServer() {}
+func (UnimplementedLibraryServer) testEmbeddedByValue()                 {}
+
+// UnsafeLibraryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LibraryServer will
+// result in compilation errors.
+type UnsafeLibraryServer interface {
+	mustEmbedUnimplementedLibraryServer()
+}
+
+func RegisterLibraryServer(s grpc.ServiceRegistrar, srv LibraryServer) {
+	// If the following call pancis, it indicates UnimplementedLibraryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Library_ServiceDesc, srv)
+}
+
+func _Library_ListKits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).ListKits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_ListKits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).ListKits(ctx, req.(*ListKitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_ListInstruments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInstrumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).ListInstruments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_ListInstruments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).ListInstruments(ctx, req.(*ListInstrumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_ListPresets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPresetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).ListPresets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_ListPresets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).ListPresets(ctx, req.(*ListPresetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Library_ServiceDesc is the grpc.ServiceDesc for Library service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Library_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "library.v1.Library",
+	HandlerType: (*LibraryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListKits",
+			Handler:    _Library_ListKits_Handler,
+		},
+		{
+			MethodName: "ListInstruments",
+			Handler:    _Library_ListInstruments_Handler,
+		},
+		{
+			MethodName: "ListPresets",
+			Handler:    _Library_ListPresets_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "library.proto",
+}
@@ -0,0 +1,869 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        v5.29.3
+// source: midi.proto
+
+package grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListMidiDevicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMidiDevicesRequest) Reset() {
+	*x = ListMidiDevicesRequest{}
+	mi := &file_midi_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMidiDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMidiDevicesRequest) ProtoMessage() {}
+
+func (x *ListMidiDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_midi_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMidiDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ListMidiDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_midi_proto_rawDescGZIP(), []int{0}
+}
+
+type ListMidiDevicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devices       []*MidiDevice          `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMidiDevicesResponse) Reset() {
+	*x = ListMidiDevicesResponse{}
+	mi := &file_midi_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMidiDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMidiDevicesResponse) ProtoMessage() {}
+
+func (x *ListMidiDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_midi_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMidiDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ListMidiDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_midi_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListMidiDevicesResponse) GetDevices() []*MidiDevice {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type MidiDevice struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ALSA sequencer address client:port, e.g. "24:0"
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// assigned MIDI map profile. Unset if device uses default profile
+	ProfileId     *int64 `protobuf:"varint,3,opt,name=profile_id,json=profileId,proto3,oneof" json:"profile_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MidiDevice) Reset() {
+	*x = MidiDevice{}
+	mi := &file_midi_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MidiDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MidiDevice) ProtoMessage() {}
+
+func (x *MidiDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_midi_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MidiDevice.ProtoReflect.Descriptor instead.
+func (*MidiDevice) Descriptor() ([]byte, []int) {
+	return file_midi_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MidiDevice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MidiDevice) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MidiDevice) GetProfileId() int64 {
+	if x != nil && x.ProfileId != nil {
+		return *x.ProfileId
+	}
+	return 0
+}
+
+type ListMidiMapProfilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMidiMapProfilesRequest) Reset() {
+	*x = ListMidiMapProfilesRequest{}
+	mi := &file_midi_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMidiMapProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMidiMapProfilesRequest) ProtoMessage() {}
+
+func (x *ListMidiMapProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_midi_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMidiMapProfilesRequest.ProtoReflect.Descriptor instead.
+func (*ListMidiMapProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_midi_proto_rawDescGZIP(), []int{3}
+}
+
+type ListMidiMapProfilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profiles      []*MidiMapProfile      `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMidiMapProfilesResponse) Reset() {
+	*x = ListMidiMapProfilesResponse{}
+	mi := &file_midi_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMidiMapProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMidiMapProfilesResponse) ProtoMessage() {}
+
+func (x *ListMidiMapProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_midi_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMidiMapProfilesResponse.ProtoReflect.Descriptor instead.
+func (*ListMidiMapProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_midi_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListMidiMapProfilesResponse) GetProfiles() []*MidiMapProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+type GetMidiMapProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMidiMapProfileRequest) Reset() {
+	*x = GetMidiMapProfileRequest{}
+	mi := &file_midi_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMidiMapProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMidiMapProfileRequest) ProtoMessage() {}
+
+func (x *GetMidiMapProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_midi_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMidiMapProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetMidiMapProfileRequest) Descriptor() ([]byte, []int) {
+	return file_midi_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetMidiMapProfileRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+type CreateMidiMapProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *MidiMapProfile        `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMidiMapProfileRequest) Reset() {
+	*x = CreateMidiMapProfileRequest{}
+	mi := &file_midi_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMidiMapProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMidiMapProfileRequest) ProtoMessage() {}
+
+func (x *CreateMidiMapProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_midi_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMidiMapProfileRequest.ProtoReflect.Descriptor instead.
+func (*CreateMidiMapProfileRequest) Descriptor() ([]byte, []int) {
+	return file_midi_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateMidiMapProfileRequest) GetProfile() *MidiMapProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// id and builtin of profile are ignored
+type UpdateMidiMapProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Profile       *MidiMapProfile        `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMidiMapProfileRequest) Reset() {
+	*x = UpdateMidiMapProfileRequest{}
+	mi := &file_midi_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMidiMapProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMidiMapProfileRequest) ProtoMessage() {}
+
+func (x *UpdateMidiMapProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_midi_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMidiMapProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMidiMapProfileRequest) Descriptor() ([]byte, []int) {
+	return file_midi_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateMidiMapProfileRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+func (x *UpdateMidiMapProfileRequest) GetProfile() *MidiMapProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type MidiMapProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *MidiMapProfile        `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MidiMapProfileResponse) Reset() {
+	*x = MidiMapProfileResponse{}
+	mi := &file_midi_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MidiMapProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MidiMapProfileResponse) ProtoMessage() {}
+
+func (x *MidiMapProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_midi_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MidiMapProfileResponse.ProtoReflect.Descriptor instead.
+func (*MidiMapProfileResponse) Descriptor() ([]byte, []int) {
+	return file_midi_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MidiMapProfileResponse) GetProfile() *MidiMapProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type DeleteMidiMapProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMidiMapProfileRequest) Reset() {
+	*x = DeleteMidiMapProfileRequest{}
+	mi := &file_midi_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMidiMapProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMidiMapProfileRequest) ProtoMessage() {}
+
+func (x *DeleteMidiMapProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_midi_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMidiMapProfileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMidiMapProfileRequest) Descriptor() ([]byte, []int) {
+	return file_midi_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteMidiMapProfileRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+type DeleteMidiMapProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMidiMapProfileResponse) Reset() {
+	*x = DeleteMidiMapProfileResponse{}
+	mi := &file_midi_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMidiMapProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMidiMapProfileResponse) ProtoMessage() {}
+
+func (x *DeleteMidiMapProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_midi_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMidiMapProfileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMidiMapProfileResponse) Descriptor() ([]byte, []int) {
+	return file_midi_proto_rawDescGZIP(), []int{10}
+}
+
+// Device is identified by name, because ALSA client id changes on reconnect
+type AssignMidiMapProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceName    string                 `protobuf:"bytes,1,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	ProfileId     int64                  `protobuf:"varint,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignMidiMapProfileRequest) Reset() {
+	*x = AssignMidiMapProfileRequest{}
+	mi := &file_midi_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignMidiMapProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignMidiMapProfileRequest) ProtoMessage() {}
+
+func (x *AssignMidiMapProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_midi_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignMidiMapProfileRequest.ProtoReflect.Descriptor instead.
+func (*AssignMidiMapProfileRequest) Descriptor() ([]byte, []int) {
+	return file_midi_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AssignMidiMapProfileRequest) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *AssignMidiMapProfileRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+type AssignMidiMapProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignMidiMapProfileResponse) Reset() {
+	*x = AssignMidiMapProfileResponse{}
+	mi := &file_midi_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignMidiMapProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignMidiMapProfileResponse) ProtoMessage() {}
+
+func (x *AssignMidiMapProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_midi_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignMidiMapProfileResponse.ProtoReflect.Descriptor instead.
+func (*AssignMidiMapProfileResponse) Descriptor() ([]byte, []int) {
+	return file_midi_proto_rawDescGZIP(), []int{12}
+}
+
+type MidiMapProfile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// builtin profiles can't be changed or removed
+	Builtin bool `protobuf:"varint,3,opt,name=builtin,proto3" json:"builtin,omitempty"`
+	// MIDI Key of preset instrument (e.g. "hihat_open") : MIDI note
+	Notes         map[string]int32 `protobuf:"bytes,4,rep,name=notes,proto3" json:"notes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MidiMapProfile) Reset() {
+	*x = MidiMapProfile{}
+	mi := &file_midi_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MidiMapProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MidiMapProfile) ProtoMessage() {}
+
+func (x *MidiMapProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_midi_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MidiMapProfile.ProtoReflect.Descriptor instead.
+func (*MidiMapProfile) Descriptor() ([]byte, []int) {
+	return file_midi_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MidiMapProfile) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MidiMapProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MidiMapProfile) GetBuiltin() bool {
+	if x != nil {
+		return x.Builtin
+	}
+	return false
+}
+
+func (x *MidiMapProfile) GetNotes() map[string]int32 {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+var File_midi_proto protoreflect.FileDescriptor
+
+var file_midi_proto_rawDesc = string([]byte{
+	0x0a, 0x0a, 0x6d, 0x69, 0x64, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6d, 0x69,
+	0x64, 0x69, 0x2e, 0x76, 0x31, 0x22, 0x18, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x64,
+	0x69, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x48, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x64, 0x69, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x69,
+	0x64, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x64, 0x69, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x63, 0x0a, 0x0a, 0x4d, 0x69, 0x64,
+	0x69, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x00, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x1c,
+	0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x1b,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6d, 0x69, 0x64, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x22, 0x39, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x1b, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x69,
+	0x64, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x6f, 0x0a,
+	0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d,
+	0x69, 0x64, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x4b,
+	0x0a, 0x16, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x69, 0x64, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x3c, 0x0a, 0x1b, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x1b, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc2, 0x01, 0x0a, 0x0e, 0x4d, 0x69, 0x64,
+	0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x12, 0x38, 0x0a, 0x05, 0x6e, 0x6f, 0x74,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x69, 0x64, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0x9f, 0x05,
+	0x0a, 0x04, 0x4d, 0x69, 0x64, 0x69, 0x12, 0x54, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69,
+	0x64, 0x69, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x64, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x64, 0x69, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x64,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x64, 0x69, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x6d, 0x69, 0x64, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x69, 0x64, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x6d, 0x69, 0x64, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x64, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x24, 0x2e, 0x6d, 0x69, 0x64, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x64, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x24,
+	0x2e, 0x6d, 0x69, 0x64, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x64, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
+	0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x24, 0x2e,
+	0x6d, 0x69, 0x64, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69,
+	0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x69, 0x64, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x14, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x24, 0x2e, 0x6d, 0x69, 0x64, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x69, 0x64, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x4d, 0x69, 0x64, 0x69, 0x4d, 0x61, 0x70,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61,
+	0x73, 0x70, 0x69, 0x64, 0x72, 0x75, 0x6d, 0x2d, 0x73, 0x72, 0x76, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_midi_proto_rawDescOnce sync.Once
+	file_midi_proto_rawDescData []byte
+)
+
+func file_midi_proto_rawDescGZIP() []byte {
+	file_midi_proto_rawDescOnce.Do(func() {
+		file_midi_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_midi_proto_rawDesc), len(file_midi_proto_rawDesc)))
+	})
+	return file_midi_proto_rawDescData
+}
+
+var file_midi_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_midi_proto_goTypes = []any{
+	(*ListMidiDevicesRequest)(nil),       // 0: midi.v1.ListMidiDevicesRequest
+	(*ListMidiDevicesResponse)(nil),      // 1: midi.v1.ListMidiDevicesResponse
+	(*MidiDevice)(nil),                   // 2: midi.v1.MidiDevice
+	(*ListMidiMapProfilesRequest)(nil),   // 3: midi.v1.ListMidiMapProfilesRequest
+	(*ListMidiMapProfilesResponse)(nil),  // 4: midi.v1.ListMidiMapProfilesResponse
+	(*GetMidiMapProfileRequest)(nil),     // 5: midi.v1.GetMidiMapProfileRequest
+	(*CreateMidiMapProfileRequest)(nil),  // 6: midi.v1.CreateMidiMapProfileRequest
+	(*UpdateMidiMapProfileRequest)(nil),  // 7: midi.v1.UpdateMidiMapProfileRequest
+	(*MidiMapProfileResponse)(nil),       // 8: midi.v1.MidiMapProfileResponse
+	(*DeleteMidiMapProfileRequest)(nil),  // 9: midi.v1.DeleteMidiMapProfileRequest
+	(*DeleteMidiMapProfileResponse)(nil), // 10: midi.v1.DeleteMidiMapProfileResponse
+	(*AssignMidiMapProfileRequest)(nil),  // 11: midi.v1.AssignMidiMapProfileRequest
+	(*AssignMidiMapProfileResponse)(nil), // 12: midi.v1.AssignMidiMapProfileResponse
+	(*MidiMapProfile)(nil),               // 13: midi.v1.MidiMapProfile
+	nil,                                  // 14: midi.v1.MidiMapProfile.NotesEntry
+}
+var file_midi_proto_depIdxs = []int32{
+	2,  // 0: midi.v1.ListMidiDevicesResponse.devices:type_name -> midi.v1.MidiDevice
+	13, // 1: midi.v1.ListMidiMapProfilesResponse.profiles:type_name -> midi.v1.MidiMapProfile
+	13, // 2: midi.v1.CreateMidiMapProfileRequest.profile:type_name -> midi.v1.MidiMapProfile
+	13, // 3: midi.v1.UpdateMidiMapProfileRequest.profile:type_name -> midi.v1.MidiMapProfile
+	13, // 4: midi.v1.MidiMapProfileResponse.profile:type_name -> midi.v1.MidiMapProfile
+	14, // 5: midi.v1.MidiMapProfile.notes:type_name -> midi.v1.MidiMapProfile.NotesEntry
+	0,  // 6: midi.v1.Midi.ListMidiDevices:input_type -> midi.v1.ListMidiDevicesRequest
+	3,  // 7: midi.v1.Midi.ListMidiMapProfiles:input_type -> midi.v1.ListMidiMapProfilesRequest
+	5,  // 8: midi.v1.Midi.GetMidiMapProfile:input_type -> midi.v1.GetMidiMapProfileRequest
+	6,  // 9: midi.v1.Midi.CreateMidiMapProfile:input_type -> midi.v1.CreateMidiMapProfileRequest
+	7,  // 10: midi.v1.Midi.UpdateMidiMapProfile:input_type -> midi.v1.UpdateMidiMapProfileRequest
+	9,  // 11: midi.v1.Midi.DeleteMidiMapProfile:input_type -> midi.v1.DeleteMidiMapProfileRequest
+	11, // 12: midi.v1.Midi.AssignMidiMapProfile:input_type -> midi.v1.AssignMidiMapProfileRequest
+	1,  // 13: midi.v1.Midi.ListMidiDevices:output_type -> midi.v1.ListMidiDevicesResponse
+	4,  // 14: midi.v1.Midi.ListMidiMapProfiles:output_type -> midi.v1.ListMidiMapProfilesResponse
+	8,  // 15: midi.v1.Midi.GetMidiMapProfile:output_type -> midi.v1.MidiMapProfileResponse
+	8,  // 16: midi.v1.Midi.CreateMidiMapProfile:output_type -> midi.v1.MidiMapProfileResponse
+	8,  // 17: midi.v1.Midi.UpdateMidiMapProfile:output_type -> midi.v1.MidiMapProfileResponse
+	10, // 18: midi.v1.Midi.DeleteMidiMapProfile:output_type -> midi.v1.DeleteMidiMapProfileResponse
+	12, // 19: midi.v1.Midi.AssignMidiMapProfile:output_type -> midi.v1.AssignMidiMapProfileResponse
+	13, // [13:20] is the sub-list for method output_type
+	6,  // [6:13] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_midi_proto_init() }
+func file_midi_proto_init() {
+	if File_midi_proto != nil {
+		return
+	}
+	file_midi_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_midi_proto_rawDesc), len(file_midi_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_midi_proto_goTypes,
+		DependencyIndexes: file_midi_proto_depIdxs,
+		MessageInfos:      file_midi_proto_msgTypes,
+	}.Build()
+	File_midi_proto = out.File
+	file_midi_proto_goTypes = nil
+	file_midi_proto_depIdxs = nil
+}
@@ -0,0 +1,361 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: midi.proto
+
+package grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Midi_ListMidiDevices_FullMethodName      = "/midi.v1.Midi/ListMidiDevices"
+	Midi_ListMidiMapProfiles_FullMethodName  = "/midi.v1.Midi/ListMidiMapProfiles"
+	Midi_GetMidiMapProfile_FullMethodName    = "/midi.v1.Midi/GetMidiMapProfile"
+	Midi_CreateMidiMapProfile_FullMethodName = "/midi.v1.Midi/CreateMidiMapProfile"
+	Midi_UpdateMidiMapProfile_FullMethodName = "/midi.v1.Midi/UpdateMidiMapProfile"
+	Midi_DeleteMidiMapProfile_FullMethodName = "/midi.v1.Midi/DeleteMidiMapProfile"
+	Midi_AssignMidiMapProfile_FullMethodName = "/midi.v1.Midi/AssignMidiMapProfile"
+)
+
+// MidiClient is the client API for Midi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MIDI devices connected to the server and MIDI note mapping profiles
+type MidiClient interface {
+	// Lists MIDI devices found in ALSA sequencer
+	ListMidiDevices(ctx context.Context, in *ListMidiDevicesRequest, opts ...grpc.CallOption) (*ListMidiDevicesResponse, error)
+	ListMidiMapProfiles(ctx context.Context, in *ListMidiMapProfilesRequest, opts ...grpc.CallOption) (*ListMidiMapProfilesResponse, error)
+	GetMidiMapProfile(ctx context.Context, in *GetMidiMapProfileRequest, opts ...grpc.CallOption) (*MidiMapProfileResponse, error)
+	CreateMidiMapProfile(ctx context.Context, in *CreateMidiMapProfileRequest, opts ...grpc.CallOption) (*MidiMapProfileResponse, error)
+	// Replaces name and notes of profile. Builtin profiles can't be updated
+	UpdateMidiMapProfile(ctx context.Context, in *UpdateMidiMapProfileRequest, opts ...grpc.CallOption) (*MidiMapProfileResponse, error)
+	// Fails with FAILED_PRECONDITION for builtin profiles and profiles assigned to devices
+	DeleteMidiMapProfile(ctx context.Context, in *DeleteMidiMapProfileRequest, opts ...grpc.CallOption) (*DeleteMidiMapProfileResponse, error)
+	// Assigns profile to device. Profile is applied on next preset loading
+	AssignMidiMapProfile(ctx context.Context, in *AssignMidiMapProfileRequest, opts ...grpc.CallOption) (*AssignMidiMapProfileResponse, error)
+}
+
+type midiClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMidiClient(cc grpc.ClientConnInterface) MidiClient {
+	return &midiClient{cc}
+}
+
+func (c *midiClient) ListMidiDevices(ctx context.Context, in *ListMidiDevicesRequest, opts ...grpc.CallOption) (*ListMidiDevicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMidiDevicesResponse)
+	err := c.cc.Invoke(ctx, Midi_ListMidiDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *midiClient) ListMidiMapProfiles(ctx context.Context, in *ListMidiMapProfilesRequest, opts ...grpc.CallOption) (*ListMidiMapProfilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMidiMapProfilesResponse)
+	err := c.cc.Invoke(ctx, Midi_ListMidiMapProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *midiClient) GetMidiMapProfile(ctx context.Context, in *GetMidiMapProfileRequest, opts ...grpc.CallOption) (*MidiMapProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MidiMapProfileResponse)
+	err := c.cc.Invoke(ctx, Midi_GetMidiMapProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *midiClient) CreateMidiMapProfile(ctx context.Context, in *CreateMidiMapProfileRequest, opts ...grpc.CallOption) (*MidiMapProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MidiMapProfileResponse)
+	err := c.cc.Invoke(ctx, Midi_CreateMidiMapProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *midiClient) UpdateMidiMapProfile(ctx context.Context, in *UpdateMidiMapProfileRequest, opts ...grpc.CallOption) (*MidiMapProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MidiMapProfileResponse)
+	err := c.cc.Invoke(ctx, Midi_UpdateMidiMapProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *midiClient) DeleteMidiMapProfile(ctx context.Context, in *DeleteMidiMapProfileRequest, opts ...grpc.CallOption) (*DeleteMidiMapProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMidiMapProfileResponse)
+	err := c.cc.Invoke(ctx, Midi_DeleteMidiMapProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *midiClient) AssignMidiMapProfile(ctx context.Context, in *AssignMidiMapProfileRequest, opts ...grpc.CallOption) (*AssignMidiMapProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignMidiMapProfileResponse)
+	err := c.cc.Invoke(ctx, Midi_AssignMidiMapProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MidiServer is the server API for Midi service.
+// All implementations must embed UnimplementedMidiServer
+// for forward compatibility.
+//
+// MIDI devices connected to the server and MIDI note mapping profiles
+type MidiServer interface {
+	// Lists MIDI devices found in ALSA sequencer
+	ListMidiDevices(context.Context, *ListMidiDevicesRequest) (*ListMidiDevicesResponse, error)
+	ListMidiMapProfiles(context.Context, *ListMidiMapProfilesRequest) (*ListMidiMapProfilesResponse, error)
+	GetMidiMapProfile(context.Context, *GetMidiMapProfileRequest) (*MidiMapProfileResponse, error)
+	CreateMidiMapProfile(context.Context, *CreateMidiMapProfileRequest) (*MidiMapProfileResponse, error)
+	// Replaces name and notes of profile. Builtin profiles can't be updated
+	UpdateMidiMapProfile(context.Context, *UpdateMidiMapProfileRequest) (*MidiMapProfileResponse, error)
+	// Fails with FAILED_PRECONDITION for builtin profiles and profiles assigned to devices
+	DeleteMidiMapProfile(context.Context, *DeleteMidiMapProfileRequest) (*DeleteMidiMapProfileResponse, error)
+	// Assigns profile to device. Profile is applied on next preset loading
+	AssignMidiMapProfile(context.Context, *AssignMidiMapProfileRequest) (*AssignMidiMapProfileResponse, error)
+	mustEmbedUnimplementedMidiServer()
+}
+
+// UnimplementedMidiServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMidiServer struct{}
+
+func (UnimplementedMidiServer) ListMidiDevices(context.Context, *ListMidiDevicesRequest) (*ListMidiDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMidiDevices not implemented")
+}
+func (UnimplementedMidiServer) ListMidiMapProfiles(context.Context, *ListMidiMapProfilesRequest) (*ListMidiMapProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMidiMapProfiles not implemented")
+}
+func (UnimplementedMidiServer) GetMidiMapProfile(context.Context, *GetMidiMapProfileRequest) (*MidiMapProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMidiMapProfile not implemented")
+}
+func (UnimplementedMidiServer) CreateMidiMapProfile(context.Context, *CreateMidiMapProfileRequest) (*MidiMapProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMidiMapProfile not implemented")
+}
+func (UnimplementedMidiServer) UpdateMidiMapProfile(context.Context, *UpdateMidiMapProfileRequest) (*MidiMapProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMidiMapProfile not implemented")
+}
+func (UnimplementedMidiServer) DeleteMidiMapProfile(context.Context, *DeleteMidiMapProfileRequest) (*DeleteMidiMapProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMidiMapProfile not implemented")
+}
+func (UnimplementedMidiServer) AssignMidiMapProfile(context.Context, *AssignMidiMapProfileRequest) (*AssignMidiMapProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignMidiMapProfile not implemented")
+}
+func (UnimplementedMidiServer) mustEmbedUnimplementedMidiServer() {}
+func (UnimplementedMidiServer) testEmbeddedByValue()              {}
+
+// UnsafeMidiServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MidiServer will
+// result in compilation errors.
+type UnsafeMidiServer interface {
+	mustEmbedUnimplementedMidiServer()
+}
+
+func RegisterMidiServer(s grpc.ServiceRegistrar, srv MidiServer) {
+	// If the following call pancis, it indicates UnimplementedMidiServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Midi_ServiceDesc, srv)
+}
+
+func _Midi_ListMidiDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMidiDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MidiServer).ListMidiDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Midi_ListMidiDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MidiServer).ListMidiDevices(ctx, req.(*ListMidiDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Midi_ListMidiMapProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMidiMapProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MidiServer).ListMidiMapProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Midi_ListMidiMapProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MidiServer).ListMidiMapProfiles(ctx, req.(*ListMidiMapProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Midi_GetMidiMapProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMidiMapProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MidiServer).GetMidiMapProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Midi_GetMidiMapProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MidiServer).GetMidiMapProfile(ctx, req.(*GetMidiMapProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Midi_CreateMidiMapProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMidiMapProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MidiServer).CreateMidiMapProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Midi_CreateMidiMapProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MidiServer).CreateMidiMapProfile(ctx, req.(*CreateMidiMapProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Midi_UpdateMidiMapProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMidiMapProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MidiServer).UpdateMidiMapProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Midi_UpdateMidiMapProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MidiServer).UpdateMidiMapProfile(ctx, req.(*UpdateMidiMapProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Midi_DeleteMidiMapProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMidiMapProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MidiServer).DeleteMidiMapProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Midi_DeleteMidiMapProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MidiServer).DeleteMidiMapProfile(ctx, req.(*DeleteMidiMapProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Midi_AssignMidiMapProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignMidiMapProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MidiServer).AssignMidiMapProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Midi_AssignMidiMapProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MidiServer).AssignMidiMapProfile(ctx, req.(*AssignMidiMapProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Midi_ServiceDesc is the grpc.ServiceDesc for Midi service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Midi_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "midi.v1.Midi",
+	HandlerType: (*MidiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListMidiDevices",
+			Handler:    _Midi_ListMidiDevices_Handler,
+		},
+		{
+			MethodName: "ListMidiMapProfiles",
+			Handler:    _Midi_ListMidiMapProfiles_Handler,
+		},
+		{
+			MethodName: "GetMidiMapProfile",
+			Handler:    _Midi_GetMidiMapProfile_Handler,
+		},
+		{
+			MethodName: "CreateMidiMapProfile",
+			Handler:    _Midi_CreateMidiMapProfile_Handler,
+		},
+		{
+			MethodName: "UpdateMidiMapProfile",
+			Handler:    _Midi_UpdateMidiMapProfile_Handler,
+		},
+		{
+			MethodName: "DeleteMidiMapProfile",
+			Handler:    _Midi_DeleteMidiMapProfile_Handler,
+		},
+		{
+			MethodName: "AssignMidiMapProfile",
+			Handler:    _Midi_AssignMidiMapProfile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "midi.proto",
+}
@@ -21,6 +21,104 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PresetEventType int32
+
+const (
+	PresetEventType_PRESET_EVENT_TYPE_UNSPECIFIED PresetEventType = 0
+	// sampler was restarted. The loaded preset is loaded again with current control values
+	PresetEventType_PRESET_EVENT_TYPE_RESTORED PresetEventType = 1
+)
+
+// Enum value maps for PresetEventType.
+var (
+	PresetEventType_name = map[int32]string{
+		0: "PRESET_EVENT_TYPE_UNSPECIFIED",
+		1: "PRESET_EVENT_TYPE_RESTORED",
+	}
+	PresetEventType_value = map[string]int32{
+		"PRESET_EVENT_TYPE_UNSPECIFIED": 0,
+		"PRESET_EVENT_TYPE_RESTORED":    1,
+	}
+)
+
+func (x PresetEventType) Enum() *PresetEventType {
+	p := new(PresetEventType)
+	*p = x
+	return p
+}
+
+func (x PresetEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PresetEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_preset_proto_enumTypes[0].Descriptor()
+}
+
+func (PresetEventType) Type() protoreflect.EnumType {
+	return &file_preset_proto_enumTypes[0]
+}
+
+func (x PresetEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PresetEventType.Descriptor instead.
+func (PresetEventType) EnumDescriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{0}
+}
+
+type ActivityEventType int32
+
+const (
+	ActivityEventType_ACTIVITY_EVENT_TYPE_UNSPECIFIED ActivityEventType = 0
+	// instrument or its layer is hit
+	ActivityEventType_ACTIVITY_EVENT_TYPE_HIT ActivityEventType = 1
+	// count of playing voices of channel is changed
+	ActivityEventType_ACTIVITY_EVENT_TYPE_VOICES ActivityEventType = 2
+)
+
+// Enum value maps for ActivityEventType.
+var (
+	ActivityEventType_name = map[int32]string{
+		0: "ACTIVITY_EVENT_TYPE_UNSPECIFIED",
+		1: "ACTIVITY_EVENT_TYPE_HIT",
+		2: "ACTIVITY_EVENT_TYPE_VOICES",
+	}
+	ActivityEventType_value = map[string]int32{
+		"ACTIVITY_EVENT_TYPE_UNSPECIFIED": 0,
+		"ACTIVITY_EVENT_TYPE_HIT":         1,
+		"ACTIVITY_EVENT_TYPE_VOICES":      2,
+	}
+)
+
+func (x ActivityEventType) Enum() *ActivityEventType {
+	p := new(ActivityEventType)
+	*p = x
+	return p
+}
+
+func (x ActivityEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActivityEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_preset_proto_enumTypes[1].Descriptor()
+}
+
+func (ActivityEventType) Type() protoreflect.EnumType {
+	return &file_preset_proto_enumTypes[1]
+}
+
+func (x ActivityEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActivityEventType.Descriptor instead.
+func (ActivityEventType) EnumDescriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{1}
+}
+
 // Channel type enumeration
 type ChannelType int32
 
@@ -59,103 +157,1406 @@ func (x ChannelType) Enum() *ChannelType {
 	return p
 }
 
-func (x ChannelType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x ChannelType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChannelType) Descriptor() protoreflect.EnumDescriptor {
+	return file_preset_proto_enumTypes[2].Descriptor()
+}
+
+func (ChannelType) Type() protoreflect.EnumType {
+	return &file_preset_proto_enumTypes[2]
+}
+
+func (x ChannelType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChannelType.Descriptor instead.
+func (ChannelType) EnumDescriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{2}
+}
+
+// FX parameter type enumeration
+type FXParamType int32
+
+const (
+	FXParamType_FX_PARAM_TYPE_UNSPECIFIED FXParamType = 0
+	FXParamType_FX_PARAM_TYPE_RANGE       FXParamType = 1
+	FXParamType_FX_PARAM_TYPE_FIXED       FXParamType = 2
+	FXParamType_FX_PARAM_TYPE_BOOLEAN     FXParamType = 3
+)
+
+// Enum value maps for FXParamType.
+var (
+	FXParamType_name = map[int32]string{
+		0: "FX_PARAM_TYPE_UNSPECIFIED",
+		1: "FX_PARAM_TYPE_RANGE",
+		2: "FX_PARAM_TYPE_FIXED",
+		3: "FX_PARAM_TYPE_BOOLEAN",
+	}
+	FXParamType_value = map[string]int32{
+		"FX_PARAM_TYPE_UNSPECIFIED": 0,
+		"FX_PARAM_TYPE_RANGE":       1,
+		"FX_PARAM_TYPE_FIXED":       2,
+		"FX_PARAM_TYPE_BOOLEAN":     3,
+	}
+)
+
+func (x FXParamType) Enum() *FXParamType {
+	p := new(FXParamType)
+	*p = x
+	return p
+}
+
+func (x FXParamType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FXParamType) Descriptor() protoreflect.EnumDescriptor {
+	return file_preset_proto_enumTypes[3].Descriptor()
+}
+
+func (FXParamType) Type() protoreflect.EnumType {
+	return &file_preset_proto_enumTypes[3]
+}
+
+func (x FXParamType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FXParamType.Descriptor instead.
+func (FXParamType) EnumDescriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{3}
+}
+
+// Request message for loading a preset
+type GetPresetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PresetId      int64                  `protobuf:"varint,1,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPresetRequest) Reset() {
+	*x = GetPresetRequest{}
+	mi := &file_preset_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPresetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresetRequest) ProtoMessage() {}
+
+func (x *GetPresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPresetRequest.ProtoReflect.Descriptor instead.
+func (*GetPresetRequest) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetPresetRequest) GetPresetId() int64 {
+	if x != nil {
+		return x.PresetId
+	}
+	return 0
+}
+
+// Response message for loading a preset
+type PresetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preset        *Preset                `protobuf:"bytes,1,opt,name=preset,proto3" json:"preset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresetResponse) Reset() {
+	*x = PresetResponse{}
+	mi := &file_preset_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresetResponse) ProtoMessage() {}
+
+func (x *PresetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresetResponse.ProtoReflect.Descriptor instead.
+func (*PresetResponse) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PresetResponse) GetPreset() *Preset {
+	if x != nil {
+		return x.Preset
+	}
+	return nil
+}
+
+// Loading progress of preset channel or the loaded preset
+type LoadPresetProgress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key of preset channel. Empty in the last message
+	ChannelKey string `protobuf:"bytes,1,opt,name=channel_key,json=channelKey,proto3" json:"channel_key,omitempty"`
+	// 0..100
+	Percent int32 `protobuf:"varint,2,opt,name=percent,proto3" json:"percent,omitempty"`
+	// set in the last message
+	Preset        *Preset `protobuf:"bytes,3,opt,name=preset,proto3" json:"preset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadPresetProgress) Reset() {
+	*x = LoadPresetProgress{}
+	mi := &file_preset_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadPresetProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadPresetProgress) ProtoMessage() {}
+
+func (x *LoadPresetProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadPresetProgress.ProtoReflect.Descriptor instead.
+func (*LoadPresetProgress) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LoadPresetProgress) GetChannelKey() string {
+	if x != nil {
+		return x.ChannelKey
+	}
+	return ""
+}
+
+func (x *LoadPresetProgress) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *LoadPresetProgress) GetPreset() *Preset {
+	if x != nil {
+		return x.Preset
+	}
+	return nil
+}
+
+// Request message for creating a preset
+type CreatePresetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preset        *PresetDef             `protobuf:"bytes,1,opt,name=preset,proto3" json:"preset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePresetRequest) Reset() {
+	*x = CreatePresetRequest{}
+	mi := &file_preset_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePresetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePresetRequest) ProtoMessage() {}
+
+func (x *CreatePresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePresetRequest.ProtoReflect.Descriptor instead.
+func (*CreatePresetRequest) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreatePresetRequest) GetPreset() *PresetDef {
+	if x != nil {
+		return x.Preset
+	}
+	return nil
+}
+
+// Request message for replacing content of a preset
+type UpdatePresetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PresetId      int64                  `protobuf:"varint,1,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+	Preset        *PresetDef             `protobuf:"bytes,2,opt,name=preset,proto3" json:"preset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePresetRequest) Reset() {
+	*x = UpdatePresetRequest{}
+	mi := &file_preset_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePresetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePresetRequest) ProtoMessage() {}
+
+func (x *UpdatePresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePresetRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePresetRequest) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdatePresetRequest) GetPresetId() int64 {
+	if x != nil {
+		return x.PresetId
+	}
+	return 0
+}
+
+func (x *UpdatePresetRequest) GetPreset() *PresetDef {
+	if x != nil {
+		return x.Preset
+	}
+	return nil
+}
+
+// Request message for renaming a preset
+type RenamePresetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PresetId      int64                  `protobuf:"varint,1,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenamePresetRequest) Reset() {
+	*x = RenamePresetRequest{}
+	mi := &file_preset_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenamePresetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenamePresetRequest) ProtoMessage() {}
+
+func (x *RenamePresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenamePresetRequest.ProtoReflect.Descriptor instead.
+func (*RenamePresetRequest) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RenamePresetRequest) GetPresetId() int64 {
+	if x != nil {
+		return x.PresetId
+	}
+	return 0
+}
+
+func (x *RenamePresetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Request message for cloning a preset. Name is the name of the new preset
+type ClonePresetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PresetId      int64                  `protobuf:"varint,1,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClonePresetRequest) Reset() {
+	*x = ClonePresetRequest{}
+	mi := &file_preset_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClonePresetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClonePresetRequest) ProtoMessage() {}
+
+func (x *ClonePresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClonePresetRequest.ProtoReflect.Descriptor instead.
+func (*ClonePresetRequest) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ClonePresetRequest) GetPresetId() int64 {
+	if x != nil {
+		return x.PresetId
+	}
+	return 0
+}
+
+func (x *ClonePresetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Request message for deleting a preset
+type DeletePresetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PresetId      int64                  `protobuf:"varint,1,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePresetRequest) Reset() {
+	*x = DeletePresetRequest{}
+	mi := &file_preset_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePresetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePresetRequest) ProtoMessage() {}
+
+func (x *DeletePresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePresetRequest.ProtoReflect.Descriptor instead.
+func (*DeletePresetRequest) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeletePresetRequest) GetPresetId() int64 {
+	if x != nil {
+		return x.PresetId
+	}
+	return 0
+}
+
+// Response message for deleting a preset
+type DeletePresetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePresetResponse) Reset() {
+	*x = DeletePresetResponse{}
+	mi := &file_preset_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePresetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePresetResponse) ProtoMessage() {}
+
+func (x *DeletePresetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePresetResponse.ProtoReflect.Descriptor instead.
+func (*DeletePresetResponse) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{8}
+}
+
+// Request message for saving the loaded preset
+type SavePresetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavePresetRequest) Reset() {
+	*x = SavePresetRequest{}
+	mi := &file_preset_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavePresetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavePresetRequest) ProtoMessage() {}
+
+func (x *SavePresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavePresetRequest.ProtoReflect.Descriptor instead.
+func (*SavePresetRequest) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{9}
+}
+
+// Request message for saving the loaded preset as a new preset
+type SavePresetAsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavePresetAsRequest) Reset() {
+	*x = SavePresetAsRequest{}
+	mi := &file_preset_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavePresetAsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavePresetAsRequest) ProtoMessage() {}
+
+func (x *SavePresetAsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavePresetAsRequest.ProtoReflect.Descriptor instead.
+func (*SavePresetAsRequest) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SavePresetAsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Request message for preloading presets, i.e. the next songs of a setlist
+type PreloadPresetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PresetIds     []int64                `protobuf:"varint,1,rep,packed,name=preset_ids,json=presetIds,proto3" json:"preset_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreloadPresetsRequest) Reset() {
+	*x = PreloadPresetsRequest{}
+	mi := &file_preset_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreloadPresetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreloadPresetsRequest) ProtoMessage() {}
+
+func (x *PreloadPresetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreloadPresetsRequest.ProtoReflect.Descriptor instead.
+func (*PreloadPresetsRequest) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PreloadPresetsRequest) GetPresetIds() []int64 {
+	if x != nil {
+		return x.PresetIds
+	}
+	return nil
+}
+
+// Response message with all preloaded presets except the loaded one
+type PreloadPresetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PresetIds     []int64                `protobuf:"varint,1,rep,packed,name=preset_ids,json=presetIds,proto3" json:"preset_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreloadPresetsResponse) Reset() {
+	*x = PreloadPresetsResponse{}
+	mi := &file_preset_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreloadPresetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreloadPresetsResponse) ProtoMessage() {}
+
+func (x *PreloadPresetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreloadPresetsResponse.ProtoReflect.Descriptor instead.
+func (*PreloadPresetsResponse) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PreloadPresetsResponse) GetPresetIds() []int64 {
+	if x != nil {
+		return x.PresetIds
+	}
+	return nil
+}
+
+// Request message for watching events of the loaded preset
+type WatchPresetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPresetRequest) Reset() {
+	*x = WatchPresetRequest{}
+	mi := &file_preset_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPresetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPresetRequest) ProtoMessage() {}
+
+func (x *WatchPresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPresetRequest.ProtoReflect.Descriptor instead.
+func (*WatchPresetRequest) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{13}
+}
+
+// Event of the loaded preset
+type PresetEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  PresetEventType        `protobuf:"varint,1,opt,name=type,proto3,enum=kitPreset.v1.PresetEventType" json:"type,omitempty"`
+	// the loaded preset after event
+	Preset        *Preset `protobuf:"bytes,2,opt,name=preset,proto3" json:"preset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresetEvent) Reset() {
+	*x = PresetEvent{}
+	mi := &file_preset_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresetEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresetEvent) ProtoMessage() {}
+
+func (x *PresetEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresetEvent.ProtoReflect.Descriptor instead.
+func (*PresetEvent) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PresetEvent) GetType() PresetEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PresetEventType_PRESET_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *PresetEvent) GetPreset() *Preset {
+	if x != nil {
+		return x.Preset
+	}
+	return nil
+}
+
+// Request message for watching activity of the loaded preset
+type WatchActivityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchActivityRequest) Reset() {
+	*x = WatchActivityRequest{}
+	mi := &file_preset_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchActivityRequest) ProtoMessage() {}
+
+func (x *WatchActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchActivityRequest.ProtoReflect.Descriptor instead.
+func (*WatchActivityRequest) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{15}
+}
+
+// Activity of preset channel
+type ActivityEvent struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Type       ActivityEventType      `protobuf:"varint,1,opt,name=type,proto3,enum=kitPreset.v1.ActivityEventType" json:"type,omitempty"`
+	ChannelKey string                 `protobuf:"bytes,2,opt,name=channel_key,json=channelKey,proto3" json:"channel_key,omitempty"`
+	// set for hit
+	InstrumentKey string `protobuf:"bytes,3,opt,name=instrument_key,json=instrumentKey,proto3" json:"instrument_key,omitempty"`
+	// set if layer of instrument is hit
+	LayerKey string `protobuf:"bytes,4,opt,name=layer_key,json=layerKey,proto3" json:"layer_key,omitempty"`
+	// 1..127. Max velocity of hits in the frame
+	Velocity int32 `protobuf:"varint,5,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	// the last voice count of channel in the frame
+	VoiceCount int32 `protobuf:"varint,6,opt,name=voice_count,json=voiceCount,proto3" json:"voice_count,omitempty"`
+	// unix time in milliseconds
+	Timestamp     int64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityEvent) Reset() {
+	*x = ActivityEvent{}
+	mi := &file_preset_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityEvent) ProtoMessage() {}
+
+func (x *ActivityEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityEvent.ProtoReflect.Descriptor instead.
+func (*ActivityEvent) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ActivityEvent) GetType() ActivityEventType {
+	if x != nil {
+		return x.Type
+	}
+	return ActivityEventType_ACTIVITY_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *ActivityEvent) GetChannelKey() string {
+	if x != nil {
+		return x.ChannelKey
+	}
+	return ""
+}
+
+func (x *ActivityEvent) GetInstrumentKey() string {
+	if x != nil {
+		return x.InstrumentKey
+	}
+	return ""
+}
+
+func (x *ActivityEvent) GetLayerKey() string {
+	if x != nil {
+		return x.LayerKey
+	}
+	return ""
+}
+
+func (x *ActivityEvent) GetVelocity() int32 {
+	if x != nil {
+		return x.Velocity
+	}
+	return 0
+}
+
+func (x *ActivityEvent) GetVoiceCount() int32 {
+	if x != nil {
+		return x.VoiceCount
+	}
+	return 0
+}
+
+func (x *ActivityEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// Events collected for the frame. Hits of the same instrument or layer are merged
+type ActivityFrame struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*ActivityEvent       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityFrame) Reset() {
+	*x = ActivityFrame{}
+	mi := &file_preset_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityFrame) ProtoMessage() {}
+
+func (x *ActivityFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityFrame.ProtoReflect.Descriptor instead.
+func (*ActivityFrame) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ActivityFrame) GetEvents() []*ActivityEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Response message with reference to the created or changed preset
+type PresetRefResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PresetId      int64                  `protobuf:"varint,1,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresetRefResponse) Reset() {
+	*x = PresetRefResponse{}
+	mi := &file_preset_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresetRefResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresetRefResponse) ProtoMessage() {}
+
+func (x *PresetRefResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresetRefResponse.ProtoReflect.Descriptor instead.
+func (*PresetRefResponse) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PresetRefResponse) GetPresetId() int64 {
+	if x != nil {
+		return x.PresetId
+	}
+	return 0
+}
+
+func (x *PresetRefResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PresetRefResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Preset definition. Same structure as preset yaml file (doc/kit_preset_schema.yaml)
+type PresetDef struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kit uuid
+	KitKey      string                 `protobuf:"bytes,1,opt,name=kit_key,json=kitKey,proto3" json:"kit_key,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Channels    []*PresetChannelDef    `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels,omitempty"`
+	Instruments []*PresetInstrumentDef `protobuf:"bytes,4,rep,name=instruments,proto3" json:"instruments,omitempty"`
+	// send effect chains of audio output. Effects are exposed as fxs of sampler channel
+	Fxs           []*PresetFxChainDef `protobuf:"bytes,5,rep,name=fxs,proto3" json:"fxs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresetDef) Reset() {
+	*x = PresetDef{}
+	mi := &file_preset_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresetDef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresetDef) ProtoMessage() {}
+
+func (x *PresetDef) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresetDef.ProtoReflect.Descriptor instead.
+func (*PresetDef) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PresetDef) GetKitKey() string {
+	if x != nil {
+		return x.KitKey
+	}
+	return ""
+}
+
+func (x *PresetDef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PresetDef) GetChannels() []*PresetChannelDef {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *PresetDef) GetInstruments() []*PresetInstrumentDef {
+	if x != nil {
+		return x.Instruments
+	}
+	return nil
+}
+
+func (x *PresetDef) GetFxs() []*PresetFxChainDef {
+	if x != nil {
+		return x.Fxs
+	}
+	return nil
+}
+
+// Preset channel definition
+type PresetChannelDef struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// key - control key, i.e. volume, pan
+	Controls      map[string]*PresetControlDef `protobuf:"bytes,3,rep,name=controls,proto3" json:"controls,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresetChannelDef) Reset() {
+	*x = PresetChannelDef{}
+	mi := &file_preset_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresetChannelDef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresetChannelDef) ProtoMessage() {}
+
+func (x *PresetChannelDef) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresetChannelDef.ProtoReflect.Descriptor instead.
+func (*PresetChannelDef) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PresetChannelDef) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PresetChannelDef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PresetChannelDef) GetControls() map[string]*PresetControlDef {
+	if x != nil {
+		return x.Controls
+	}
+	return nil
+}
+
+// Preset instrument definition
+type PresetInstrumentDef struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// instrument uuid
+	InstrumentKey string                       `protobuf:"bytes,1,opt,name=instrument_key,json=instrumentKey,proto3" json:"instrument_key,omitempty"`
+	Name          string                       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ChannelKey    string                       `protobuf:"bytes,3,opt,name=channel_key,json=channelKey,proto3" json:"channel_key,omitempty"`
+	MidiKey       *string                      `protobuf:"bytes,4,opt,name=midi_key,json=midiKey,proto3,oneof" json:"midi_key,omitempty"`
+	Controls      map[string]*PresetControlDef `protobuf:"bytes,5,rep,name=controls,proto3" json:"controls,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Layers        map[string]*PresetLayerDef   `protobuf:"bytes,6,rep,name=layers,proto3" json:"layers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresetInstrumentDef) Reset() {
+	*x = PresetInstrumentDef{}
+	mi := &file_preset_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresetInstrumentDef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresetInstrumentDef) ProtoMessage() {}
+
+func (x *PresetInstrumentDef) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresetInstrumentDef.ProtoReflect.Descriptor instead.
+func (*PresetInstrumentDef) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PresetInstrumentDef) GetInstrumentKey() string {
+	if x != nil {
+		return x.InstrumentKey
+	}
+	return ""
+}
+
+func (x *PresetInstrumentDef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PresetInstrumentDef) GetChannelKey() string {
+	if x != nil {
+		return x.ChannelKey
+	}
+	return ""
+}
+
+func (x *PresetInstrumentDef) GetMidiKey() string {
+	if x != nil && x.MidiKey != nil {
+		return *x.MidiKey
+	}
+	return ""
+}
+
+func (x *PresetInstrumentDef) GetControls() map[string]*PresetControlDef {
+	if x != nil {
+		return x.Controls
+	}
+	return nil
+}
+
+func (x *PresetInstrumentDef) GetLayers() map[string]*PresetLayerDef {
+	if x != nil {
+		return x.Layers
+	}
+	return nil
+}
+
+// Preset instrument layer definition
+type PresetLayerDef struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Name          *string                      `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	MidiKey       *string                      `protobuf:"bytes,2,opt,name=midi_key,json=midiKey,proto3,oneof" json:"midi_key,omitempty"`
+	Controls      map[string]*PresetControlDef `protobuf:"bytes,3,rep,name=controls,proto3" json:"controls,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresetLayerDef) Reset() {
+	*x = PresetLayerDef{}
+	mi := &file_preset_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresetLayerDef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresetLayerDef) ProtoMessage() {}
+
+func (x *PresetLayerDef) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresetLayerDef.ProtoReflect.Descriptor instead.
+func (*PresetLayerDef) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PresetLayerDef) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *PresetLayerDef) GetMidiKey() string {
+	if x != nil && x.MidiKey != nil {
+		return *x.MidiKey
+	}
+	return ""
 }
 
-func (ChannelType) Descriptor() protoreflect.EnumDescriptor {
-	return file_preset_proto_enumTypes[0].Descriptor()
+func (x *PresetLayerDef) GetControls() map[string]*PresetControlDef {
+	if x != nil {
+		return x.Controls
+	}
+	return nil
 }
 
-func (ChannelType) Type() protoreflect.EnumType {
-	return &file_preset_proto_enumTypes[0]
+// Preset control definition
+type PresetControlDef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	MidiCc        *int32                 `protobuf:"varint,3,opt,name=midi_cc,json=midiCc,proto3,oneof" json:"midi_cc,omitempty"`
+	Value         float64                `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x ChannelType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *PresetControlDef) Reset() {
+	*x = PresetControlDef{}
+	mi := &file_preset_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-// Deprecated: Use ChannelType.Descriptor instead.
-func (ChannelType) EnumDescriptor() ([]byte, []int) {
-	return file_preset_proto_rawDescGZIP(), []int{0}
+func (x *PresetControlDef) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// FX parameter type enumeration
-type FXParamType int32
-
-const (
-	FXParamType_FX_PARAM_TYPE_UNSPECIFIED FXParamType = 0
-	FXParamType_FX_PARAM_TYPE_RANGE       FXParamType = 1
-	FXParamType_FX_PARAM_TYPE_FIXED       FXParamType = 2
-	FXParamType_FX_PARAM_TYPE_BOOLEAN     FXParamType = 3
-)
+func (*PresetControlDef) ProtoMessage() {}
 
-// Enum value maps for FXParamType.
-var (
-	FXParamType_name = map[int32]string{
-		0: "FX_PARAM_TYPE_UNSPECIFIED",
-		1: "FX_PARAM_TYPE_RANGE",
-		2: "FX_PARAM_TYPE_FIXED",
-		3: "FX_PARAM_TYPE_BOOLEAN",
-	}
-	FXParamType_value = map[string]int32{
-		"FX_PARAM_TYPE_UNSPECIFIED": 0,
-		"FX_PARAM_TYPE_RANGE":       1,
-		"FX_PARAM_TYPE_FIXED":       2,
-		"FX_PARAM_TYPE_BOOLEAN":     3,
+func (x *PresetControlDef) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-)
-
-func (x FXParamType) Enum() *FXParamType {
-	p := new(FXParamType)
-	*p = x
-	return p
+	return mi.MessageOf(x)
 }
 
-func (x FXParamType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+// Deprecated: Use PresetControlDef.ProtoReflect.Descriptor instead.
+func (*PresetControlDef) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{23}
 }
 
-func (FXParamType) Descriptor() protoreflect.EnumDescriptor {
-	return file_preset_proto_enumTypes[1].Descriptor()
+func (x *PresetControlDef) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
 }
 
-func (FXParamType) Type() protoreflect.EnumType {
-	return &file_preset_proto_enumTypes[1]
+func (x *PresetControlDef) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-func (x FXParamType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *PresetControlDef) GetMidiCc() int32 {
+	if x != nil && x.MidiCc != nil {
+		return *x.MidiCc
+	}
+	return 0
 }
 
-// Deprecated: Use FXParamType.Descriptor instead.
-func (FXParamType) EnumDescriptor() ([]byte, []int) {
-	return file_preset_proto_rawDescGZIP(), []int{1}
+func (x *PresetControlDef) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
 }
 
-// Request message for loading a preset
-type GetPresetRequest struct {
+// Send effect chain definition. Effects process sound one by one
+type PresetFxChainDef struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PresetId      int64                  `protobuf:"varint,1,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Effects       []*PresetFxDef         `protobuf:"bytes,3,rep,name=effects,proto3" json:"effects,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPresetRequest) Reset() {
-	*x = GetPresetRequest{}
-	mi := &file_preset_proto_msgTypes[0]
+func (x *PresetFxChainDef) Reset() {
+	*x = PresetFxChainDef{}
+	mi := &file_preset_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPresetRequest) String() string {
+func (x *PresetFxChainDef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPresetRequest) ProtoMessage() {}
+func (*PresetFxChainDef) ProtoMessage() {}
 
-func (x *GetPresetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_preset_proto_msgTypes[0]
+func (x *PresetFxChainDef) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -166,41 +1567,65 @@ func (x *GetPresetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPresetRequest.ProtoReflect.Descriptor instead.
-func (*GetPresetRequest) Descriptor() ([]byte, []int) {
-	return file_preset_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use PresetFxChainDef.ProtoReflect.Descriptor instead.
+func (*PresetFxChainDef) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetPresetRequest) GetPresetId() int64 {
+func (x *PresetFxChainDef) GetKey() string {
 	if x != nil {
-		return x.PresetId
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-// Response message for loading a preset
-type PresetResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Preset        *Preset                `protobuf:"bytes,1,opt,name=preset,proto3" json:"preset,omitempty"`
+func (x *PresetFxChainDef) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *PresetFxChainDef) GetEffects() []*PresetFxDef {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
+
+// Effect plugin definition
+type PresetFxDef struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unique across preset
+	Key  string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// effect system, LADSPA if not set
+	System *string `protobuf:"bytes,3,opt,name=system,proto3,oneof" json:"system,omitempty"`
+	// plugin file, i.e. /usr/lib/ladspa/caps.so
+	Module string `protobuf:"bytes,4,opt,name=module,proto3" json:"module,omitempty"`
+	// plugin in module, i.e. Plate
+	Label string `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	// stored values of plugin params, key - p<param index>
+	Params        map[string]float64 `protobuf:"bytes,6,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PresetResponse) Reset() {
-	*x = PresetResponse{}
-	mi := &file_preset_proto_msgTypes[1]
+func (x *PresetFxDef) Reset() {
+	*x = PresetFxDef{}
+	mi := &file_preset_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PresetResponse) String() string {
+func (x *PresetFxDef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PresetResponse) ProtoMessage() {}
+func (*PresetFxDef) ProtoMessage() {}
 
-func (x *PresetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_preset_proto_msgTypes[1]
+func (x *PresetFxDef) ProtoReflect() protoreflect.Message {
+	mi := &file_preset_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -211,14 +1636,49 @@ func (x *PresetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PresetResponse.ProtoReflect.Descriptor instead.
-func (*PresetResponse) Descriptor() ([]byte, []int) {
-	return file_preset_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use PresetFxDef.ProtoReflect.Descriptor instead.
+func (*PresetFxDef) Descriptor() ([]byte, []int) {
+	return file_preset_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *PresetResponse) GetPreset() *Preset {
+func (x *PresetFxDef) GetKey() string {
 	if x != nil {
-		return x.Preset
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PresetFxDef) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *PresetFxDef) GetSystem() string {
+	if x != nil && x.System != nil {
+		return *x.System
+	}
+	return ""
+}
+
+func (x *PresetFxDef) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *PresetFxDef) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PresetFxDef) GetParams() map[string]float64 {
+	if x != nil {
+		return x.Params
 	}
 	return nil
 }
@@ -237,7 +1697,7 @@ type Preset struct {
 
 func (x *Preset) Reset() {
 	*x = Preset{}
-	mi := &file_preset_proto_msgTypes[2]
+	mi := &file_preset_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -249,7 +1709,7 @@ func (x *Preset) String() string {
 func (*Preset) ProtoMessage() {}
 
 func (x *Preset) ProtoReflect() protoreflect.Message {
-	mi := &file_preset_proto_msgTypes[2]
+	mi := &file_preset_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -262,7 +1722,7 @@ func (x *Preset) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Preset.ProtoReflect.Descriptor instead.
 func (*Preset) Descriptor() ([]byte, []int) {
-	return file_preset_proto_rawDescGZIP(), []int{2}
+	return file_preset_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *Preset) GetId() int64 {
@@ -302,21 +1762,28 @@ func (x *Preset) GetChannels() []*Channel {
 
 // Channel message
 type Channel struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type          ChannelType            `protobuf:"varint,3,opt,name=type,proto3,enum=kitPreset.v1.ChannelType" json:"type,omitempty"`
-	Volume        *BaseControl           `protobuf:"bytes,4,opt,name=volume,proto3" json:"volume,omitempty"`
-	Pan           *BaseControl           `protobuf:"bytes,5,opt,name=pan,proto3,oneof" json:"pan,omitempty"`
-	Fxs           []*FX                  `protobuf:"bytes,6,rep,name=fxs,proto3" json:"fxs,omitempty"`
-	Instruments   []*Instrument          `protobuf:"bytes,7,rep,name=instruments,proto3" json:"instruments,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Key         string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type        ChannelType            `protobuf:"varint,3,opt,name=type,proto3,enum=kitPreset.v1.ChannelType" json:"type,omitempty"`
+	Volume      *BaseControl           `protobuf:"bytes,4,opt,name=volume,proto3" json:"volume,omitempty"`
+	Pan         *BaseControl           `protobuf:"bytes,5,opt,name=pan,proto3,oneof" json:"pan,omitempty"`
+	Fxs         []*FX                  `protobuf:"bytes,6,rep,name=fxs,proto3" json:"fxs,omitempty"`
+	Instruments []*Instrument          `protobuf:"bytes,7,rep,name=instruments,proto3" json:"instruments,omitempty"`
+	// mute and solo are switched by ChannelControl: value 1 - on, 0 - off.
+	// Solo of channel, instrument or layer silences everything, which isn't soloed
+	Mute *BaseControl `protobuf:"bytes,8,opt,name=mute,proto3,oneof" json:"mute,omitempty"`
+	Solo *BaseControl `protobuf:"bytes,9,opt,name=solo,proto3,oneof" json:"solo,omitempty"`
+	// levels of FX sends of channel to effects of sampler channel, ordered by key.
+	// Address of send is <channel key>/<effect chain key>
+	Sends         []*BaseControl `protobuf:"bytes,10,rep,name=sends,proto3" json:"sends,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Channel) Reset() {
 	*x = Channel{}
-	mi := &file_preset_proto_msgTypes[3]
+	mi := &file_preset_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -328,7 +1795,7 @@ func (x *Channel) String() string {
 func (*Channel) ProtoMessage() {}
 
 func (x *Channel) ProtoReflect() protoreflect.Message {
-	mi := &file_preset_proto_msgTypes[3]
+	mi := &file_preset_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -341,7 +1808,7 @@ func (x *Channel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Channel.ProtoReflect.Descriptor instead.
 func (*Channel) Descriptor() ([]byte, []int) {
-	return file_preset_proto_rawDescGZIP(), []int{3}
+	return file_preset_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *Channel) GetKey() string {
@@ -393,22 +1860,46 @@ func (x *Channel) GetInstruments() []*Instrument {
 	return nil
 }
 
+func (x *Channel) GetMute() *BaseControl {
+	if x != nil {
+		return x.Mute
+	}
+	return nil
+}
+
+func (x *Channel) GetSolo() *BaseControl {
+	if x != nil {
+		return x.Solo
+	}
+	return nil
+}
+
+func (x *Channel) GetSends() []*BaseControl {
+	if x != nil {
+		return x.Sends
+	}
+	return nil
+}
+
 // Instrument message
 type Instrument struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Volume        *BaseControl           `protobuf:"bytes,3,opt,name=volume,proto3,oneof" json:"volume,omitempty"`
-	Pan           *BaseControl           `protobuf:"bytes,4,opt,name=pan,proto3,oneof" json:"pan,omitempty"`
-	Tunes         []*FX                  `protobuf:"bytes,5,rep,name=tunes,proto3" json:"tunes,omitempty"`
-	Layers        []*Layer               `protobuf:"bytes,6,rep,name=layers,proto3" json:"layers,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Key    string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Volume *BaseControl           `protobuf:"bytes,3,opt,name=volume,proto3,oneof" json:"volume,omitempty"`
+	Pan    *BaseControl           `protobuf:"bytes,4,opt,name=pan,proto3,oneof" json:"pan,omitempty"`
+	Tunes  []*FX                  `protobuf:"bytes,5,rep,name=tunes,proto3" json:"tunes,omitempty"`
+	Layers []*Layer               `protobuf:"bytes,6,rep,name=layers,proto3" json:"layers,omitempty"`
+	// absent if instrument has no volume regulated by MIDI CC
+	Mute          *BaseControl `protobuf:"bytes,7,opt,name=mute,proto3,oneof" json:"mute,omitempty"`
+	Solo          *BaseControl `protobuf:"bytes,8,opt,name=solo,proto3,oneof" json:"solo,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Instrument) Reset() {
 	*x = Instrument{}
-	mi := &file_preset_proto_msgTypes[4]
+	mi := &file_preset_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -420,7 +1911,7 @@ func (x *Instrument) String() string {
 func (*Instrument) ProtoMessage() {}
 
 func (x *Instrument) ProtoReflect() protoreflect.Message {
-	mi := &file_preset_proto_msgTypes[4]
+	mi := &file_preset_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -433,7 +1924,7 @@ func (x *Instrument) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Instrument.ProtoReflect.Descriptor instead.
 func (*Instrument) Descriptor() ([]byte, []int) {
-	return file_preset_proto_rawDescGZIP(), []int{4}
+	return file_preset_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *Instrument) GetKey() string {
@@ -478,21 +1969,38 @@ func (x *Instrument) GetLayers() []*Layer {
 	return nil
 }
 
+func (x *Instrument) GetMute() *BaseControl {
+	if x != nil {
+		return x.Mute
+	}
+	return nil
+}
+
+func (x *Instrument) GetSolo() *BaseControl {
+	if x != nil {
+		return x.Solo
+	}
+	return nil
+}
+
 // Layer message
 type Layer struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Volume        *BaseControl           `protobuf:"bytes,3,opt,name=volume,proto3,oneof" json:"volume,omitempty"`
-	Pan           *BaseControl           `protobuf:"bytes,4,opt,name=pan,proto3,oneof" json:"pan,omitempty"`
-	Fxs           []*FX                  `protobuf:"bytes,5,rep,name=fxs,proto3" json:"fxs,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Key    string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Volume *BaseControl           `protobuf:"bytes,3,opt,name=volume,proto3,oneof" json:"volume,omitempty"`
+	Pan    *BaseControl           `protobuf:"bytes,4,opt,name=pan,proto3,oneof" json:"pan,omitempty"`
+	Fxs    []*FX                  `protobuf:"bytes,5,rep,name=fxs,proto3" json:"fxs,omitempty"`
+	// absent if layer has no volume regulated by MIDI CC
+	Mute          *BaseControl `protobuf:"bytes,6,opt,name=mute,proto3,oneof" json:"mute,omitempty"`
+	Solo          *BaseControl `protobuf:"bytes,7,opt,name=solo,proto3,oneof" json:"solo,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Layer) Reset() {
 	*x = Layer{}
-	mi := &file_preset_proto_msgTypes[5]
+	mi := &file_preset_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -504,7 +2012,7 @@ func (x *Layer) String() string {
 func (*Layer) ProtoMessage() {}
 
 func (x *Layer) ProtoReflect() protoreflect.Message {
-	mi := &file_preset_proto_msgTypes[5]
+	mi := &file_preset_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -517,7 +2025,7 @@ func (x *Layer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Layer.ProtoReflect.Descriptor instead.
 func (*Layer) Descriptor() ([]byte, []int) {
-	return file_preset_proto_rawDescGZIP(), []int{5}
+	return file_preset_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *Layer) GetKey() string {
@@ -555,21 +2063,38 @@ func (x *Layer) GetFxs() []*FX {
 	return nil
 }
 
+func (x *Layer) GetMute() *BaseControl {
+	if x != nil {
+		return x.Mute
+	}
+	return nil
+}
+
+func (x *Layer) GetSolo() *BaseControl {
+	if x != nil {
+		return x.Solo
+	}
+	return nil
+}
+
 // Base control message
 type BaseControl struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Value         float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
-	Min           *float64               `protobuf:"fixed64,4,opt,name=min,proto3,oneof" json:"min,omitempty"`
-	Max           *float64               `protobuf:"fixed64,5,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	Min   *float64               `protobuf:"fixed64,4,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max   *float64               `protobuf:"fixed64,5,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	// stable address of control: <channel key>/[<instrument uid>/[<layer key>/]]<control key>.
+	// Unlike key, it doesn't change on adding or reordering of channels and instruments
+	Address       string `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BaseControl) Reset() {
 	*x = BaseControl{}
-	mi := &file_preset_proto_msgTypes[6]
+	mi := &file_preset_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -581,7 +2106,7 @@ func (x *BaseControl) String() string {
 func (*BaseControl) ProtoMessage() {}
 
 func (x *BaseControl) ProtoReflect() protoreflect.Message {
-	mi := &file_preset_proto_msgTypes[6]
+	mi := &file_preset_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,7 +2119,7 @@ func (x *BaseControl) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BaseControl.ProtoReflect.Descriptor instead.
 func (*BaseControl) Descriptor() ([]byte, []int) {
-	return file_preset_proto_rawDescGZIP(), []int{6}
+	return file_preset_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *BaseControl) GetKey() string {
@@ -632,6 +2157,13 @@ func (x *BaseControl) GetMax() float64 {
 	return 0
 }
 
+func (x *BaseControl) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
 // FX message
 type FX struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -645,7 +2177,7 @@ type FX struct {
 
 func (x *FX) Reset() {
 	*x = FX{}
-	mi := &file_preset_proto_msgTypes[7]
+	mi := &file_preset_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -657,7 +2189,7 @@ func (x *FX) String() string {
 func (*FX) ProtoMessage() {}
 
 func (x *FX) ProtoReflect() protoreflect.Message {
-	mi := &file_preset_proto_msgTypes[7]
+	mi := &file_preset_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,7 +2202,7 @@ func (x *FX) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FX.ProtoReflect.Descriptor instead.
 func (*FX) Descriptor() ([]byte, []int) {
-	return file_preset_proto_rawDescGZIP(), []int{7}
+	return file_preset_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *FX) GetKey() string {
@@ -703,23 +2235,25 @@ func (x *FX) GetParams() []*FXParam {
 
 // FX Parameter message
 type FXParam struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Order         int32                  `protobuf:"varint,3,opt,name=order,proto3" json:"order,omitempty"`
-	Type          FXParamType            `protobuf:"varint,4,opt,name=type,proto3,enum=kitPreset.v1.FXParamType" json:"type,omitempty"`
-	Min           *float64               `protobuf:"fixed64,5,opt,name=min,proto3,oneof" json:"min,omitempty"`
-	Max           *float64               `protobuf:"fixed64,6,opt,name=max,proto3,oneof" json:"max,omitempty"`
-	Divisions     *int32                 `protobuf:"varint,7,opt,name=divisions,proto3,oneof" json:"divisions,omitempty"`
-	DiscreteVals  []*FXParamDiscreteVal  `protobuf:"bytes,8,rep,name=discrete_vals,json=discreteVals,proto3" json:"discrete_vals,omitempty"`
-	Value         float64                `protobuf:"fixed64,9,opt,name=value,proto3" json:"value,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Key          string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Order        int32                  `protobuf:"varint,3,opt,name=order,proto3" json:"order,omitempty"`
+	Type         FXParamType            `protobuf:"varint,4,opt,name=type,proto3,enum=kitPreset.v1.FXParamType" json:"type,omitempty"`
+	Min          *float64               `protobuf:"fixed64,5,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max          *float64               `protobuf:"fixed64,6,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	Divisions    *int32                 `protobuf:"varint,7,opt,name=divisions,proto3,oneof" json:"divisions,omitempty"`
+	DiscreteVals []*FXParamDiscreteVal  `protobuf:"bytes,8,rep,name=discrete_vals,json=discreteVals,proto3" json:"discrete_vals,omitempty"`
+	Value        float64                `protobuf:"fixed64,9,opt,name=value,proto3" json:"value,omitempty"`
+	// stable address of param, see BaseControl.address
+	Address       string `protobuf:"bytes,10,opt,name=address,proto3" json:"address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FXParam) Reset() {
 	*x = FXParam{}
-	mi := &file_preset_proto_msgTypes[8]
+	mi := &file_preset_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -731,7 +2265,7 @@ func (x *FXParam) String() string {
 func (*FXParam) ProtoMessage() {}
 
 func (x *FXParam) ProtoReflect() protoreflect.Message {
-	mi := &file_preset_proto_msgTypes[8]
+	mi := &file_preset_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -744,7 +2278,7 @@ func (x *FXParam) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FXParam.ProtoReflect.Descriptor instead.
 func (*FXParam) Descriptor() ([]byte, []int) {
-	return file_preset_proto_rawDescGZIP(), []int{8}
+	return file_preset_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *FXParam) GetKey() string {
@@ -810,6 +2344,13 @@ func (x *FXParam) GetValue() float64 {
 	return 0
 }
 
+func (x *FXParam) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
 // FX Parameter Discrete Value message
 type FXParamDiscreteVal struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -821,7 +2362,7 @@ type FXParamDiscreteVal struct {
 
 func (x *FXParamDiscreteVal) Reset() {
 	*x = FXParamDiscreteVal{}
-	mi := &file_preset_proto_msgTypes[9]
+	mi := &file_preset_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -833,7 +2374,7 @@ func (x *FXParamDiscreteVal) String() string {
 func (*FXParamDiscreteVal) ProtoMessage() {}
 
 func (x *FXParamDiscreteVal) ProtoReflect() protoreflect.Message {
-	mi := &file_preset_proto_msgTypes[9]
+	mi := &file_preset_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,7 +2387,7 @@ func (x *FXParamDiscreteVal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FXParamDiscreteVal.ProtoReflect.Descriptor instead.
 func (*FXParamDiscreteVal) Descriptor() ([]byte, []int) {
-	return file_preset_proto_rawDescGZIP(), []int{9}
+	return file_preset_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *FXParamDiscreteVal) GetName() string {
@@ -874,38 +2415,262 @@ var file_preset_proto_rawDesc = string([]byte{
 	0x0e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x2c, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x14, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x22, 0xa8, 0x01,
-	0x0a, 0x06, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65,
-	0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xab, 0x02, 0x0a, 0x07, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72,
-	0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x76, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x69, 0x74, 0x50,
-	0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x03,
-	0x70, 0x61, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x69, 0x74, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x22, 0x7d, 0x0a,
+	0x12, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2c,
+	0x0a, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x52, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x22, 0x46, 0x0a, 0x13,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x44, 0x65, 0x66, 0x52, 0x06, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x22, 0x63, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x44, 0x65,
+	0x66, 0x52, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x22, 0x46, 0x0a, 0x13, 0x52, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x45, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x32, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x29, 0x0a, 0x13, 0x53, 0x61, 0x76,
+	0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x41, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x36, 0x0a, 0x15, 0x50, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x09, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x49, 0x64, 0x73, 0x22, 0x37, 0x0a, 0x16,
+	0x50, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6e, 0x0a, 0x0b, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a,
+	0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x52, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x84, 0x02, 0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x69,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x44, 0x0a, 0x0d, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6b, 0x69,
+	0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x56, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x66, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x09, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x44, 0x65, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x6b, 0x69, 0x74, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6b, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x44, 0x65, 0x66, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12,
+	0x43, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x03, 0x66, 0x78, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x46, 0x78, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x44, 0x65,
+	0x66, 0x52, 0x03, 0x66, 0x78, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x48, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x44, 0x65, 0x66, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x1a, 0x5b, 0x0a, 0x0d, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44, 0x65, 0x66, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe8, 0x03, 0x0a, 0x13, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66,
+	0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x08,
+	0x6d, 0x69, 0x64, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x07, 0x6d, 0x69, 0x64, 0x69, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65,
+	0x66, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x69, 0x74, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x2e, 0x4c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x1a, 0x5b, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44,
+	0x65, 0x66, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x57, 0x0a,
+	0x0b, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x65, 0x66, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x69, 0x64, 0x69, 0x5f,
+	0x6b, 0x65, 0x79, 0x22, 0x84, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x4c, 0x61,
+	0x79, 0x65, 0x72, 0x44, 0x65, 0x66, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x1e, 0x0a, 0x08, 0x6d, 0x69, 0x64, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x69, 0x64, 0x69, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12,
+	0x46, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x65, 0x66, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x1a, 0x5b, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6b, 0x69, 0x74, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44, 0x65, 0x66, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x6d, 0x69, 0x64, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x88, 0x01, 0x0a, 0x10, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44, 0x65, 0x66, 0x12,
+	0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x07,
+	0x6d, 0x69, 0x64, 0x69, 0x5f, 0x63, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52,
+	0x06, 0x6d, 0x69, 0x64, 0x69, 0x43, 0x63, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69,
+	0x64, 0x69, 0x5f, 0x63, 0x63, 0x22, 0x7b, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x46,
+	0x78, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x46, 0x78, 0x44, 0x65, 0x66,
+	0x52, 0x07, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x91, 0x02, 0x0a, 0x0b, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x46, 0x78, 0x44,
+	0x65, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
+	0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x46, 0x78,
+	0x44, 0x65, 0x66, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0xa8, 0x01, 0x0a, 0x06, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x31,
+	0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xd6, 0x03, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x06, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x03, 0x70, 0x61, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x00, 0x52,
+	0x03, 0x70, 0x61, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x03, 0x66, 0x78, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x58, 0x52, 0x03, 0x66, 0x78, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x69,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x48, 0x01, 0x52, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x04, 0x73,
+	0x6f, 0x6c, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x69, 0x74, 0x50,
 	0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x03, 0x70, 0x61, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x22,
-	0x0a, 0x03, 0x66, 0x78, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69,
-	0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x58, 0x52, 0x03, 0x66,
-	0x78, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65,
-	0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x06,
-	0x0a, 0x04, 0x5f, 0x70, 0x61, 0x6e, 0x22, 0x84, 0x02, 0x0a, 0x0a, 0x49, 0x6e, 0x73, 0x74, 0x72,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x74, 0x72, 0x6f, 0x6c, 0x48, 0x02, 0x52, 0x04, 0x73, 0x6f, 0x6c, 0x6f, 0x88, 0x01, 0x01, 0x12,
+	0x2f, 0x0a, 0x05, 0x73, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x05, 0x73, 0x65, 0x6e, 0x64, 0x73,
+	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x70, 0x61, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x75, 0x74,
+	0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6f, 0x6c, 0x6f, 0x22, 0xfe, 0x02, 0x0a, 0x0a, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x36, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x06, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x03, 0x70, 0x61, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48,
+	0x01, 0x52, 0x03, 0x70, 0x61, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x05, 0x74, 0x75, 0x6e,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x58, 0x52, 0x05, 0x74, 0x75, 0x6e, 0x65,
+	0x73, 0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x32,
+	0x0a, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b,
+	0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x02, 0x52, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x32, 0x0a, 0x04, 0x73, 0x6f, 0x6c, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x03, 0x52, 0x04, 0x73,
+	0x6f, 0x6c, 0x6f, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x70, 0x61, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x75,
+	0x74, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6f, 0x6c, 0x6f, 0x22, 0xc8, 0x02, 0x0a, 0x05,
+	0x4c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x76,
 	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x69,
@@ -914,99 +2679,164 @@ var file_preset_proto_rawDesc = string([]byte{
 	0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x03, 0x70, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x19, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
 	0x42, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x01, 0x52, 0x03, 0x70,
-	0x61, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x05, 0x74, 0x75, 0x6e, 0x65, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x58, 0x52, 0x05, 0x74, 0x75, 0x6e, 0x65, 0x73, 0x12, 0x2b, 0x0a,
-	0x06, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x79,
-	0x65, 0x72, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x76,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x70, 0x61, 0x6e, 0x22, 0xce, 0x01,
-	0x0a, 0x05, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x61, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x03, 0x66, 0x78, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x58, 0x52, 0x03, 0x66, 0x78, 0x73, 0x12, 0x32, 0x0a, 0x04, 0x6d, 0x75, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x48, 0x02, 0x52, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a,
+	0x04, 0x73, 0x6f, 0x6c, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x69,
+	0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x03, 0x52, 0x04, 0x73, 0x6f, 0x6c, 0x6f, 0x88, 0x01,
+	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x06, 0x0a, 0x04,
+	0x5f, 0x70, 0x61, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x75, 0x74, 0x65, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x73, 0x6f, 0x6c, 0x6f, 0x22, 0xa1, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x73, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x00, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x78,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x88, 0x01, 0x01,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d,
+	0x69, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x78, 0x22, 0x6f, 0x0a, 0x02, 0x46, 0x58,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6b,
+	0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x58, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xda, 0x02, 0x0a, 0x07,
+	0x46, 0x58, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a,
-	0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x03, 0x70, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x01, 0x52,
-	0x03, 0x70, 0x61, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x03, 0x66, 0x78, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x58, 0x52, 0x03, 0x66, 0x78, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x70, 0x61, 0x6e, 0x22, 0x87,
-	0x01, 0x0a, 0x0b, 0x42, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x69,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x88, 0x01,
-	0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01,
-	0x52, 0x03, 0x6d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x69, 0x6e,
-	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x78, 0x22, 0x6f, 0x0a, 0x02, 0x46, 0x58, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x06, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6b, 0x69, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x58, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x00, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x78,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x88, 0x01, 0x01,
+	0x12, 0x21, 0x0a, 0x09, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x09, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x74, 0x65, 0x5f,
+	0x76, 0x61, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x69, 0x74,
 	0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x58, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xc0, 0x02, 0x0a, 0x07, 0x46, 0x58,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x19, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46,
-	0x58, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x15, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52,
-	0x03, 0x6d, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x12, 0x21,
-	0x0a, 0x09, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x05, 0x48, 0x02, 0x52, 0x09, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01,
-	0x01, 0x12, 0x45, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x74, 0x65, 0x5f, 0x76, 0x61,
-	0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72,
-	0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x58, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x44,
-	0x69, 0x73, 0x63, 0x72, 0x65, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63,
-	0x72, 0x65, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x06,
-	0x0a, 0x04, 0x5f, 0x6d, 0x69, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x78, 0x42, 0x0c,
-	0x0a, 0x0a, 0x5f, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x48, 0x0a, 0x12,
-	0x46, 0x58, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x74, 0x65, 0x56,
-	0x61, 0x6c, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x10, 0x0a, 0x03, 0x76,
-	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x42, 0x07, 0x0a,
-	0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2a, 0xac, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45,
-	0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x18, 0x0a,
-	0x14, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x41,
-	0x4d, 0x50, 0x4c, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x4e, 0x4e,
-	0x45, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x4d, 0x45,
-	0x4e, 0x54, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x49, 0x58, 0x45, 0x52, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13,
-	0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4c, 0x41,
-	0x59, 0x45, 0x52, 0x10, 0x05, 0x2a, 0x79, 0x0a, 0x0b, 0x46, 0x58, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x46, 0x58, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x58, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13,
-	0x46, 0x58, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49,
-	0x58, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x46, 0x58, 0x5f, 0x50, 0x41, 0x52, 0x41,
-	0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4f, 0x4f, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x03,
-	0x32, 0xa2, 0x01, 0x0a, 0x09, 0x4b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x4a,
-	0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x6b,
-	0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6b,
-	0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x73,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x09, 0x47, 0x65,
+	0x6d, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x52, 0x0c, 0x64, 0x69,
+	0x73, 0x63, 0x72, 0x65, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d,
+	0x69, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x78, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64,
+	0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x48, 0x0a, 0x12, 0x46, 0x58, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x12, 0x17,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x2a, 0x54, 0x0a, 0x0f, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x52, 0x45, 0x53, 0x45, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x52, 0x45, 0x53,
+	0x45, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45,
+	0x53, 0x54, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x75, 0x0a, 0x11, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a,
+	0x1f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x49, 0x54, 0x10, 0x01, 0x12,
+	0x1e, 0x0a, 0x1a, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x53, 0x10, 0x02, 0x2a,
+	0xac, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1c, 0x0a, 0x18, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a,
+	0x13, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x4c,
+	0x4f, 0x42, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45,
+	0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x52, 0x10, 0x02,
+	0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x49, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x16, 0x0a,
+	0x12, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x49,
+	0x58, 0x45, 0x52, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x10, 0x05, 0x2a, 0x79,
+	0x0a, 0x0b, 0x46, 0x58, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a,
+	0x19, 0x46, 0x58, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+	0x46, 0x58, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x41,
+	0x4e, 0x47, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x58, 0x5f, 0x50, 0x41, 0x52, 0x41,
+	0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19,
+	0x0a, 0x15, 0x46, 0x58, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x42, 0x4f, 0x4f, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x03, 0x32, 0xc1, 0x08, 0x0a, 0x09, 0x4b, 0x69,
+	0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x4a, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0f, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x1e, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x09, 0x47, 0x65,
 	0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65,
 	0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65,
 	0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x73, 0x70, 0x69, 0x64, 0x72, 0x75, 0x6d, 0x2d, 0x73, 0x72,
-	0x76, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x21, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x21, 0x2e, 0x6b, 0x69, 0x74, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6b,
+	0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a,
+	0x0c, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x21, 0x2e,
+	0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x50, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x12, 0x20, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x12, 0x21, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0a, 0x53, 0x61,
+	0x76, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6b, 0x69, 0x74, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0c, 0x53, 0x61,
+	0x76, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x41, 0x73, 0x12, 0x21, 0x2e, 0x6b, 0x69, 0x74,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x41, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b,
+	0x0a, 0x0e, 0x50, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73,
+	0x12, 0x23, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x20, 0x2e, 0x6b, 0x69, 0x74,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6b,
+	0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x52, 0x0a, 0x0d, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x22, 0x2e, 0x6b, 0x69, 0x74,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x6b, 0x69, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x30, 0x01, 0x42, 0x23, 0x5a,
+	0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x73, 0x70,
+	0x69, 0x64, 0x72, 0x75, 0x6d, 0x2d, 0x73, 0x72, 0x76, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72,
+	0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -1021,49 +2851,129 @@ func file_preset_proto_rawDescGZIP() []byte {
 	return file_preset_proto_rawDescData
 }
 
-var file_preset_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_preset_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_preset_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_preset_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_preset_proto_goTypes = []any{
-	(ChannelType)(0),           // 0: kitPreset.v1.ChannelType
-	(FXParamType)(0),           // 1: kitPreset.v1.FXParamType
-	(*GetPresetRequest)(nil),   // 2: kitPreset.v1.GetPresetRequest
-	(*PresetResponse)(nil),     // 3: kitPreset.v1.PresetResponse
-	(*Preset)(nil),             // 4: kitPreset.v1.Preset
-	(*Channel)(nil),            // 5: kitPreset.v1.Channel
-	(*Instrument)(nil),         // 6: kitPreset.v1.Instrument
-	(*Layer)(nil),              // 7: kitPreset.v1.Layer
-	(*BaseControl)(nil),        // 8: kitPreset.v1.BaseControl
-	(*FX)(nil),                 // 9: kitPreset.v1.FX
-	(*FXParam)(nil),            // 10: kitPreset.v1.FXParam
-	(*FXParamDiscreteVal)(nil), // 11: kitPreset.v1.FXParamDiscreteVal
+	(PresetEventType)(0),           // 0: kitPreset.v1.PresetEventType
+	(ActivityEventType)(0),         // 1: kitPreset.v1.ActivityEventType
+	(ChannelType)(0),               // 2: kitPreset.v1.ChannelType
+	(FXParamType)(0),               // 3: kitPreset.v1.FXParamType
+	(*GetPresetRequest)(nil),       // 4: kitPreset.v1.GetPresetRequest
+	(*PresetResponse)(nil),         // 5: kitPreset.v1.PresetResponse
+	(*LoadPresetProgress)(nil),     // 6: kitPreset.v1.LoadPresetProgress
+	(*CreatePresetRequest)(nil),    // 7: kitPreset.v1.CreatePresetRequest
+	(*UpdatePresetRequest)(nil),    // 8: kitPreset.v1.UpdatePresetRequest
+	(*RenamePresetRequest)(nil),    // 9: kitPreset.v1.RenamePresetRequest
+	(*ClonePresetRequest)(nil),     // 10: kitPreset.v1.ClonePresetRequest
+	(*DeletePresetRequest)(nil),    // 11: kitPreset.v1.DeletePresetRequest
+	(*DeletePresetResponse)(nil),   // 12: kitPreset.v1.DeletePresetResponse
+	(*SavePresetRequest)(nil),      // 13: kitPreset.v1.SavePresetRequest
+	(*SavePresetAsRequest)(nil),    // 14: kitPreset.v1.SavePresetAsRequest
+	(*PreloadPresetsRequest)(nil),  // 15: kitPreset.v1.PreloadPresetsRequest
+	(*PreloadPresetsResponse)(nil), // 16: kitPreset.v1.PreloadPresetsResponse
+	(*WatchPresetRequest)(nil),     // 17: kitPreset.v1.WatchPresetRequest
+	(*PresetEvent)(nil),            // 18: kitPreset.v1.PresetEvent
+	(*WatchActivityRequest)(nil),   // 19: kitPreset.v1.WatchActivityRequest
+	(*ActivityEvent)(nil),          // 20: kitPreset.v1.ActivityEvent
+	(*ActivityFrame)(nil),          // 21: kitPreset.v1.ActivityFrame
+	(*PresetRefResponse)(nil),      // 22: kitPreset.v1.PresetRefResponse
+	(*PresetDef)(nil),              // 23: kitPreset.v1.PresetDef
+	(*PresetChannelDef)(nil),       // 24: kitPreset.v1.PresetChannelDef
+	(*PresetInstrumentDef)(nil),    // 25: kitPreset.v1.PresetInstrumentDef
+	(*PresetLayerDef)(nil),         // 26: kitPreset.v1.PresetLayerDef
+	(*PresetControlDef)(nil),       // 27: kitPreset.v1.PresetControlDef
+	(*PresetFxChainDef)(nil),       // 28: kitPreset.v1.PresetFxChainDef
+	(*PresetFxDef)(nil),            // 29: kitPreset.v1.PresetFxDef
+	(*Preset)(nil),                 // 30: kitPreset.v1.Preset
+	(*Channel)(nil),                // 31: kitPreset.v1.Channel
+	(*Instrument)(nil),             // 32: kitPreset.v1.Instrument
+	(*Layer)(nil),                  // 33: kitPreset.v1.Layer
+	(*BaseControl)(nil),            // 34: kitPreset.v1.BaseControl
+	(*FX)(nil),                     // 35: kitPreset.v1.FX
+	(*FXParam)(nil),                // 36: kitPreset.v1.FXParam
+	(*FXParamDiscreteVal)(nil),     // 37: kitPreset.v1.FXParamDiscreteVal
+	nil,                            // 38: kitPreset.v1.PresetChannelDef.ControlsEntry
+	nil,                            // 39: kitPreset.v1.PresetInstrumentDef.ControlsEntry
+	nil,                            // 40: kitPreset.v1.PresetInstrumentDef.LayersEntry
+	nil,                            // 41: kitPreset.v1.PresetLayerDef.ControlsEntry
+	nil,                            // 42: kitPreset.v1.PresetFxDef.ParamsEntry
 }
 var file_preset_proto_depIdxs = []int32{
-	4,  // 0: kitPreset.v1.PresetResponse.preset:type_name -> kitPreset.v1.Preset
-	5,  // 1: kitPreset.v1.Preset.channels:type_name -> kitPreset.v1.Channel
-	0,  // 2: kitPreset.v1.Channel.type:type_name -> kitPreset.v1.ChannelType
-	8,  // 3: kitPreset.v1.Channel.volume:type_name -> kitPreset.v1.BaseControl
-	8,  // 4: kitPreset.v1.Channel.pan:type_name -> kitPreset.v1.BaseControl
-	9,  // 5: kitPreset.v1.Channel.fxs:type_name -> kitPreset.v1.FX
-	6,  // 6: kitPreset.v1.Channel.instruments:type_name -> kitPreset.v1.Instrument
-	8,  // 7: kitPreset.v1.Instrument.volume:type_name -> kitPreset.v1.BaseControl
-	8,  // 8: kitPreset.v1.Instrument.pan:type_name -> kitPreset.v1.BaseControl
-	9,  // 9: kitPreset.v1.Instrument.tunes:type_name -> kitPreset.v1.FX
-	7,  // 10: kitPreset.v1.Instrument.layers:type_name -> kitPreset.v1.Layer
-	8,  // 11: kitPreset.v1.Layer.volume:type_name -> kitPreset.v1.BaseControl
-	8,  // 12: kitPreset.v1.Layer.pan:type_name -> kitPreset.v1.BaseControl
-	9,  // 13: kitPreset.v1.Layer.fxs:type_name -> kitPreset.v1.FX
-	10, // 14: kitPreset.v1.FX.params:type_name -> kitPreset.v1.FXParam
-	1,  // 15: kitPreset.v1.FXParam.type:type_name -> kitPreset.v1.FXParamType
-	11, // 16: kitPreset.v1.FXParam.discrete_vals:type_name -> kitPreset.v1.FXParamDiscreteVal
-	2,  // 17: kitPreset.v1.KitPreset.LoadPreset:input_type -> kitPreset.v1.GetPresetRequest
-	2,  // 18: kitPreset.v1.KitPreset.GetPreset:input_type -> kitPreset.v1.GetPresetRequest
-	3,  // 19: kitPreset.v1.KitPreset.LoadPreset:output_type -> kitPreset.v1.PresetResponse
-	3,  // 20: kitPreset.v1.KitPreset.GetPreset:output_type -> kitPreset.v1.PresetResponse
-	19, // [19:21] is the sub-list for method output_type
-	17, // [17:19] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	30, // 0: kitPreset.v1.PresetResponse.preset:type_name -> kitPreset.v1.Preset
+	30, // 1: kitPreset.v1.LoadPresetProgress.preset:type_name -> kitPreset.v1.Preset
+	23, // 2: kitPreset.v1.CreatePresetRequest.preset:type_name -> kitPreset.v1.PresetDef
+	23, // 3: kitPreset.v1.UpdatePresetRequest.preset:type_name -> kitPreset.v1.PresetDef
+	0,  // 4: kitPreset.v1.PresetEvent.type:type_name -> kitPreset.v1.PresetEventType
+	30, // 5: kitPreset.v1.PresetEvent.preset:type_name -> kitPreset.v1.Preset
+	1,  // 6: kitPreset.v1.ActivityEvent.type:type_name -> kitPreset.v1.ActivityEventType
+	20, // 7: kitPreset.v1.ActivityFrame.events:type_name -> kitPreset.v1.ActivityEvent
+	24, // 8: kitPreset.v1.PresetDef.channels:type_name -> kitPreset.v1.PresetChannelDef
+	25, // 9: kitPreset.v1.PresetDef.instruments:type_name -> kitPreset.v1.PresetInstrumentDef
+	28, // 10: kitPreset.v1.PresetDef.fxs:type_name -> kitPreset.v1.PresetFxChainDef
+	38, // 11: kitPreset.v1.PresetChannelDef.controls:type_name -> kitPreset.v1.PresetChannelDef.ControlsEntry
+	39, // 12: kitPreset.v1.PresetInstrumentDef.controls:type_name -> kitPreset.v1.PresetInstrumentDef.ControlsEntry
+	40, // 13: kitPreset.v1.PresetInstrumentDef.layers:type_name -> kitPreset.v1.PresetInstrumentDef.LayersEntry
+	41, // 14: kitPreset.v1.PresetLayerDef.controls:type_name -> kitPreset.v1.PresetLayerDef.ControlsEntry
+	29, // 15: kitPreset.v1.PresetFxChainDef.effects:type_name -> kitPreset.v1.PresetFxDef
+	42, // 16: kitPreset.v1.PresetFxDef.params:type_name -> kitPreset.v1.PresetFxDef.ParamsEntry
+	31, // 17: kitPreset.v1.Preset.channels:type_name -> kitPreset.v1.Channel
+	2,  // 18: kitPreset.v1.Channel.type:type_name -> kitPreset.v1.ChannelType
+	34, // 19: kitPreset.v1.Channel.volume:type_name -> kitPreset.v1.BaseControl
+	34, // 20: kitPreset.v1.Channel.pan:type_name -> kitPreset.v1.BaseControl
+	35, // 21: kitPreset.v1.Channel.fxs:type_name -> kitPreset.v1.FX
+	32, // 22: kitPreset.v1.Channel.instruments:type_name -> kitPreset.v1.Instrument
+	34, // 23: kitPreset.v1.Channel.mute:type_name -> kitPreset.v1.BaseControl
+	34, // 24: kitPreset.v1.Channel.solo:type_name -> kitPreset.v1.BaseControl
+	34, // 25: kitPreset.v1.Channel.sends:type_name -> kitPreset.v1.BaseControl
+	34, // 26: kitPreset.v1.Instrument.volume:type_name -> kitPreset.v1.BaseControl
+	34, // 27: kitPreset.v1.Instrument.pan:type_name -> kitPreset.v1.BaseControl
+	35, // 28: kitPreset.v1.Instrument.tunes:type_name -> kitPreset.v1.FX
+	33, // 29: kitPreset.v1.Instrument.layers:type_name -> kitPreset.v1.Layer
+	34, // 30: kitPreset.v1.Instrument.mute:type_name -> kitPreset.v1.BaseControl
+	34, // 31: kitPreset.v1.Instrument.solo:type_name -> kitPreset.v1.BaseControl
+	34, // 32: kitPreset.v1.Layer.volume:type_name -> kitPreset.v1.BaseControl
+	34, // 33: kitPreset.v1.Layer.pan:type_name -> kitPreset.v1.BaseControl
+	35, // 34: kitPreset.v1.Layer.fxs:type_name -> kitPreset.v1.FX
+	34, // 35: kitPreset.v1.Layer.mute:type_name -> kitPreset.v1.BaseControl
+	34, // 36: kitPreset.v1.Layer.solo:type_name -> kitPreset.v1.BaseControl
+	36, // 37: kitPreset.v1.FX.params:type_name -> kitPreset.v1.FXParam
+	3,  // 38: kitPreset.v1.FXParam.type:type_name -> kitPreset.v1.FXParamType
+	37, // 39: kitPreset.v1.FXParam.discrete_vals:type_name -> kitPreset.v1.FXParamDiscreteVal
+	27, // 40: kitPreset.v1.PresetChannelDef.ControlsEntry.value:type_name -> kitPreset.v1.PresetControlDef
+	27, // 41: kitPreset.v1.PresetInstrumentDef.ControlsEntry.value:type_name -> kitPreset.v1.PresetControlDef
+	26, // 42: kitPreset.v1.PresetInstrumentDef.LayersEntry.value:type_name -> kitPreset.v1.PresetLayerDef
+	27, // 43: kitPreset.v1.PresetLayerDef.ControlsEntry.value:type_name -> kitPreset.v1.PresetControlDef
+	4,  // 44: kitPreset.v1.KitPreset.LoadPreset:input_type -> kitPreset.v1.GetPresetRequest
+	4,  // 45: kitPreset.v1.KitPreset.LoadPresetAsync:input_type -> kitPreset.v1.GetPresetRequest
+	4,  // 46: kitPreset.v1.KitPreset.GetPreset:input_type -> kitPreset.v1.GetPresetRequest
+	7,  // 47: kitPreset.v1.KitPreset.CreatePreset:input_type -> kitPreset.v1.CreatePresetRequest
+	8,  // 48: kitPreset.v1.KitPreset.UpdatePreset:input_type -> kitPreset.v1.UpdatePresetRequest
+	9,  // 49: kitPreset.v1.KitPreset.RenamePreset:input_type -> kitPreset.v1.RenamePresetRequest
+	10, // 50: kitPreset.v1.KitPreset.ClonePreset:input_type -> kitPreset.v1.ClonePresetRequest
+	11, // 51: kitPreset.v1.KitPreset.DeletePreset:input_type -> kitPreset.v1.DeletePresetRequest
+	13, // 52: kitPreset.v1.KitPreset.SavePreset:input_type -> kitPreset.v1.SavePresetRequest
+	14, // 53: kitPreset.v1.KitPreset.SavePresetAs:input_type -> kitPreset.v1.SavePresetAsRequest
+	15, // 54: kitPreset.v1.KitPreset.PreloadPresets:input_type -> kitPreset.v1.PreloadPresetsRequest
+	17, // 55: kitPreset.v1.KitPreset.WatchPreset:input_type -> kitPreset.v1.WatchPresetRequest
+	19, // 56: kitPreset.v1.KitPreset.WatchActivity:input_type -> kitPreset.v1.WatchActivityRequest
+	5,  // 57: kitPreset.v1.KitPreset.LoadPreset:output_type -> kitPreset.v1.PresetResponse
+	6,  // 58: kitPreset.v1.KitPreset.LoadPresetAsync:output_type -> kitPreset.v1.LoadPresetProgress
+	5,  // 59: kitPreset.v1.KitPreset.GetPreset:output_type -> kitPreset.v1.PresetResponse
+	22, // 60: kitPreset.v1.KitPreset.CreatePreset:output_type -> kitPreset.v1.PresetRefResponse
+	22, // 61: kitPreset.v1.KitPreset.UpdatePreset:output_type -> kitPreset.v1.PresetRefResponse
+	22, // 62: kitPreset.v1.KitPreset.RenamePreset:output_type -> kitPreset.v1.PresetRefResponse
+	22, // 63: kitPreset.v1.KitPreset.ClonePreset:output_type -> kitPreset.v1.PresetRefResponse
+	12, // 64: kitPreset.v1.KitPreset.DeletePreset:output_type -> kitPreset.v1.DeletePresetResponse
+	22, // 65: kitPreset.v1.KitPreset.SavePreset:output_type -> kitPreset.v1.PresetRefResponse
+	22, // 66: kitPreset.v1.KitPreset.SavePresetAs:output_type -> kitPreset.v1.PresetRefResponse
+	16, // 67: kitPreset.v1.KitPreset.PreloadPresets:output_type -> kitPreset.v1.PreloadPresetsResponse
+	18, // 68: kitPreset.v1.KitPreset.WatchPreset:output_type -> kitPreset.v1.PresetEvent
+	21, // 69: kitPreset.v1.KitPreset.WatchActivity:output_type -> kitPreset.v1.ActivityFrame
+	57, // [57:70] is the sub-list for method output_type
+	44, // [44:57] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_preset_proto_init() }
@@ -1071,20 +2981,25 @@ func file_preset_proto_init() {
 	if File_preset_proto != nil {
 		return
 	}
-	file_preset_proto_msgTypes[2].OneofWrappers = []any{}
-	file_preset_proto_msgTypes[3].OneofWrappers = []any{}
-	file_preset_proto_msgTypes[4].OneofWrappers = []any{}
-	file_preset_proto_msgTypes[5].OneofWrappers = []any{}
-	file_preset_proto_msgTypes[6].OneofWrappers = []any{}
-	file_preset_proto_msgTypes[8].OneofWrappers = []any{}
-	file_preset_proto_msgTypes[9].OneofWrappers = []any{}
+	file_preset_proto_msgTypes[21].OneofWrappers = []any{}
+	file_preset_proto_msgTypes[22].OneofWrappers = []any{}
+	file_preset_proto_msgTypes[23].OneofWrappers = []any{}
+	file_preset_proto_msgTypes[24].OneofWrappers = []any{}
+	file_preset_proto_msgTypes[25].OneofWrappers = []any{}
+	file_preset_proto_msgTypes[26].OneofWrappers = []any{}
+	file_preset_proto_msgTypes[27].OneofWrappers = []any{}
+	file_preset_proto_msgTypes[28].OneofWrappers = []any{}
+	file_preset_proto_msgTypes[29].OneofWrappers = []any{}
+	file_preset_proto_msgTypes[30].OneofWrappers = []any{}
+	file_preset_proto_msgTypes[32].OneofWrappers = []any{}
+	file_preset_proto_msgTypes[33].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_preset_proto_rawDesc), len(file_preset_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   10,
+			NumEnums:      4,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,8 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	KitPreset_LoadPreset_FullMethodName = "/kitPreset.v1.KitPreset/LoadPreset"
-	KitPreset_GetPreset_FullMethodName  = "/kitPreset.v1.KitPreset/GetPreset"
+	KitPreset_LoadPreset_FullMethodName      = "/kitPreset.v1.KitPreset/LoadPreset"
+	KitPreset_LoadPresetAsync_FullMethodName = "/kitPreset.v1.KitPreset/LoadPresetAsync"
+	KitPreset_GetPreset_FullMethodName       = "/kitPreset.v1.KitPreset/GetPreset"
+	KitPreset_CreatePreset_FullMethodName    = "/kitPreset.v1.KitPreset/CreatePreset"
+	KitPreset_UpdatePreset_FullMethodName    = "/kitPreset.v1.KitPreset/UpdatePreset"
+	KitPreset_RenamePreset_FullMethodName    = "/kitPreset.v1.KitPreset/RenamePreset"
+	KitPreset_ClonePreset_FullMethodName     = "/kitPreset.v1.KitPreset/ClonePreset"
+	KitPreset_DeletePreset_FullMethodName    = "/kitPreset.v1.KitPreset/DeletePreset"
+	KitPreset_SavePreset_FullMethodName      = "/kitPreset.v1.KitPreset/SavePreset"
+	KitPreset_SavePresetAs_FullMethodName    = "/kitPreset.v1.KitPreset/SavePresetAs"
+	KitPreset_PreloadPresets_FullMethodName  = "/kitPreset.v1.KitPreset/PreloadPresets"
+	KitPreset_WatchPreset_FullMethodName     = "/kitPreset.v1.KitPreset/WatchPreset"
+	KitPreset_WatchActivity_FullMethodName   = "/kitPreset.v1.KitPreset/WatchActivity"
 )
 
 // KitPresetClient is the client API for KitPreset service.
@@ -28,7 +39,29 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type KitPresetClient interface {
 	LoadPreset(ctx context.Context, in *GetPresetRequest, opts ...grpc.CallOption) (*PresetResponse, error)
+	// Loads preset like LoadPreset and streams loading progress of preset channels.
+	// The last message contains the loaded preset. Canceling the call removes half-loaded channels
+	// and keeps the previous preset loaded
+	LoadPresetAsync(ctx context.Context, in *GetPresetRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LoadPresetProgress], error)
 	GetPreset(ctx context.Context, in *GetPresetRequest, opts ...grpc.CallOption) (*PresetResponse, error)
+	CreatePreset(ctx context.Context, in *CreatePresetRequest, opts ...grpc.CallOption) (*PresetRefResponse, error)
+	UpdatePreset(ctx context.Context, in *UpdatePresetRequest, opts ...grpc.CallOption) (*PresetRefResponse, error)
+	RenamePreset(ctx context.Context, in *RenamePresetRequest, opts ...grpc.CallOption) (*PresetRefResponse, error)
+	ClonePreset(ctx context.Context, in *ClonePresetRequest, opts ...grpc.CallOption) (*PresetRefResponse, error)
+	DeletePreset(ctx context.Context, in *DeletePresetRequest, opts ...grpc.CallOption) (*DeletePresetResponse, error)
+	// Stores current control values of the loaded preset
+	SavePreset(ctx context.Context, in *SavePresetRequest, opts ...grpc.CallOption) (*PresetRefResponse, error)
+	// Stores the loaded preset with current control values as a new preset. The new preset becomes the loaded one
+	SavePresetAs(ctx context.Context, in *SavePresetAsRequest, opts ...grpc.CallOption) (*PresetRefResponse, error)
+	// Loads presets into muted sampler channels, so LoadPreset switches to them without loading samples.
+	// Presets are preloaded in the request order. Presets, which don't fit the preload budget, are skipped.
+	// Least recently used preloaded presets, which aren't in the request, are released to fit the budget
+	PreloadPresets(ctx context.Context, in *PreloadPresetsRequest, opts ...grpc.CallOption) (*PreloadPresetsResponse, error)
+	// Streams events of the loaded preset, i.e. its restoring after sampler restart
+	WatchPreset(ctx context.Context, in *WatchPresetRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PresetEvent], error)
+	// Streams hits of instruments and voice counts of channels of the loaded preset, i.e. to flash pads in UI.
+	// Events are collected into frames sent not often than 30 times per second
+	WatchActivity(ctx context.Context, in *WatchActivityRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ActivityFrame], error)
 }
 
 type kitPresetClient struct {
@@ -49,6 +82,25 @@ func (c *kitPresetClient) LoadPreset(ctx context.Context, in *GetPresetRequest,
 	return out, nil
 }
 
+func (c *kitPresetClient) LoadPresetAsync(ctx context.Context, in *GetPresetRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LoadPresetProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &KitPreset_ServiceDesc.Streams[0], KitPreset_LoadPresetAsync_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetPresetRequest, LoadPresetProgress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type KitPreset_LoadPresetAsyncClient = grpc.ServerStreamingClient[LoadPresetProgress]
+
 func (c *kitPresetClient) GetPreset(ctx context.Context, in *GetPresetRequest, opts ...grpc.CallOption) (*PresetResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PresetResponse)
@@ -59,12 +111,152 @@ func (c *kitPresetClient) GetPreset(ctx context.Context, in *GetPresetRequest, o
 	return out, nil
 }
 
+func (c *kitPresetClient) CreatePreset(ctx context.Context, in *CreatePresetRequest, opts ...grpc.CallOption) (*PresetRefResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PresetRefResponse)
+	err := c.cc.Invoke(ctx, KitPreset_CreatePreset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kitPresetClient) UpdatePreset(ctx context.Context, in *UpdatePresetRequest, opts ...grpc.CallOption) (*PresetRefResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PresetRefResponse)
+	err := c.cc.Invoke(ctx, KitPreset_UpdatePreset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kitPresetClient) RenamePreset(ctx context.Context, in *RenamePresetRequest, opts ...grpc.CallOption) (*PresetRefResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PresetRefResponse)
+	err := c.cc.Invoke(ctx, KitPreset_RenamePreset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kitPresetClient) ClonePreset(ctx context.Context, in *ClonePresetRequest, opts ...grpc.CallOption) (*PresetRefResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PresetRefResponse)
+	err := c.cc.Invoke(ctx, KitPreset_ClonePreset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kitPresetClient) DeletePreset(ctx context.Context, in *DeletePresetRequest, opts ...grpc.CallOption) (*DeletePresetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePresetResponse)
+	err := c.cc.Invoke(ctx, KitPreset_DeletePreset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kitPresetClient) SavePreset(ctx context.Context, in *SavePresetRequest, opts ...grpc.CallOption) (*PresetRefResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PresetRefResponse)
+	err := c.cc.Invoke(ctx, KitPreset_SavePreset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kitPresetClient) SavePresetAs(ctx context.Context, in *SavePresetAsRequest, opts ...grpc.CallOption) (*PresetRefResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PresetRefResponse)
+	err := c.cc.Invoke(ctx, KitPreset_SavePresetAs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kitPresetClient) PreloadPresets(ctx context.Context, in *PreloadPresetsRequest, opts ...grpc.CallOption) (*PreloadPresetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreloadPresetsResponse)
+	err := c.cc.Invoke(ctx, KitPreset_PreloadPresets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kitPresetClient) WatchPreset(ctx context.Context, in *WatchPresetRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PresetEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &KitPreset_ServiceDesc.Streams[1], KitPreset_WatchPreset_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPresetRequest, PresetEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type KitPreset_WatchPresetClient = grpc.ServerStreamingClient[PresetEvent]
+
+func (c *kitPresetClient) WatchActivity(ctx context.Context, in *WatchActivityRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ActivityFrame], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &KitPreset_ServiceDesc.Streams[2], KitPreset_WatchActivity_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchActivityRequest, ActivityFrame]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type KitPreset_WatchActivityClient = grpc.ServerStreamingClient[ActivityFrame]
+
 // KitPresetServer is the server API for KitPreset service.
 // All implementations must embed UnimplementedKitPresetServer
 // for forward compatibility.
 type KitPresetServer interface {
 	LoadPreset(context.Context, *GetPresetRequest) (*PresetResponse, error)
+	// Loads preset like LoadPreset and streams loading progress of preset channels.
+	// The last message contains the loaded preset. Canceling the call removes half-loaded channels
+	// and keeps the previous preset loaded
+	LoadPresetAsync(*GetPresetRequest, grpc.ServerStreamingServer[LoadPresetProgress]) error
 	GetPreset(context.Context, *GetPresetRequest) (*PresetResponse, error)
+	CreatePreset(context.Context, *CreatePresetRequest) (*PresetRefResponse, error)
+	UpdatePreset(context.Context, *UpdatePresetRequest) (*PresetRefResponse, error)
+	RenamePreset(context.Context, *RenamePresetRequest) (*PresetRefResponse, error)
+	ClonePreset(context.Context, *ClonePresetRequest) (*PresetRefResponse, error)
+	DeletePreset(context.Context, *DeletePresetRequest) (*DeletePresetResponse, error)
+	// Stores current control values of the loaded preset
+	SavePreset(context.Context, *SavePresetRequest) (*PresetRefResponse, error)
+	// Stores the loaded preset with current control values as a new preset. The new preset becomes the loaded one
+	SavePresetAs(context.Context, *SavePresetAsRequest) (*PresetRefResponse, error)
+	// Loads presets into muted sampler channels, so LoadPreset switches to them without loading samples.
+	// Presets are preloaded in the request order. Presets, which don't fit the preload budget, are skipped.
+	// Least recently used preloaded presets, which aren't in the request, are released to fit the budget
+	PreloadPresets(context.Context, *PreloadPresetsRequest) (*PreloadPresetsResponse, error)
+	// Streams events of the loaded preset, i.e. its restoring after sampler restart
+	WatchPreset(*WatchPresetRequest, grpc.ServerStreamingServer[PresetEvent]) error
+	// Streams hits of instruments and voice counts of channels of the loaded preset, i.e. to flash pads in UI.
+	// Events are collected into frames sent not often than 30 times per second
+	WatchActivity(*WatchActivityRequest, grpc.ServerStreamingServer[ActivityFrame]) error
 	mustEmbedUnimplementedKitPresetServer()
 }
 
@@ -78,9 +270,42 @@ type UnimplementedKitPresetServer struct{}
 func (UnimplementedKitPresetServer) LoadPreset(context.Context, *GetPresetRequest) (*PresetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoadPreset not implemented")
 }
+func (UnimplementedKitPresetServer) LoadPresetAsync(*GetPresetRequest, grpc.ServerStreamingServer[LoadPresetProgress]) error {
+	return status.Errorf(codes.Unimplemented, "method LoadPresetAsync not implemented")
+}
 func (UnimplementedKitPresetServer) GetPreset(context.Context, *GetPresetRequest) (*PresetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPreset not implemented")
 }
+func (UnimplementedKitPresetServer) CreatePreset(context.Context, *CreatePresetRequest) (*PresetRefResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePreset not implemented")
+}
+func (UnimplementedKitPresetServer) UpdatePreset(context.Context, *UpdatePresetRequest) (*PresetRefResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePreset not implemented")
+}
+func (UnimplementedKitPresetServer) RenamePreset(context.Context, *RenamePresetRequest) (*PresetRefResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenamePreset not implemented")
+}
+func (UnimplementedKitPresetServer) ClonePreset(context.Context, *ClonePresetRequest) (*PresetRefResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClonePreset not implemented")
+}
+func (UnimplementedKitPresetServer) DeletePreset(context.Context, *DeletePresetRequest) (*DeletePresetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePreset not implemented")
+}
+func (UnimplementedKitPresetServer) SavePreset(context.Context, *SavePresetRequest) (*PresetRefResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SavePreset not implemented")
+}
+func (UnimplementedKitPresetServer) SavePresetAs(context.Context, *SavePresetAsRequest) (*PresetRefResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SavePresetAs not implemented")
+}
+func (UnimplementedKitPresetServer) PreloadPresets(context.Context, *PreloadPresetsRequest) (*PreloadPresetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreloadPresets not implemented")
+}
+func (UnimplementedKitPresetServer) WatchPreset(*WatchPresetRequest, grpc.ServerStreamingServer[PresetEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPreset not implemented")
+}
+func (UnimplementedKitPresetServer) WatchActivity(*WatchActivityRequest, grpc.ServerStreamingServer[ActivityFrame]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchActivity not implemented")
+}
 func (UnimplementedKitPresetServer) mustEmbedUnimplementedKitPresetServer() {}
 func (UnimplementedKitPresetServer) testEmbeddedByValue()                   {}
 
@@ -120,6 +345,17 @@ func _KitPreset_LoadPreset_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KitPreset_LoadPresetAsync_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetPresetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KitPresetServer).LoadPresetAsync(m, &grpc.GenericServerStream[GetPresetRequest, LoadPresetProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type KitPreset_LoadPresetAsyncServer = grpc.ServerStreamingServer[LoadPresetProgress]
+
 func _KitPreset_GetPreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPresetRequest)
 	if err := dec(in); err != nil {
@@ -138,6 +374,172 @@ func _KitPreset_GetPreset_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KitPreset_CreatePreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitPresetServer).CreatePreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KitPreset_CreatePreset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitPresetServer).CreatePreset(ctx, req.(*CreatePresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KitPreset_UpdatePreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitPresetServer).UpdatePreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KitPreset_UpdatePreset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitPresetServer).UpdatePreset(ctx, req.(*UpdatePresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KitPreset_RenamePreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenamePresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitPresetServer).RenamePreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KitPreset_RenamePreset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitPresetServer).RenamePreset(ctx, req.(*RenamePresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KitPreset_ClonePreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClonePresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitPresetServer).ClonePreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KitPreset_ClonePreset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitPresetServer).ClonePreset(ctx, req.(*ClonePresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KitPreset_DeletePreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitPresetServer).DeletePreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KitPreset_DeletePreset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitPresetServer).DeletePreset(ctx, req.(*DeletePresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KitPreset_SavePreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavePresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitPresetServer).SavePreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KitPreset_SavePreset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitPresetServer).SavePreset(ctx, req.(*SavePresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KitPreset_SavePresetAs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavePresetAsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitPresetServer).SavePresetAs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KitPreset_SavePresetAs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitPresetServer).SavePresetAs(ctx, req.(*SavePresetAsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KitPreset_PreloadPresets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreloadPresetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitPresetServer).PreloadPresets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KitPreset_PreloadPresets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitPresetServer).PreloadPresets(ctx, req.(*PreloadPresetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KitPreset_WatchPreset_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPresetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KitPresetServer).WatchPreset(m, &grpc.GenericServerStream[WatchPresetRequest, PresetEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type KitPreset_WatchPresetServer = grpc.ServerStreamingServer[PresetEvent]
+
+func _KitPreset_WatchActivity_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchActivityRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KitPresetServer).WatchActivity(m, &grpc.GenericServerStream[WatchActivityRequest, ActivityFrame]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type KitPreset_WatchActivityServer = grpc.ServerStreamingServer[ActivityFrame]
+
 // KitPreset_ServiceDesc is the grpc.ServiceDesc for KitPreset service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,7 +555,55 @@ var KitPreset_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPreset",
 			Handler:    _KitPreset_GetPreset_Handler,
 		},
+		{
+			MethodName: "CreatePreset",
+			Handler:    _KitPreset_CreatePreset_Handler,
+		},
+		{
+			MethodName: "UpdatePreset",
+			Handler:    _KitPreset_UpdatePreset_Handler,
+		},
+		{
+			MethodName: "RenamePreset",
+			Handler:    _KitPreset_RenamePreset_Handler,
+		},
+		{
+			MethodName: "ClonePreset",
+			Handler:    _KitPreset_ClonePreset_Handler,
+		},
+		{
+			MethodName: "DeletePreset",
+			Handler:    _KitPreset_DeletePreset_Handler,
+		},
+		{
+			MethodName: "SavePreset",
+			Handler:    _KitPreset_SavePreset_Handler,
+		},
+		{
+			MethodName: "SavePresetAs",
+			Handler:    _KitPreset_SavePresetAs_Handler,
+		},
+		{
+			MethodName: "PreloadPresets",
+			Handler:    _KitPreset_PreloadPresets_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "LoadPresetAsync",
+			Handler:       _KitPreset_LoadPresetAsync_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchPreset",
+			Handler:       _KitPreset_WatchPreset_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchActivity",
+			Handler:       _KitPreset_WatchActivity_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "preset.proto",
 }
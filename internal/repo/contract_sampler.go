@@ -1,6 +1,8 @@
 package repo
 
 import (
+	"context"
+
 	m "github.com/raspidrum-srv/internal/model"
 	"github.com/spf13/afero"
 )
@@ -18,13 +20,98 @@ type Param[T ParamType] struct {
 // value (int) - sampler channel id
 type SamplerChannels map[string]int
 
+// Settings of sampler audio output device
+type AudioOutput struct {
+	Driver string
+	// parameters of audio output device, i.e. CARD, SAMPLERATE
+	Params []Param[string]
+	// parameters grouped by audio channels of device, i.e. JACK_BINDINGS. Audio channel is key of map
+	Channels map[int][]Param[string]
+}
+
+// Audio output driver supported by sampler
+type AudioDriver struct {
+	Name        string
+	Description string
+	Version     string
+	Params      []DriverParam
+}
+
+type DriverParam struct {
+	Name        string
+	Description string
+	// BOOL, INT, FLOAT, STRING
+	Type          string
+	Mandatory     bool
+	Multiplicity  bool
+	Default       *string
+	Possibilities []string
+}
+
+// Loading progress of preset channel
+type ChannelProgress struct {
+	ChannelKey string
+	// 0..100
+	Percent int
+}
+
+// Effect instance of send effect chain built in sampler for preset effect
+type SamplerEffect struct {
+	ChainId    int
+	InstanceId int
+	// params of instance with values after applying of preset values
+	Params []m.FxParam
+}
+
+// key (string) - effect key from preset
+type SamplerEffects map[string]SamplerEffect
+
+// Devices and channels created in sampler for the loaded preset
+type SamplerSession struct {
+	PresetId   int64
+	AudioDevId int
+	MidiDevId  int
+	Channels   SamplerChannels
+	// send effect chains of audio output device. Key - chain key from preset, value - sampler chain id
+	FxChains map[string]int
+	Effects  SamplerEffects
+}
+
 type SamplerRepo interface {
-	ConnectAudioOutput(driver string, params map[int][]Param[string]) (devId int, err error)
+	GetAudioOutputDrivers() ([]AudioDriver, error)
+	ConnectAudioOutput(out AudioOutput) (devId int, err error)
 	ConnectMidiInput(driver string, params []Param[string]) (devId int, err error)
+	// Sets parameters of MIDI inputs created by ConnectMidiInput
+	RebindMidiInputs(params []Param[string]) error
 	CreateChannel(audioDevId, midiDevId int) (channelId int, err error)
 	LoadInstrument(instrumentFile string, instrIdx int, channelId int) error
+	// Loads preset into new channels. Channels of the previous preset are muted and stay preloaded
 	LoadPreset(audioDevId, midiDevId int, preset *m.KitPreset, fs afero.Fs) (SamplerChannels, error)
+	// Loads preset like LoadPreset, but instruments of all channels are loaded in background.
+	// Progress of channels is reported on change. On ctx cancellation half-loaded channels are removed
+	// and the previous preset stays loaded
+	LoadPresetAsync(ctx context.Context, audioDevId, midiDevId int, preset *m.KitPreset, fs afero.Fs, progress func(ChannelProgress)) (SamplerChannels, error)
+	// Loads preset into muted channels. Preset is activated by SwitchPreset
+	PreloadPreset(audioDevId, midiDevId int, preset *m.KitPreset, fs afero.Fs) error
+	// Unmutes channels of preloaded preset and mutes channels of the loaded preset, which stays preloaded.
+	// ok is false if preset isn't preloaded
+	SwitchPreset(preset *m.KitPreset) (chnls SamplerChannels, ok bool, err error)
+	// Removes channels of preloaded preset
+	ReleasePreset(presetId int64) error
+	// Returns ids of preloaded presets except the loaded one
+	PreloadedPresets() []int64
+	// Estimates memory in bytes, which samples of preset take in sampler
+	PresetMemory(preset *m.KitPreset, fs afero.Fs) (int64, error)
 	SetChannelVolume(samplerChn int, volume float32) error
+	SetChannelMute(samplerChn int, mute bool) error
+	// Soloed channel silences channels, which aren't soloed
+	SetChannelSolo(samplerChn int, solo bool) error
 	SendMidiCC(samplerChn int, cc int, value float32) error
 	SetGlobalVolume(volume float32) error
+	// Sets param of effect instance created for preset effect
+	SetEffectParam(fxInstId int, param int, value float32) error
+	// Returns devices and channels of the loaded preset. ok is false if preset isn't loaded
+	Session() (session SamplerSession, ok bool)
+	// Removes all channels and devices created in sampler, so the next preset is loaded into clean sampler
+	CloseSession() error
 }
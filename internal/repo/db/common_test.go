@@ -1,8 +1,10 @@
 package db
 
 import (
+	"os"
 	"path"
 	"runtime"
+	"testing"
 )
 
 func getDBPath() string {
@@ -10,3 +12,17 @@ func getDBPath() string {
 	dir := path.Join(path.Dir(f), "../../../db/")
 	return dir
 }
+
+// copy of project db in temp dir. Used for tests which modify db
+func getTempDBPath(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	cont, err := os.ReadFile(path.Join(getDBPath(), "kits.sqlite3"))
+	if err != nil {
+		t.Fatalf("failed read db: %v", err)
+	}
+	if err := os.WriteFile(path.Join(dir, "kits.sqlite3"), cont, 0644); err != nil {
+		t.Fatalf("failed copy db: %v", err)
+	}
+	return dir
+}
@@ -27,6 +27,32 @@ func ByUid(uuid string) Condition {
 	return Eq("uid", uuid)
 }
 
+/*
+	 Can be used with:
+		GetMidiMapProfile
+*/
+func ByName(name string) Condition {
+	return Eq("name", name)
+}
+
+/*
+	 Can be used with:
+		ListInstruments
+*/
+func ByType(t string) Condition {
+	return Eq("type", t)
+}
+
+func BySubtype(t string) Condition {
+	return Eq("subtype", t)
+}
+
+func ByInstrumentTag(tag string) Condition {
+	return func() (sql string, args []interface{}, err error) {
+		return "exists (select 1 from instrument_tag it where it.instrument = i.id and it.name = ?)", []interface{}{tag}, nil
+	}
+}
+
 func Eq(field string, inargs ...interface{}) Condition {
 	return func() (sql string, args []interface{}, err error) {
 		return fmt.Sprintf("%s = ?", field), inargs, nil
@@ -39,6 +65,24 @@ func In(field string, inargs ...interface{}) Condition {
 	}
 }
 
+// Page limits rows of list. Zero Limit means all rows from Offset
+type Page struct {
+	Offset int
+	Limit  int
+}
+
+func buildPage(page Page) (sql string, args []interface{}) {
+	if page.Limit == 0 && page.Offset == 0 {
+		return "", nil
+	}
+	limit := page.Limit
+	if limit == 0 {
+		// sqlite requires limit for offset
+		limit = -1
+	}
+	return "limit ? offset ?", []interface{}{limit, page.Offset}
+}
+
 func buildConditions(conds ...Condition) (sql string, args []interface{}, err error) {
 	sqls := make([]string, len(conds))
 	for i, cond := range conds {
@@ -145,3 +145,54 @@ func Test_buildConditions(t *testing.T) {
 		})
 	}
 }
+
+func TestByInstrumentTag(t *testing.T) {
+	gotSql, gotArgs, err := ByInstrumentTag("Zildjian")()
+	if err != nil {
+		t.Errorf("ByInstrumentTag() error = %v", err)
+		return
+	}
+	wantSql := "exists (select 1 from instrument_tag it where it.instrument = i.id and it.name = ?)"
+	if gotSql != wantSql {
+		t.Errorf("ByInstrumentTag() gotSql = %v, want %v", gotSql, wantSql)
+	}
+	if !reflect.DeepEqual(gotArgs, []interface{}{"Zildjian"}) {
+		t.Errorf("ByInstrumentTag() gotArgs = %v, want %v", gotArgs, []interface{}{"Zildjian"})
+	}
+}
+
+func TestBuildPage(t *testing.T) {
+	tests := []struct {
+		name     string
+		page     Page
+		wantSql  string
+		wantArgs []interface{}
+	}{
+		{
+			name: "all rows",
+		},
+		{
+			name:     "limit and offset",
+			page:     Page{Offset: 20, Limit: 10},
+			wantSql:  "limit ? offset ?",
+			wantArgs: []any{10, 20},
+		},
+		{
+			name:     "only offset",
+			page:     Page{Offset: 20},
+			wantSql:  "limit ? offset ?",
+			wantArgs: []any{-1, 20},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSql, gotArgs := buildPage(tt.page)
+			if gotSql != tt.wantSql {
+				t.Errorf("buildPage() gotSql = %v, want %v", gotSql, tt.wantSql)
+			}
+			if !reflect.DeepEqual(gotArgs, tt.wantArgs) {
+				t.Errorf("buildPage() gotArgs = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+//go:build integration
+
+package db
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// creates custom kit with preset which uses instrument of stock kit. Returns custom kit and preset ids
+func makeCustomKitPreset(t *testing.T, d *Sqlite, instrId int64) (kitId, presetId int64) {
+	t.Helper()
+	res, err := d.db.Exec("insert into kit(uid, name, iscustom) values('custom-kit', 'Custom', 1)")
+	require.NoError(t, err)
+	kitId, _ = res.LastInsertId()
+	_, err = d.db.Exec("insert into kit_instrument(kit, instrument) values(?, ?)", kitId, instrId)
+	require.NoError(t, err)
+	res, err = d.db.Exec("insert into kit_preset(uid, kit, name) values('custom-preset', ?, 'Custom preset')", kitId)
+	require.NoError(t, err)
+	presetId, _ = res.LastInsertId()
+	res, err = d.db.Exec("insert into preset_channel(preset, key, name, controls) values(?, 'ch1', 'Ch 1', '{}')", presetId)
+	require.NoError(t, err)
+	chId, _ := res.LastInsertId()
+	_, err = d.db.Exec("insert into preset_instrument(preset, channel, instrument, name, controls) values(?, ?, ?, 'Instr', '{}')", presetId, chId, instrId)
+	require.NoError(t, err)
+	return kitId, presetId
+}
+
+func TestSqlite_DeleteKit(t *testing.T) {
+	d, err := NewSqlite(getTempDBPath(t))
+	require.NoError(t, err)
+	defer d.Close()
+
+	custKitId, custPresetId := makeCustomKitPreset(t, d, 1)
+
+	_, err = d.DeleteKit(nil, 1)
+	assert.ErrorIs(t, err, ErrInUse)
+	var inUse *PresetsInUseError
+	require.True(t, errors.As(err, &inUse))
+	require.Len(t, inUse.Presets, 1)
+	assert.Equal(t, "custom-preset", inUse.Presets[0].Uid)
+	assert.Equal(t, "Custom", inUse.Presets[0].Kit.Name)
+
+	require.NoError(t, d.DeletePreset(nil, custPresetId))
+	deleted, err := d.DeleteKit(nil, 1)
+	require.NoError(t, err)
+	assert.Len(t, deleted, 22)
+	assert.NotEmpty(t, deleted[0].Uid)
+
+	kits, err := d.ListKits()
+	require.NoError(t, err)
+	require.Len(t, *kits, 1)
+	assert.Equal(t, custKitId, (*kits)[0].Id)
+	psts, err := d.ListPresets()
+	require.NoError(t, err)
+	assert.Empty(t, *psts)
+	instrs, err := d.ListInstruments()
+	require.NoError(t, err)
+	assert.Empty(t, *instrs)
+
+	_, err = d.DeleteKit(nil, 1)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestSqlite_DeleteInstrument(t *testing.T) {
+	d, err := NewSqlite(getTempDBPath(t))
+	require.NoError(t, err)
+	defer d.Close()
+
+	var usedId, unusedId int64
+	require.NoError(t, d.db.Get(&usedId, "select min(instrument) from preset_instrument"))
+	require.NoError(t, d.db.Get(&unusedId, "select min(id) from instrument where id not in (select instrument from preset_instrument)"))
+
+	_, err = d.DeleteInstrument(nil, usedId)
+	var inUse *PresetsInUseError
+	require.True(t, errors.As(err, &inUse))
+	assert.Equal(t, "SMDrums 1", inUse.Presets[0].Name)
+
+	deleted, err := d.DeleteInstrument(nil, unusedId)
+	require.NoError(t, err)
+	assert.Equal(t, unusedId, deleted.Id)
+	instrs, err := d.ListInstruments(ByKitId(1))
+	require.NoError(t, err)
+	assert.Len(t, *instrs, 21)
+
+	_, err = d.DeleteInstrument(nil, unusedId)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
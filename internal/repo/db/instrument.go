@@ -40,6 +40,10 @@ type InstrTag struct {
 //   - in (tags)
 //   - kit
 func (d *Sqlite) ListInstruments(conds ...Condition) (*[]m.Instrument, error) {
+	return d.ListInstrumentsPage(Page{}, conds...)
+}
+
+func (d *Sqlite) ListInstrumentsPage(page Page, conds ...Condition) (*[]m.Instrument, error) {
 	sql_select := `select i.*, string_agg(t.name, ',') as tags
 	from instrument i join kit_instrument ki on ki.instrument = i.id
 	     left join instrument_tag t on t.instrument = i.id`
@@ -52,7 +56,10 @@ func (d *Sqlite) ListInstruments(conds ...Condition) (*[]m.Instrument, error) {
 		return nil, fmt.Errorf("failed ListInstruments: %w", err)
 	}
 
-	sql := fmt.Sprintf("%s %s %s %s", sql_select, sql_where, sql_group, sql_order)
+	sql_limit, limitArgs := buildPage(page)
+	args = append(args, limitArgs...)
+
+	sql := fmt.Sprintf("%s %s %s %s %s", sql_select, sql_where, sql_group, sql_order, sql_limit)
 
 	rows, err := d.db.Queryx(sql, args...)
 	if err != nil {
@@ -71,6 +78,21 @@ func (d *Sqlite) ListInstruments(conds ...Condition) (*[]m.Instrument, error) {
 	return &ins, nil
 }
 
+// CountInstruments returns count of instruments matched by conditions of ListInstruments
+func (d *Sqlite) CountInstruments(conds ...Condition) (int, error) {
+	sql_where, args, err := buildConditions(conds...)
+	if err != nil {
+		return 0, fmt.Errorf("failed CountInstruments: %w", err)
+	}
+	sql := fmt.Sprintf("select count(distinct i.id) from instrument i join kit_instrument ki on ki.instrument = i.id %s", sql_where)
+	var count int
+	err = d.db.Get(&count, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed CountInstruments: %w", err)
+	}
+	return count, nil
+}
+
 // TODO: ON CONFLICT UPDATE
 func (d *Sqlite) StoreInstrument(tx *sqlx.Tx, kitId int64, instr *m.Instrument) (instrId int64, err error) {
 	localTx := tx == nil
@@ -91,7 +113,7 @@ func (d *Sqlite) StoreInstrument(tx *sqlx.Tx, kitId int64, instr *m.Instrument)
 		if localTx {
 			tx.Rollback()
 		}
-		return 0, fmt.Errorf("failed store instrument: %w", err)
+		return 0, fmt.Errorf("failed store instrument: %w", wrapConstraintErr(err))
 	}
 	instrId, err = res.LastInsertId()
 	if err != nil {
@@ -216,3 +238,38 @@ func mapInstrFields(fields []string) fieldMap {
 	}
 	return res
 }
+
+// Removes instrument with its tags and links with kits.
+// Returns removed instrument. Fails with *PresetsInUseError if presets use instrument
+func (d *Sqlite) DeleteInstrument(tx *sqlx.Tx, instrId int64) (deleted *m.Instrument, err error) {
+	localTx := tx == nil
+	if localTx {
+		tx, err = d.db.Beginx()
+		if err != nil {
+			return nil, fmt.Errorf("failed delete instrument: %w", err)
+		}
+		defer func() {
+			if err != nil {
+				tx.Rollback()
+			} else {
+				tx.Commit()
+			}
+		}()
+	}
+
+	instrs := []Instr{}
+	if err = tx.Select(&instrs, "select id, uid, key, name from instrument where id = ?", instrId); err != nil {
+		return nil, fmt.Errorf("failed delete instrument: %w", err)
+	}
+	if len(instrs) == 0 {
+		return nil, fmt.Errorf("failed delete instrument %d: %w", instrId, ErrNotFound)
+	}
+	// kit ids start from 1, so presets of all kits are checked
+	if err = checkInstrumentsNotInUse(tx, 0, []int64{instrId}); err != nil {
+		return nil, fmt.Errorf("failed delete instrument %d: %w", instrId, err)
+	}
+	if _, err = tx.Exec("delete from instrument where id = ?", instrId); err != nil {
+		return nil, fmt.Errorf("failed delete instrument: %w", err)
+	}
+	return dbToInstrument(&instrs[0]), nil
+}
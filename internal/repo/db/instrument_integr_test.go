@@ -37,6 +37,29 @@ func TestSqlite_ListInstruments(t *testing.T) {
 			wantLen: 22,
 			wantErr: false,
 		},
+		{
+			name: "list by type and subtype",
+			args: args{
+				conds: []Condition{
+					ByKitId(1),
+					ByType("cymbal"),
+					BySubtype("crash"),
+				},
+			},
+			wantLen: 4,
+			wantErr: false,
+		},
+		{
+			name: "list by tag",
+			args: args{
+				conds: []Condition{
+					ByKitId(1),
+					ByInstrumentTag("Zildjian"),
+				},
+			},
+			wantLen: 7,
+			wantErr: false,
+		},
 		{
 			name: "list for non exists kit",
 			args: args{
@@ -66,6 +89,55 @@ func TestSqlite_ListInstruments(t *testing.T) {
 	}
 }
 
+func TestSqlite_ListInstrumentsPage(t *testing.T) {
+	d, err := NewSqlite(getDBPath())
+	if err != nil {
+		t.Errorf("%v", err)
+		return
+	}
+	defer d.Close()
+
+	all, err := d.ListInstruments(ByKitId(1))
+	if err != nil {
+		t.Errorf("Sqlite.ListInstruments() error = %v", err)
+		return
+	}
+	count, err := d.CountInstruments(ByKitId(1))
+	if err != nil {
+		t.Errorf("Sqlite.CountInstruments() error = %v", err)
+		return
+	}
+	if count != len(*all) {
+		t.Errorf("Sqlite.CountInstruments() = %v, want %v", count, len(*all))
+	}
+	count, err = d.CountInstruments(ByKitId(1), ByInstrumentTag("Zildjian"))
+	if err != nil {
+		t.Errorf("Sqlite.CountInstruments() error = %v", err)
+		return
+	}
+	if count != 7 {
+		t.Errorf("Sqlite.CountInstruments() by tag = %v, want 7", count)
+	}
+
+	got, err := d.ListInstrumentsPage(Page{Offset: 5, Limit: 10}, ByKitId(1))
+	if err != nil {
+		t.Errorf("Sqlite.ListInstrumentsPage() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(*got, (*all)[5:15]) {
+		t.Errorf("Sqlite.ListInstrumentsPage() = %v, want %v", *got, (*all)[5:15])
+	}
+	// the last partial page
+	got, err = d.ListInstrumentsPage(Page{Offset: 20, Limit: 10}, ByKitId(1))
+	if err != nil {
+		t.Errorf("Sqlite.ListInstrumentsPage() error = %v", err)
+		return
+	}
+	if len(*got) != len(*all)-20 {
+		t.Errorf("Sqlite.ListInstrumentsPage() len = %v, want len = %v", len(*got), len(*all)-20)
+	}
+}
+
 func TestSqlite_getInstrumentsByUid(t *testing.T) {
 	d, err := NewSqlite(getDBPath())
 	if err != nil {
@@ -3,6 +3,7 @@ package db
 import (
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 	m "github.com/raspidrum-srv/internal/model"
@@ -33,11 +34,16 @@ type KitTag struct {
 //   - isCustom
 //   - in (tags)
 func (d *Sqlite) ListKits() (*[]m.Kit, error) {
-	rows, err := d.db.Queryx(`select k.*, string_agg(t.name, ',') as tags
+	return d.ListKitsPage(Page{})
+}
+
+func (d *Sqlite) ListKitsPage(page Page) (*[]m.Kit, error) {
+	sql_limit, args := buildPage(page)
+	rows, err := d.db.Queryx(fmt.Sprintf(`select k.*, string_agg(t.name, ',') as tags
 	from kit k left join kit_tag t on t.kit = k.id
 	group by k.id, k.uid, k.name, k.iscustom, k.description, k.copyright, k.licence, k.credits, k.url
 	order by k.name, k.id
-	`)
+	%s`, sql_limit), args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed sql: %w", err)
 	}
@@ -54,6 +60,15 @@ func (d *Sqlite) ListKits() (*[]m.Kit, error) {
 	return &kits, nil
 }
 
+func (d *Sqlite) CountKits() (int, error) {
+	var count int
+	err := d.db.Get(&count, "select count(*) from kit")
+	if err != nil {
+		return 0, fmt.Errorf("failed CountKits: %w", err)
+	}
+	return count, nil
+}
+
 // TODO: ON CONFLICT UPDATE
 func (d *Sqlite) StoreKit(tx *sqlx.Tx, kit *m.Kit) (kitId int64, err error) {
 	localTx := tx == nil
@@ -73,7 +88,7 @@ func (d *Sqlite) StoreKit(tx *sqlx.Tx, kit *m.Kit) (kitId int64, err error) {
 		if localTx {
 			tx.Rollback()
 		}
-		return 0, fmt.Errorf("failed store kit: %w", err)
+		return 0, fmt.Errorf("failed store kit: %w", wrapConstraintErr(err))
 	}
 	kitId, err = res.LastInsertId()
 	if err != nil {
@@ -179,3 +194,134 @@ func mapKitFields(fields []string) fieldMap {
 	}
 	return res
 }
+
+// Removes kit with its presets and tags.
+// Instruments of kit are removed too, except instruments linked to other not custom kit.
+// Removed instruments are unlinked from custom kits.
+// Returns removed instruments. Fails with *PresetsInUseError if presets of other kits use removed instruments
+func (d *Sqlite) DeleteKit(tx *sqlx.Tx, kitId int64) (deleted []m.Instrument, err error) {
+	localTx := tx == nil
+	if localTx {
+		tx, err = d.db.Beginx()
+		if err != nil {
+			return nil, fmt.Errorf("failed delete kit: %w", err)
+		}
+		defer func() {
+			if err != nil {
+				tx.Rollback()
+			} else {
+				tx.Commit()
+			}
+		}()
+	}
+
+	var cnt int
+	if err = tx.Get(&cnt, "select count(*) from kit where id = ?", kitId); err != nil {
+		return nil, fmt.Errorf("failed delete kit: %w", err)
+	}
+	if cnt == 0 {
+		return nil, fmt.Errorf("failed delete kit %d: %w", kitId, ErrNotFound)
+	}
+
+	// instruments owned by kit
+	instrs := []Instr{}
+	err = tx.Select(&instrs, `select i.id, i.uid, i.key, i.name
+	from instrument i join kit_instrument ki on ki.instrument = i.id
+	where ki.kit = ?
+	  and not exists (
+	    select 1 from kit_instrument o join kit k on k.id = o.kit
+	    where o.instrument = i.id and o.kit <> ki.kit and coalesce(k.iscustom, 0) = 0)`, kitId)
+	if err != nil {
+		return nil, fmt.Errorf("failed delete kit: %w", err)
+	}
+	instrIds := make([]int64, len(instrs))
+	for i, v := range instrs {
+		instrIds[i] = v.Id
+	}
+	if err = checkInstrumentsNotInUse(tx, kitId, instrIds); err != nil {
+		return nil, fmt.Errorf("failed delete kit %d: %w", kitId, err)
+	}
+
+	// preset channels and instruments are removed by cascade
+	if _, err = tx.Exec("delete from kit_preset where kit = ?", kitId); err != nil {
+		return nil, fmt.Errorf("failed delete presets of kit: %w", err)
+	}
+	if _, err = tx.Exec("delete from kit_instrument where kit = ?", kitId); err != nil {
+		return nil, fmt.Errorf("failed delete kit instruments: %w", err)
+	}
+	// tags are removed by cascade
+	if _, err = tx.Exec("delete from kit where id = ?", kitId); err != nil {
+		return nil, fmt.Errorf("failed delete kit: %w", err)
+	}
+	if len(instrIds) > 0 {
+		// links with custom kits and tags are removed by cascade
+		sql, args, err := sqlx.In("delete from instrument where id in (?)", instrIds)
+		if err != nil {
+			return nil, fmt.Errorf("failed delete kit instruments: %w", err)
+		}
+		if _, err = tx.Exec(sql, args...); err != nil {
+			return nil, fmt.Errorf("failed delete kit instruments: %w", err)
+		}
+	}
+
+	deleted = make([]m.Instrument, len(instrs))
+	for i, v := range instrs {
+		deleted[i] = *dbToInstrument(&v)
+	}
+	return deleted, nil
+}
+
+// Links instruments by uid with custom kit.
+// Fails with ErrNotFound if kit or any of instruments doesn't exist
+func (d *Sqlite) LinkInstruments(tx *sqlx.Tx, kitId int64, instrUids []string) (err error) {
+	localTx := tx == nil
+	if localTx {
+		tx, err = d.db.Beginx()
+		if err != nil {
+			return fmt.Errorf("failed link instruments: %w", err)
+		}
+		defer func() {
+			if err != nil {
+				tx.Rollback()
+			} else {
+				tx.Commit()
+			}
+		}()
+	}
+
+	var isCustom []int
+	if err = tx.Select(&isCustom, "select coalesce(iscustom, 0) from kit where id = ?", kitId); err != nil {
+		return fmt.Errorf("failed link instruments: %w", err)
+	}
+	if len(isCustom) == 0 {
+		return fmt.Errorf("failed link instruments to kit %d: %w", kitId, ErrNotFound)
+	}
+	if isCustom[0] != 1 {
+		return fmt.Errorf("failed link instruments: kit %d is not custom", kitId)
+	}
+	if len(instrUids) == 0 {
+		return nil
+	}
+
+	instrs, err := d.getInstrumentsByUid(tx, instrUids, "id")
+	if err != nil {
+		return fmt.Errorf("failed link instruments: %w", err)
+	}
+	missing := []string{}
+	for _, uid := range instrUids {
+		if _, ok := (*instrs)[uid]; !ok {
+			missing = append(missing, uid)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("failed link instruments: instruments %s: %w", strings.Join(missing, ", "), ErrNotFound)
+	}
+
+	for _, instr := range *instrs {
+		_, err = tx.Exec("insert into kit_instrument(kit, instrument) values(?, ?) on conflict do nothing", kitId, instr.Id)
+		if err != nil {
+			return fmt.Errorf("failed link instruments: %w", err)
+		}
+	}
+	return nil
+}
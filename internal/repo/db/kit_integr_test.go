@@ -48,6 +48,32 @@ func TestSqlite_ListKits(t *testing.T) {
 	}
 }
 
+func TestSqlite_ListKitsPage(t *testing.T) {
+	d, err := NewSqlite(getDBPath())
+	if err != nil {
+		t.Errorf("%v", err)
+		return
+	}
+	defer d.Close()
+
+	count, err := d.CountKits()
+	if err != nil {
+		t.Errorf("Sqlite.CountKits() error = %v", err)
+		return
+	}
+	if count != 1 {
+		t.Errorf("Sqlite.CountKits() = %v, want 1", count)
+	}
+	got, err := d.ListKitsPage(Page{Offset: 1, Limit: 10})
+	if err != nil {
+		t.Errorf("Sqlite.ListKitsPage() error = %v", err)
+		return
+	}
+	if len(*got) != 0 {
+		t.Errorf("Sqlite.ListKitsPage() len = %v, want len = 0", len(*got))
+	}
+}
+
 func TestSqlite_getKitByUid(t *testing.T) {
 	d, err := NewSqlite(getDBPath())
 	if err != nil {
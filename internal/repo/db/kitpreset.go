@@ -22,6 +22,8 @@ type KitPrst struct {
 	Name        string `db:"name"`
 	Channels    []PrstChnl
 	Instruments []PrtsInstr
+	// send effect chains in json
+	Fxs sql.NullString `db:"fxs"`
 }
 
 type PrstChnl struct {
@@ -30,6 +32,8 @@ type PrstChnl struct {
 	Key      string `db:"key"`
 	Name     string `db:"name"`
 	Controls string `db:"controls"`
+	Mute     int    `db:"mute"`
+	Solo     int    `db:"solo"`
 }
 
 type InstrBase struct {
@@ -52,6 +56,8 @@ type PrtsInstr struct {
 	MidiKey    sql.NullString `db:"midikey"`
 	Controls   string         `db:"controls"`
 	Layers     sql.NullString `db:"layers"`
+	Mute       int            `db:"mute"`
+	Solo       int            `db:"solo"`
 }
 
 func (d *Sqlite) StorePreset(tx *sqlx.Tx, preset *m.KitPreset) (presetId int64, err error) {
@@ -81,7 +87,7 @@ func (d *Sqlite) StorePreset(tx *sqlx.Tx, preset *m.KitPreset) (presetId int64,
 		return presetId, err
 	}
 	if kitIds == nil || len(*kitIds) == 0 {
-		return presetId, fmt.Errorf("not found kit with uuid: %s", pstDb.KitUid)
+		return presetId, fmt.Errorf("kit with uuid: %s: %w", pstDb.KitUid, ErrNotFound)
 	}
 	pstDb.KitId = (*kitIds)[pstDb.KitUid].Id
 
@@ -104,17 +110,17 @@ func (d *Sqlite) StorePreset(tx *sqlx.Tx, preset *m.KitPreset) (presetId int64,
 		pstDb.Instruments[i].InstrId = instr.Id
 	}
 	if len(missingInstrs) != 0 {
-		return presetId, fmt.Errorf("failed store kit preset: %s", strings.Join(missingInstrs, "\n"))
+		return presetId, fmt.Errorf("failed store kit preset: %s: %w", strings.Join(missingInstrs, "\n"), ErrNotFound)
 	}
 
 	// store kit preset
-	sql := `insert into kit_preset(uid, kit, name) values(:uid, :kit, :name)
-	on conflict (id) do update set name = excluded.name, uid = excluded.uid
-	on conflict (uid) do update set name = excluded.name
+	sql := `insert into kit_preset(uid, kit, name, fxs) values(:uid, :kit, :name, :fxs)
+	on conflict (id) do update set name = excluded.name, uid = excluded.uid, fxs = excluded.fxs
+	on conflict (uid) do update set name = excluded.name, fxs = excluded.fxs
 	returning id`
 	rows, err := tx.NamedQuery(sql, pstDb)
 	if err != nil {
-		return presetId, fmt.Errorf("failed store kit preset: %w", err)
+		return presetId, fmt.Errorf("failed store kit preset: %w", wrapConstraintErr(err))
 	}
 	defer rows.Close()
 	for rows.Next() {
@@ -123,11 +129,14 @@ func (d *Sqlite) StorePreset(tx *sqlx.Tx, preset *m.KitPreset) (presetId int64,
 			return presetId, fmt.Errorf("failed store kit preset: %w", err)
 		}
 	}
+	if err = rows.Err(); err != nil {
+		return presetId, fmt.Errorf("failed store kit preset: %w", wrapConstraintErr(err))
+	}
 
 	// store preset channels
-	sql = `insert into preset_channel(preset, key, name, controls) values(:preset, :key, :name, :controls) 
-	on conflict (id) do update set preset = excluded.preset, key = excluded.key, name = excluded.name, controls = excluded.controls
-	on conflict (preset, key) do update set name = excluded.name, controls = excluded.controls
+	sql = `insert into preset_channel(preset, key, name, controls, mute, solo) values(:preset, :key, :name, :controls, :mute, :solo) 
+	on conflict (id) do update set preset = excluded.preset, key = excluded.key, name = excluded.name, controls = excluded.controls, mute = excluded.mute, solo = excluded.solo
+	on conflict (preset, key) do update set name = excluded.name, controls = excluded.controls, mute = excluded.mute, solo = excluded.solo
 	returning id`
 	chnls := make(map[string]int64, len(pstDb.Channels))
 	for i, v := range pstDb.Channels {
@@ -156,9 +165,9 @@ func (d *Sqlite) StorePreset(tx *sqlx.Tx, preset *m.KitPreset) (presetId int64,
 		}
 		pstDb.Instruments[i].ChannelId = chnlId
 	}
-	sql = `insert into preset_instrument(preset, channel, instrument, name, midikey, controls, layers) 
-	values(:preset, :channel, :instrument, :name, :midikey, :controls, :layers)
-	on conflict (preset, name) do update set channel = excluded.channel, instrument = excluded.instrument, midikey = excluded.midikey, controls = excluded.controls, layers = excluded.layers`
+	sql = `insert into preset_instrument(preset, channel, instrument, name, midikey, controls, layers, mute, solo) 
+	values(:preset, :channel, :instrument, :name, :midikey, :controls, :layers, :mute, :solo)
+	on conflict (preset, name) do update set channel = excluded.channel, instrument = excluded.instrument, midikey = excluded.midikey, controls = excluded.controls, layers = excluded.layers, mute = excluded.mute, solo = excluded.solo`
 	_, err = tx.NamedExec(sql, pstDb.Instruments)
 	if err != nil {
 		return presetId, fmt.Errorf("failed store instruments of kit preset: %w", err)
@@ -172,14 +181,38 @@ func (d *Sqlite) StorePreset(tx *sqlx.Tx, preset *m.KitPreset) (presetId int64,
 
 // Return minimal list of Kit Presets with minimal info
 func (d *Sqlite) ListPresets(conds ...Condition) (*[]m.KitPreset, error) {
+	return listPresets(d.db, Page{}, conds...)
+}
+
+func (d *Sqlite) ListPresetsPage(page Page, conds ...Condition) (*[]m.KitPreset, error) {
+	return listPresets(d.db, page, conds...)
+}
+
+// CountPresets returns count of presets matched by conditions of ListPresets
+func (d *Sqlite) CountPresets(conds ...Condition) (int, error) {
+	sql_where, args, err := buildConditions(conds...)
+	if err != nil {
+		return 0, fmt.Errorf("failed CountPresets: %w", err)
+	}
+	var count int
+	err = d.db.Get(&count, fmt.Sprintf("select count(*) from v_kit_preset %s", sql_where), args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed CountPresets: %w", err)
+	}
+	return count, nil
+}
+
+func listPresets(q sqlx.Queryer, page Page, conds ...Condition) (*[]m.KitPreset, error) {
 	sql_select := `select * from v_kit_preset`
-	sql_order := `order by name`
+	sql_order := `order by name, id`
 	sql_where, args, err := buildConditions(conds...)
 	if err != nil {
 		return nil, fmt.Errorf("failed ListPresets: %w", err)
 	}
-	sql := fmt.Sprintf("%s %s %s", sql_select, sql_where, sql_order)
-	rows, err := d.db.Queryx(sql, args...)
+	sql_limit, limitArgs := buildPage(page)
+	args = append(args, limitArgs...)
+	sql := fmt.Sprintf("%s %s %s %s", sql_select, sql_where, sql_order, sql_limit)
+	rows, err := q.Queryx(sql, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed ListPresets: %w", err)
 	}
@@ -236,3 +269,115 @@ func (d *Sqlite) GetPreset(conds ...Condition) (*m.KitPreset, error) {
 
 	return dbToKitPreset(&pst), nil
 }
+
+// Removes preset with its channels and instruments
+func (d *Sqlite) DeletePreset(tx *sqlx.Tx, presetId int64) (err error) {
+	localTx := tx == nil
+	if localTx {
+		tx, err = d.db.Beginx()
+		if err != nil {
+			return fmt.Errorf("failed delete kit preset: %w", err)
+		}
+		defer func() {
+			if err != nil {
+				tx.Rollback()
+			} else {
+				tx.Commit()
+			}
+		}()
+	}
+
+	// channels and instruments are removed by cascade
+	res, err := tx.Exec("delete from kit_preset where id = ?", presetId)
+	if err != nil {
+		return fmt.Errorf("failed delete kit preset: %w", err)
+	}
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed delete kit preset: %w", err)
+	}
+	if cnt == 0 {
+		return fmt.Errorf("failed delete kit preset %d: %w", presetId, ErrNotFound)
+	}
+	return nil
+}
+
+func (d *Sqlite) RenamePreset(tx *sqlx.Tx, presetId int64, name string) (err error) {
+	localTx := tx == nil
+	if localTx {
+		tx, err = d.db.Beginx()
+		if err != nil {
+			return fmt.Errorf("failed rename kit preset: %w", err)
+		}
+		defer func() {
+			if err != nil {
+				tx.Rollback()
+			} else {
+				tx.Commit()
+			}
+		}()
+	}
+
+	res, err := tx.Exec("update kit_preset set name = ? where id = ?", name, presetId)
+	if err != nil {
+		return fmt.Errorf("failed rename kit preset: %w", wrapConstraintErr(err))
+	}
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed rename kit preset: %w", err)
+	}
+	if cnt == 0 {
+		return fmt.Errorf("failed rename kit preset %d: %w", presetId, ErrNotFound)
+	}
+	return nil
+}
+
+// presetsUsingInstruments selects presets which use any of instruments.
+// Presets of excluded kit are skipped
+func presetsUsingInstruments(excludeKit int64, instrIds []int64) Condition {
+	return func() (sql string, args []interface{}, err error) {
+		sql, args, err = sqlx.In("kit <> ? and exists (select 1 from preset_instrument pi where pi.preset = v_kit_preset.id and pi.instrument in (?))", excludeKit, instrIds)
+		return
+	}
+}
+
+// checkInstrumentsNotInUse returns *PresetsInUseError if presets (except presets of excluded kit) use any of instruments
+func checkInstrumentsNotInUse(tx *sqlx.Tx, excludeKit int64, instrIds []int64) error {
+	if len(instrIds) == 0 {
+		return nil
+	}
+	psts, err := listPresets(tx, Page{}, presetsUsingInstruments(excludeKit, instrIds))
+	if err != nil {
+		return err
+	}
+	if len(*psts) > 0 {
+		return &PresetsInUseError{Presets: *psts}
+	}
+	return nil
+}
+
+// Removes channels and instruments of preset. Preset itself is kept.
+// Used for replace preset content by StorePreset
+func (d *Sqlite) ClearPreset(tx *sqlx.Tx, presetId int64) (err error) {
+	localTx := tx == nil
+	if localTx {
+		tx, err = d.db.Beginx()
+		if err != nil {
+			return fmt.Errorf("failed clear kit preset: %w", err)
+		}
+		defer func() {
+			if err != nil {
+				tx.Rollback()
+			} else {
+				tx.Commit()
+			}
+		}()
+	}
+
+	// instruments are removed by cascade from channel
+	_, err = tx.Exec("delete from preset_channel where preset = ?", presetId)
+	if err != nil {
+		return fmt.Errorf("failed clear kit preset: %w", err)
+	}
+	return nil
+}
@@ -4,6 +4,11 @@ package db
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	m "github.com/raspidrum-srv/internal/model"
 )
 
 func TestSqlite_GetPreset(t *testing.T) {
@@ -145,3 +150,80 @@ func TestSqlite_ListPresets(t *testing.T) {
 		})
 	}
 }
+
+func TestSqlite_ListPresetsPage(t *testing.T) {
+	d, err := NewSqlite(getDBPath())
+	require.NoError(t, err)
+	defer d.Close()
+
+	count, err := d.CountPresets(ByKitId(1))
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	count, err = d.CountPresets(ByKitId(0))
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	got, err := d.ListPresetsPage(Page{Offset: 0, Limit: 1}, ByKitId(1))
+	require.NoError(t, err)
+	assert.Len(t, *got, 1)
+	got, err = d.ListPresetsPage(Page{Offset: 1, Limit: 1}, ByKitId(1))
+	require.NoError(t, err)
+	assert.Empty(t, *got)
+}
+
+func TestSqlite_StorePreset_MuteSolo(t *testing.T) {
+	d, err := NewSqlite(getTempDBPath(t))
+	require.NoError(t, err)
+	defer d.Close()
+
+	pst, err := d.GetPreset(ById(1))
+	require.NoError(t, err)
+	require.NotNil(t, pst)
+	require.NotEmpty(t, pst.Instruments)
+	pst.Channels[0].Mute = true
+	pst.Instruments[0].Solo = true
+
+	require.NoError(t, d.ClearPreset(nil, pst.Id))
+	_, err = d.StorePreset(nil, pst)
+	require.NoError(t, err)
+
+	got, err := d.GetPreset(ById(1))
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	for _, ch := range got.Channels {
+		assert.Equal(t, ch.Key == pst.Channels[0].Key, ch.Mute, ch.Key)
+		assert.False(t, ch.Solo, ch.Key)
+	}
+	for _, instr := range got.Instruments {
+		assert.Equal(t, instr.Name == pst.Instruments[0].Name, instr.Solo, instr.Name)
+		assert.False(t, instr.Mute, instr.Name)
+	}
+}
+
+func TestSqlite_StorePreset_Fxs(t *testing.T) {
+	d, err := NewSqlite(getTempDBPath(t))
+	require.NoError(t, err)
+	defer d.Close()
+
+	pst, err := d.GetPreset(ById(1))
+	require.NoError(t, err)
+	require.NotNil(t, pst)
+	pst.Fxs = []m.PresetFxChain{{
+		Key: "reverb",
+		Effects: []m.PresetFx{{
+			Key:    "plate",
+			Module: "/usr/lib/ladspa/caps.so",
+			Label:  "Plate",
+			Params: m.ControlMap{"p1": {Type: m.CtrlFxParam, Value: 0.7}},
+		}},
+	}}
+
+	require.NoError(t, d.ClearPreset(nil, pst.Id))
+	_, err = d.StorePreset(nil, pst)
+	require.NoError(t, err)
+
+	got, err := d.GetPreset(ById(1))
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, pst.Fxs, got.Fxs)
+}
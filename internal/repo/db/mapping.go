@@ -19,7 +19,7 @@ func kitToDb(kit *m.Kit) *KitDb {
 		Id:          kit.Id,
 		Uid:         kit.Uid,
 		Name:        kit.Name,
-		IsCustom:    1,
+		IsCustom:    boolToInt(kit.IsCustom),
 		Description: sql.NullString{Valid: true, String: kit.Description},
 		Copyright:   sql.NullString{Valid: true, String: kit.Copyright},
 		Licence:     sql.NullString{Valid: true, String: kit.Licence},
@@ -35,10 +35,11 @@ func dbToKit(kit *KitDb) *m.Kit {
 		tgl = strings.Split(kit.Tags.String, ",")
 	}
 	res := m.Kit{
-		Id:   kit.Id,
-		Uid:  kit.Uid,
-		Name: kit.Name,
-		Tags: tgl,
+		Id:       kit.Id,
+		Uid:      kit.Uid,
+		Name:     kit.Name,
+		IsCustom: kit.IsCustom == 1,
+		Tags:     tgl,
 	}
 	if kit.Description.Valid {
 		res.Description = kit.Description.String
@@ -58,6 +59,13 @@ func dbToKit(kit *KitDb) *m.Kit {
 	return &res
 }
 
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
 func instrumentToDb(instr *m.Instrument) *Instr {
 	// map tags
 	tgl := make([]InstrTag, len(instr.Tags))
@@ -172,6 +180,8 @@ func kitPresetToDb(pst *m.KitPreset) *KitPrst {
 		chs[i] = PrstChnl{
 			Key:  v.Key,
 			Name: v.Name,
+			Mute: boolToInt(v.Mute),
+			Solo: boolToInt(v.Solo),
 		}
 		// marshal controls to json
 		if len(v.Controls) > 0 {
@@ -193,6 +203,8 @@ func kitPresetToDb(pst *m.KitPreset) *KitPrst {
 			},
 			Name:       v.Name,
 			ChannelKey: v.ChannelKey,
+			Mute:       boolToInt(v.Mute),
+			Solo:       boolToInt(v.Solo),
 		}
 		if len(v.MidiKey) > 0 {
 			ins[i].MidiKey = sql.NullString{Valid: true, String: v.MidiKey}
@@ -216,6 +228,15 @@ func kitPresetToDb(pst *m.KitPreset) *KitPrst {
 	}
 	res.Instruments = ins
 
+	// marshal effect chains to json
+	if len(pst.Fxs) > 0 {
+		fxs, err := json.Marshal(pst.Fxs)
+		if err != nil {
+			slog.Error(fmt.Sprint(fmt.Errorf("failed convert to json preset fxs due storing to db: %w", err)))
+		}
+		res.Fxs = sql.NullString{Valid: true, String: string(fxs)}
+	}
+
 	return &res
 }
 
@@ -231,12 +252,22 @@ func dbToKitPreset(pst *KitPrst) *m.KitPreset {
 		},
 		Name: pst.Name,
 	}
+	if pst.Fxs.Valid && len(pst.Fxs.String) > 0 {
+		var fxs []m.PresetFxChain
+		err := json.Unmarshal([]byte(pst.Fxs.String), &fxs)
+		if err != nil {
+			slog.Error(fmt.Sprint(fmt.Errorf("failed convert preset fxs from json due loading from db: %w", err)))
+		}
+		res.Fxs = fxs
+	}
 	// channels
 	chs := make([]m.PresetChannel, len(pst.Channels))
 	for i, v := range pst.Channels {
 		chs[i] = m.PresetChannel{
 			Key:  v.Key,
 			Name: v.Name,
+			Mute: v.Mute == 1,
+			Solo: v.Solo == 1,
 		}
 		if len(v.Controls) > 0 {
 			var ctrls map[string]*m.PresetControl
@@ -262,6 +293,8 @@ func dbToKitPreset(pst *KitPrst) *m.KitPreset {
 			Id:         v.Id,
 			Name:       v.Name,
 			ChannelKey: v.ChannelKey,
+			Mute:       v.Mute == 1,
+			Solo:       v.Solo == 1,
 		}
 		if v.MidiKey.Valid {
 			ins[i].MidiKey = v.MidiKey.String
@@ -308,3 +341,29 @@ func dbToKitPreset(pst *KitPrst) *m.KitPreset {
 
 	return &res
 }
+
+func midiMapProfileToDb(prfl *m.MidiMapProfile) *MidiMapPrfl {
+	entries := make([]MidiMapEntry, 0, len(prfl.Notes))
+	for k, v := range prfl.Notes {
+		entries = append(entries, MidiMapEntry{Profile: prfl.Id, MidiKey: k, Note: v})
+	}
+	return &MidiMapPrfl{
+		Id:      prfl.Id,
+		Name:    prfl.Name,
+		Builtin: boolToInt(prfl.Builtin),
+		Entries: entries,
+	}
+}
+
+func dbToMidiMapProfile(prfl *MidiMapPrfl) *m.MidiMapProfile {
+	notes := make(map[string]int, len(prfl.Entries))
+	for _, v := range prfl.Entries {
+		notes[v.MidiKey] = v.Note
+	}
+	return &m.MidiMapProfile{
+		Id:      prfl.Id,
+		Name:    prfl.Name,
+		Builtin: prfl.Builtin == 1,
+		Notes:   notes,
+	}
+}
@@ -0,0 +1,257 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	m "github.com/raspidrum-srv/internal/model"
+)
+
+type MidiMapPrfl struct {
+	Id      int64  `db:"id"`
+	Name    string `db:"name"`
+	Builtin int    `db:"builtin"`
+	Entries []MidiMapEntry
+}
+
+type MidiMapEntry struct {
+	Profile int64  `db:"profile"`
+	MidiKey string `db:"midikey"`
+	Note    int    `db:"note"`
+}
+
+// Returns MIDI map profiles with their entries ordered by name
+func (d *Sqlite) ListMidiMapProfiles() (*[]m.MidiMapProfile, error) {
+	prfls := []MidiMapPrfl{}
+	if err := d.db.Select(&prfls, "select id, name, builtin from midi_map_profile order by name, id"); err != nil {
+		return nil, fmt.Errorf("failed ListMidiMapProfiles: %w", err)
+	}
+	entries := []MidiMapEntry{}
+	if err := d.db.Select(&entries, "select profile, midikey, note from midi_map_entry"); err != nil {
+		return nil, fmt.Errorf("failed ListMidiMapProfiles: %w", err)
+	}
+	idx := make(map[int64]*MidiMapPrfl, len(prfls))
+	for i := range prfls {
+		idx[prfls[i].Id] = &prfls[i]
+	}
+	for _, v := range entries {
+		if p, ok := idx[v.Profile]; ok {
+			p.Entries = append(p.Entries, v)
+		}
+	}
+
+	res := make([]m.MidiMapProfile, len(prfls))
+	for i := range prfls {
+		res[i] = *dbToMidiMapProfile(&prfls[i])
+	}
+	return &res, nil
+}
+
+// Returns one MIDI map profile with its entries. Fails with ErrNotFound if profile doesn't exist
+func (d *Sqlite) GetMidiMapProfile(conds ...Condition) (*m.MidiMapProfile, error) {
+	sql_where, args, err := buildConditions(conds...)
+	if err != nil {
+		return nil, fmt.Errorf("failed GetMidiMapProfile: %w", err)
+	}
+	prfls := []MidiMapPrfl{}
+	err = d.db.Select(&prfls, fmt.Sprintf("select id, name, builtin from midi_map_profile %s", sql_where), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed GetMidiMapProfile: %w", err)
+	}
+	if len(prfls) == 0 {
+		return nil, fmt.Errorf("failed GetMidiMapProfile: %w", ErrNotFound)
+	}
+	if len(prfls) > 1 {
+		return nil, fmt.Errorf("failed GetMidiMapProfile: found %d profiles. Must be one", len(prfls))
+	}
+	prfl := prfls[0]
+	err = d.db.Select(&prfl.Entries, "select profile, midikey, note from midi_map_entry where profile = ?", prfl.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed GetMidiMapProfile: %w", err)
+	}
+	return dbToMidiMapProfile(&prfl), nil
+}
+
+// Returns profile assigned to MIDI device or default profile, if device hasn't assigned profile
+func (d *Sqlite) GetDeviceMidiMapProfile(device string) (*m.MidiMapProfile, error) {
+	ids := []int64{}
+	if err := d.db.Select(&ids, "select profile from midi_device where name = ?", device); err != nil {
+		return nil, fmt.Errorf("failed GetDeviceMidiMapProfile: %w", err)
+	}
+	if len(ids) == 0 {
+		return d.GetMidiMapProfile(ByName(m.DefaultMidiMapProfile))
+	}
+	return d.GetMidiMapProfile(ById(ids[0]))
+}
+
+// Returns assigned profiles of MIDI devices. Key - device name, value - profile id
+func (d *Sqlite) ListMidiDeviceProfiles() (map[string]int64, error) {
+	rows := []struct {
+		Name    string `db:"name"`
+		Profile int64  `db:"profile"`
+	}{}
+	if err := d.db.Select(&rows, "select name, profile from midi_device"); err != nil {
+		return nil, fmt.Errorf("failed ListMidiDeviceProfiles: %w", err)
+	}
+	res := make(map[string]int64, len(rows))
+	for _, v := range rows {
+		res[v.Name] = v.Profile
+	}
+	return res, nil
+}
+
+func (d *Sqlite) StoreMidiMapProfile(tx *sqlx.Tx, prfl *m.MidiMapProfile) (prflId int64, err error) {
+	localTx := tx == nil
+	if localTx {
+		tx, err = d.db.Beginx()
+		if err != nil {
+			return 0, fmt.Errorf("failed store MIDI map profile: %w", err)
+		}
+		defer func() {
+			if err != nil {
+				tx.Rollback()
+			} else {
+				tx.Commit()
+			}
+		}()
+	}
+
+	prfldb := midiMapProfileToDb(prfl)
+	res, err := tx.NamedExec("insert into midi_map_profile(name, builtin) values(:name, :builtin)", prfldb)
+	if err != nil {
+		return 0, fmt.Errorf("failed store MIDI map profile: %w", wrapConstraintErr(err))
+	}
+	prflId, err = res.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed store MIDI map profile: %w", err)
+	}
+	if err = storeMidiMapEntries(tx, prflId, prfldb.Entries); err != nil {
+		return 0, err
+	}
+	return prflId, nil
+}
+
+// Renames profile and replaces its entries. Builtin profiles can't be updated
+func (d *Sqlite) UpdateMidiMapProfile(tx *sqlx.Tx, prfl *m.MidiMapProfile) (err error) {
+	localTx := tx == nil
+	if localTx {
+		tx, err = d.db.Beginx()
+		if err != nil {
+			return fmt.Errorf("failed update MIDI map profile: %w", err)
+		}
+		defer func() {
+			if err != nil {
+				tx.Rollback()
+			} else {
+				tx.Commit()
+			}
+		}()
+	}
+
+	if err = checkMidiMapProfileWritable(tx, prfl.Id); err != nil {
+		return fmt.Errorf("failed update MIDI map profile: %w", err)
+	}
+	prfldb := midiMapProfileToDb(prfl)
+	if _, err = tx.NamedExec("update midi_map_profile set name = :name where id = :id", prfldb); err != nil {
+		return fmt.Errorf("failed update MIDI map profile: %w", wrapConstraintErr(err))
+	}
+	if _, err = tx.Exec("delete from midi_map_entry where profile = ?", prfl.Id); err != nil {
+		return fmt.Errorf("failed update MIDI map profile: %w", err)
+	}
+	return storeMidiMapEntries(tx, prfl.Id, prfldb.Entries)
+}
+
+// Removes profile with its entries. Fails with ErrInUse if profile is assigned to MIDI devices
+func (d *Sqlite) DeleteMidiMapProfile(tx *sqlx.Tx, prflId int64) (err error) {
+	localTx := tx == nil
+	if localTx {
+		tx, err = d.db.Beginx()
+		if err != nil {
+			return fmt.Errorf("failed delete MIDI map profile: %w", err)
+		}
+		defer func() {
+			if err != nil {
+				tx.Rollback()
+			} else {
+				tx.Commit()
+			}
+		}()
+	}
+
+	if err = checkMidiMapProfileWritable(tx, prflId); err != nil {
+		return fmt.Errorf("failed delete MIDI map profile: %w", err)
+	}
+	devs := []string{}
+	if err = tx.Select(&devs, "select name from midi_device where profile = ?", prflId); err != nil {
+		return fmt.Errorf("failed delete MIDI map profile: %w", err)
+	}
+	if len(devs) > 0 {
+		return fmt.Errorf("failed delete MIDI map profile %d: assigned to devices %v: %w", prflId, devs, ErrInUse)
+	}
+	// entries are removed by cascade
+	if _, err = tx.Exec("delete from midi_map_profile where id = ?", prflId); err != nil {
+		return fmt.Errorf("failed delete MIDI map profile: %w", err)
+	}
+	return nil
+}
+
+// Assigns profile to MIDI device. Replaces previous assignment
+func (d *Sqlite) AssignMidiMapProfile(tx *sqlx.Tx, device string, prflId int64) (err error) {
+	localTx := tx == nil
+	if localTx {
+		tx, err = d.db.Beginx()
+		if err != nil {
+			return fmt.Errorf("failed assign MIDI map profile: %w", err)
+		}
+		defer func() {
+			if err != nil {
+				tx.Rollback()
+			} else {
+				tx.Commit()
+			}
+		}()
+	}
+
+	var cnt int
+	if err = tx.Get(&cnt, "select count(*) from midi_map_profile where id = ?", prflId); err != nil {
+		return fmt.Errorf("failed assign MIDI map profile: %w", err)
+	}
+	if cnt == 0 {
+		return fmt.Errorf("failed assign MIDI map profile %d: %w", prflId, ErrNotFound)
+	}
+	_, err = tx.Exec(`insert into midi_device(name, profile) values(?, ?)
+	on conflict(name) do update set profile = excluded.profile`, device, prflId)
+	if err != nil {
+		return fmt.Errorf("failed assign MIDI map profile: %w", err)
+	}
+	return nil
+}
+
+func storeMidiMapEntries(tx *sqlx.Tx, prflId int64, entries []MidiMapEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	for i := range entries {
+		entries[i].Profile = prflId
+	}
+	_, err := tx.NamedExec("insert into midi_map_entry(profile, midikey, note) values(:profile, :midikey, :note)", entries)
+	if err != nil {
+		return fmt.Errorf("failed store MIDI map entries: %w", err)
+	}
+	return nil
+}
+
+// checks that profile exists and isn't builtin
+func checkMidiMapProfileWritable(tx *sqlx.Tx, prflId int64) error {
+	builtin := []int{}
+	if err := tx.Select(&builtin, "select builtin from midi_map_profile where id = ?", prflId); err != nil {
+		return err
+	}
+	if len(builtin) == 0 {
+		return fmt.Errorf("profile %d: %w", prflId, ErrNotFound)
+	}
+	if builtin[0] == 1 {
+		return fmt.Errorf("profile %d is builtin: %w", prflId, ErrReadOnly)
+	}
+	return nil
+}
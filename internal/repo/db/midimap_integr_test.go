@@ -0,0 +1,74 @@
+//go:build integration
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	m "github.com/raspidrum-srv/internal/model"
+)
+
+func TestSqlite_ListMidiMapProfiles(t *testing.T) {
+	d, err := NewSqlite(getDBPath())
+	require.NoError(t, err)
+	defer d.Close()
+
+	prfls, err := d.ListMidiMapProfiles()
+	require.NoError(t, err)
+	notes := map[string]map[string]int{}
+	for _, v := range *prfls {
+		assert.True(t, v.Builtin, v.Name)
+		notes[v.Name] = v.Notes
+	}
+	require.Contains(t, notes, "Alesis")
+	require.Contains(t, notes, "GM")
+	require.Contains(t, notes, m.DefaultMidiMapProfile)
+	assert.Equal(t, 44, notes["Alesis"]["hihat_open"])
+	assert.Equal(t, 46, notes["GM"]["hihat_open"])
+	assert.Equal(t, 36, notes[m.DefaultMidiMapProfile]["kick1"])
+}
+
+func TestSqlite_MidiMapProfileCRUD(t *testing.T) {
+	d, err := NewSqlite(getTempDBPath(t))
+	require.NoError(t, err)
+	defer d.Close()
+
+	prfl := &m.MidiMapProfile{Name: "Roland TD", Notes: map[string]int{"kick1": 36, "snare": 38}}
+	id, err := d.StoreMidiMapProfile(nil, prfl)
+	require.NoError(t, err)
+	_, err = d.StoreMidiMapProfile(nil, prfl)
+	assert.ErrorIs(t, err, ErrAlreadyExists)
+
+	prfl.Id = id
+	prfl.Name = "Roland TD-17"
+	prfl.Notes = map[string]int{"kick1": 35}
+	require.NoError(t, d.UpdateMidiMapProfile(nil, prfl))
+	got, err := d.GetMidiMapProfile(ById(id))
+	require.NoError(t, err)
+	assert.Equal(t, prfl, got)
+
+	// device without profile uses default
+	dflt, err := d.GetDeviceMidiMapProfile("TD-17")
+	require.NoError(t, err)
+	assert.Equal(t, m.DefaultMidiMapProfile, dflt.Name)
+	require.NoError(t, d.AssignMidiMapProfile(nil, "TD-17", id))
+	got, err = d.GetDeviceMidiMapProfile("TD-17")
+	require.NoError(t, err)
+	assert.Equal(t, id, got.Id)
+	assert.ErrorIs(t, d.AssignMidiMapProfile(nil, "TD-17", 100500), ErrNotFound)
+
+	assert.ErrorIs(t, d.DeleteMidiMapProfile(nil, id), ErrInUse)
+	require.NoError(t, d.AssignMidiMapProfile(nil, "TD-17", dflt.Id))
+	require.NoError(t, d.DeleteMidiMapProfile(nil, id))
+	_, err = d.GetMidiMapProfile(ById(id))
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, d.DeleteMidiMapProfile(nil, id), ErrNotFound)
+
+	// builtin profiles are read only
+	dflt.Name = "changed"
+	assert.ErrorIs(t, d.UpdateMidiMapProfile(nil, dflt), ErrReadOnly)
+	assert.ErrorIs(t, d.DeleteMidiMapProfile(nil, dflt.Id), ErrReadOnly)
+}
@@ -7,9 +7,40 @@ import (
 	"strings"
 
 	"github.com/jmoiron/sqlx"
-	_ "github.com/mattn/go-sqlite3"
+	"github.com/mattn/go-sqlite3"
+
+	m "github.com/raspidrum-srv/internal/model"
+)
+
+var (
+	// ErrNotFound is returned when the modified or requested entity doesn't exist
+	ErrNotFound = errors.New("not found")
+	// ErrAlreadyExists is returned when an unique constraint is violated
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrInUse is returned when the deleted entity is referenced by other entities
+	ErrInUse = errors.New("in use")
+	// ErrReadOnly is returned when the modified entity is builtin
+	ErrReadOnly = errors.New("read only")
 )
 
+// PresetsInUseError is returned when deleted instruments are used by presets.
+// Matches ErrInUse
+type PresetsInUseError struct {
+	Presets []m.KitPreset
+}
+
+func (e *PresetsInUseError) Error() string {
+	names := make([]string, len(e.Presets))
+	for i, p := range e.Presets {
+		names[i] = fmt.Sprintf("'%s' of kit '%s'", p.Name, p.Kit.Name)
+	}
+	return fmt.Sprintf("instruments are used by presets: %s", strings.Join(names, ", "))
+}
+
+func (e *PresetsInUseError) Is(target error) bool {
+	return target == ErrInUse
+}
+
 type void struct{}
 type fieldMap map[string]void
 
@@ -63,3 +94,12 @@ func flatFieldMap(fs fieldMap) string {
 	}
 	return strings.Join(fss, ",")
 }
+
+// wraps sqlite unique constraint violation with ErrAlreadyExists
+func wrapConstraintErr(err error) error {
+	var sqlErr sqlite3.Error
+	if errors.As(err, &sqlErr) && (sqlErr.ExtendedCode == sqlite3.ErrConstraintUnique || sqlErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey) {
+		return fmt.Errorf("%w: %w", ErrAlreadyExists, err)
+	}
+	return err
+}
@@ -2,32 +2,36 @@ package file
 
 import (
 	"fmt"
-	"io/fs"
-	"os"
+	iofs "io/fs"
 	"path"
-	"path/filepath"
 	"strings"
 
 	"github.com/goccy/go-yaml"
+	"github.com/spf13/afero"
 
 	m "github.com/raspidrum-srv/internal/model"
 )
 
 // Parse kit directory with kit and instrument files
 func ParseYAMLDir(dir string) (map[string]interface{}, error) {
+	return ParseYAMLDirFs(dir, afero.NewOsFs())
+}
+
+// Parse kit directory with kit and instrument files on fs
+func ParseYAMLDirFs(dir string, fs afero.Fs) (map[string]interface{}, error) {
 	result := make(map[string]interface{})
 
-	err := filepath.WalkDir(dir, func(filepath string, d fs.DirEntry, err error) error {
+	err := afero.Walk(fs, dir, func(filepath string, info iofs.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 
 		// Пропускаем директории и не-YAML файлы
-		if d.IsDir() || !(strings.HasSuffix(filepath, ".yaml") || strings.HasSuffix(filepath, ".yml")) {
+		if info.IsDir() || !(strings.HasSuffix(filepath, ".yaml") || strings.HasSuffix(filepath, ".yml")) {
 			return nil
 		}
 
-		parsed, err := parseKitInstrument(filepath)
+		parsed, err := parseKitInstrument(filepath, fs)
 		if err != nil {
 			return err
 		}
@@ -41,8 +45,8 @@ func ParseYAMLDir(dir string) (map[string]interface{}, error) {
 }
 
 // Parse yaml file with kit or instrument
-func parseKitInstrument(path string) (interface{}, error) {
-	content, err := os.ReadFile(path)
+func parseKitInstrument(path string, fs afero.Fs) (interface{}, error) {
+	content, err := afero.ReadFile(fs, path)
 	if err != nil {
 		return nil, fmt.Errorf("error reading file %s: %w", path, err)
 	}
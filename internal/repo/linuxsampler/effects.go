@@ -0,0 +1,198 @@
+package linuxsampler
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+
+	m "github.com/raspidrum-srv/internal/model"
+	repo "github.com/raspidrum-srv/internal/repo"
+	lscp "github.com/raspidrum-srv/libs/liblscp-go"
+)
+
+func (l *LinuxSampler) SetEffectParam(fxInstId int, param int, value float32) error {
+	return l.Client.SetEffectInstanceParameter(fxInstId, param, value)
+}
+
+// buildEffects adds send effect chains of preset to audio output device and appends effect instances to them.
+// Stored values of params are set to instances. Building continues on errors: effect, which failed,
+// is skipped. Built chains and effects are returned with joined errors.
+// Must be called with locked sessionMu
+func (l *LinuxSampler) buildEffects(audioDevId int, preset *m.KitPreset) (map[string]int, repo.SamplerEffects, error) {
+	if len(preset.Fxs) == 0 {
+		return nil, nil, nil
+	}
+	chains := map[string]int{}
+	effects := repo.SamplerEffects{}
+	var errs []error
+	for _, chain := range preset.Fxs {
+		chainId, err := l.Client.AddSendEffectChain(audioDevId)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed add send effect chain '%s': %w", chain.Key, err))
+			continue
+		}
+		chains[chain.Key] = chainId
+		for _, fx := range chain.Effects {
+			eff, err := l.buildEffect(audioDevId, chainId, &fx)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed build effect '%s' of chain '%s': %w", fx.Key, chain.Key, err))
+			}
+			// effect appended to chain is kept for removal, even though its params failed
+			if eff != nil {
+				effects[fx.Key] = *eff
+			}
+		}
+	}
+	return chains, effects, errors.Join(errs...)
+}
+
+// buildEffect returns nil effect if instance isn't appended to chain
+func (l *LinuxSampler) buildEffect(audioDevId, chainId int, fx *m.PresetFx) (*repo.SamplerEffect, error) {
+	instId, err := l.Client.CreateEffectInstanceByAttrs(fx.GetSystem(), fx.Module, fx.Label)
+	if err != nil {
+		return nil, fmt.Errorf("failed create effect instance: %w", err)
+	}
+	if err = l.Client.AppendEffectInstance(audioDevId, chainId, instId); err != nil {
+		l.Client.DestroyEffectInstance(instId)
+		return nil, fmt.Errorf("failed append effect instance %d: %w", instId, err)
+	}
+	eff := &repo.SamplerEffect{ChainId: chainId, InstanceId: instId}
+	info, err := l.Client.GetEffectInstanceInfo(instId)
+	if err != nil {
+		return eff, fmt.Errorf("failed get info of effect instance %d: %w", instId, err)
+	}
+	eff.Params = make([]m.FxParam, len(info.Params))
+	for i, prm := range info.Params {
+		eff.Params[i] = fxParam(prm)
+	}
+	values := fx.ParamValues()
+	for _, i := range slices.Sorted(maps.Keys(values)) {
+		if i >= len(eff.Params) {
+			continue
+		}
+		if err = l.Client.SetEffectInstanceParameter(instId, i, values[i]); err != nil {
+			return eff, fmt.Errorf("failed set param %d of effect instance %d: %w", i, instId, err)
+		}
+		eff.Params[i].Value = values[i]
+	}
+	return eff, nil
+}
+
+func fxParam(prm lscp.Parameter[float32]) m.FxParam {
+	res := m.FxParam{
+		Description:   prm.Description,
+		Value:         prm.Value,
+		Possibilities: prm.Possibilities,
+	}
+	if ok, v := prm.RangeMin(); ok {
+		res.Min = &v
+	}
+	if ok, v := prm.RangeMax(); ok {
+		res.Max = &v
+	}
+	return res
+}
+
+// removeEffects removes send effect chains from audio output device and destroys their effect instances.
+// Removal continues on errors, they are joined.
+// Must be called with locked sessionMu
+func (l *LinuxSampler) removeEffects(audioDevId int, chains map[string]int, effects repo.SamplerEffects) error {
+	var errs []error
+	for _, k := range slices.Sorted(maps.Keys(chains)) {
+		if err := l.Client.RemoveSendEffectChain(audioDevId, chains[k]); err != nil {
+			errs = append(errs, fmt.Errorf("failed remove send effect chain %d: %w", chains[k], err))
+		}
+	}
+	for _, k := range slices.Sorted(maps.Keys(effects)) {
+		if err := l.Client.DestroyEffectInstance(effects[k].InstanceId); err != nil {
+			errs = append(errs, fmt.Errorf("failed destroy effect instance %d: %w", effects[k].InstanceId, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// replaceEffects removes effects of previous session and builds effects of preset into the current session.
+// Effects don't fail loading of preset, errors are logged.
+// Must be called with locked sessionMu
+func (l *LinuxSampler) replaceEffects(prev *repo.SamplerSession, preset *m.KitPreset) {
+	if prev != nil {
+		// sends of previous channels don't refer to removed chains
+		if err := l.detachFxSends(prev.Channels); err != nil {
+			slog.Warn("failed detach FX sends of previous preset", slog.Int64("presetId", prev.PresetId), slog.Any("error", err))
+		}
+		if err := l.removeEffects(prev.AudioDevId, prev.FxChains, prev.Effects); err != nil {
+			slog.Warn("failed remove effects of previous preset", slog.Int64("presetId", prev.PresetId), slog.Any("error", err))
+		}
+	}
+	chains, effects, err := l.buildEffects(l.session.AudioDevId, preset)
+	if err != nil {
+		slog.Warn("failed build effects of preset", slog.Int64("presetId", preset.Id), slog.Any("error", err))
+	}
+	l.session.FxChains = chains
+	l.session.Effects = effects
+	if err = l.attachFxSends(l.session.Channels, chains); err != nil {
+		slog.Warn("failed attach FX sends of preset", slog.Int64("presetId", preset.Id), slog.Any("error", err))
+	}
+}
+
+// createFxSends creates FX sends of channel with their levels. Send level is regulated by MIDI CC of send control.
+// Sends are attached to effect chains on activation of preset, errors are logged
+func (l *LinuxSampler) createFxSends(chnlId int, cv *m.PresetChannel) {
+	sends := cv.Sends()
+	for _, k := range slices.Sorted(maps.Keys(sends)) {
+		ctrl := sends[k]
+		sendId, err := l.Client.CreateFxSend(chnlId, ctrl.MidiCC, k)
+		if err != nil {
+			slog.Warn("failed create FX send", slog.String("channel", cv.Key), slog.String("send", k), slog.Any("error", err))
+			continue
+		}
+		l.sessionMu.Lock()
+		if l.fxSends == nil {
+			l.fxSends = map[int]map[string]int{}
+		}
+		if l.fxSends[chnlId] == nil {
+			l.fxSends[chnlId] = map[string]int{}
+		}
+		l.fxSends[chnlId][k] = sendId
+		l.sessionMu.Unlock()
+		if err = l.Client.SetFxSendLevel(chnlId, sendId, ctrl.Value/127); err != nil {
+			slog.Warn("failed set FX send level", slog.String("channel", cv.Key), slog.String("send", k), slog.Any("error", err))
+		}
+	}
+}
+
+// attachFxSends sends channels to effect chains with the same key as send.
+// Must be called with locked sessionMu
+func (l *LinuxSampler) attachFxSends(chnls repo.SamplerChannels, chains map[string]int) error {
+	var errs []error
+	for _, chnlId := range slices.Sorted(maps.Values(chnls)) {
+		sends := l.fxSends[chnlId]
+		for _, k := range slices.Sorted(maps.Keys(sends)) {
+			chainId, ok := chains[k]
+			if !ok {
+				continue
+			}
+			if err := l.Client.SetFxSendEffect(chnlId, sends[k], chainId, 0); err != nil {
+				errs = append(errs, fmt.Errorf("failed attach FX send '%s' of channel %d: %w", k, chnlId, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// detachFxSends removes destination effect of channel sends.
+// Must be called with locked sessionMu
+func (l *LinuxSampler) detachFxSends(chnls repo.SamplerChannels) error {
+	var errs []error
+	for _, chnlId := range slices.Sorted(maps.Values(chnls)) {
+		sends := l.fxSends[chnlId]
+		for _, k := range slices.Sorted(maps.Keys(sends)) {
+			if err := l.Client.RemoveFxSendEffect(chnlId, sends[k]); err != nil {
+				errs = append(errs, fmt.Errorf("failed detach FX send '%s' of channel %d: %w", k, chnlId, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,128 @@
+package linuxsampler
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	m "github.com/raspidrum-srv/internal/model"
+	repo "github.com/raspidrum-srv/internal/repo"
+	"github.com/raspidrum-srv/libs/liblscp-go"
+)
+
+func TestLinuxSampler_replaceEffects(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+	mockServer := startMockPipeServer(serverConn)
+	l := &LinuxSampler{
+		Client:       liblscp.NewClientWithDriver(&mockLscpDriver{conn: clientConn}),
+		audioOutputs: []int{3},
+	}
+
+	prev := &repo.SamplerSession{
+		PresetId:   1,
+		AudioDevId: 3,
+		FxChains:   map[string]int{"reverb": 5},
+		Effects:    repo.SamplerEffects{"hall": {ChainId: 5, InstanceId: 7}},
+	}
+	pst := &m.KitPreset{Id: 2, Fxs: []m.PresetFxChain{{
+		Key: "reverb",
+		Effects: []m.PresetFx{{
+			Key:    "plate",
+			Module: "/usr/lib/ladspa/caps.so",
+			Label:  "Plate",
+			Params: m.ControlMap{"p1": {Type: m.CtrlFxParam, Value: 0.7}},
+		}},
+	}}}
+	l.session = &repo.SamplerSession{PresetId: 2, AudioDevId: 3}
+	l.replaceEffects(prev, pst)
+
+	sess, ok := l.Session()
+	require.True(t, ok)
+	assert.Equal(t, map[string]int{"reverb": 0}, sess.FxChains)
+	var min, max float32 = 0, 1
+	assert.Equal(t, repo.SamplerEffects{"plate": {ChainId: 0, InstanceId: 0, Params: []m.FxParam{
+		{Description: "param 0", Value: 0.5, Min: &min, Max: &max},
+		{Description: "param 1", Value: 0.7, Min: &min, Max: &max},
+	}}}, sess.Effects)
+
+	require.NoError(t, l.SetEffectParam(0, 0, 0.25))
+	require.NoError(t, l.CloseSession())
+
+	time.Sleep(100 * time.Millisecond)
+	mockServer.stop()
+	assert.Equal(t, []string{
+		"REMOVE SEND_EFFECT_CHAIN 3 5",
+		"DESTROY EFFECT_INSTANCE 7",
+		"ADD SEND_EFFECT_CHAIN 3",
+		"CREATE EFFECT_INSTANCE LADSPA '/usr/lib/ladspa/caps.so' 'Plate'",
+		"APPEND SEND_EFFECT_CHAIN EFFECT 3 0 0",
+		"GET EFFECT_INSTANCE INFO 0",
+		"GET EFFECT_INSTANCE_INPUT_CONTROL INFO 0 0",
+		"GET EFFECT_INSTANCE_INPUT_CONTROL INFO 0 1",
+		// stored value
+		"SET EFFECT_INSTANCE_INPUT_CONTROL VALUE 0 1 0.70",
+		"SET EFFECT_INSTANCE_INPUT_CONTROL VALUE 0 0 0.25",
+		// effects are removed before audio output device
+		"REMOVE SEND_EFFECT_CHAIN 3 0",
+		"DESTROY EFFECT_INSTANCE 0",
+		"DESTROY AUDIO_OUTPUT_DEVICE 3",
+	}, mockServer.getMessages())
+}
+
+func TestLinuxSampler_FxSends(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+	mockServer := startMockPipeServer(serverConn)
+	l := &LinuxSampler{
+		Client: liblscp.NewClientWithDriver(&mockLscpDriver{conn: clientConn}),
+		Engine: "sfz",
+	}
+
+	pst := &m.KitPreset{
+		Id: 2,
+		Channels: []m.PresetChannel{{Key: "1", Controls: m.ControlMap{
+			"reverb": {Type: m.CtrlSend, MidiCC: 91, Value: 63.5},
+		}}},
+		Fxs: []m.PresetFxChain{{Key: "reverb", Effects: []m.PresetFx{{Key: "plate", Module: "caps.so", Label: "Plate"}}}},
+	}
+	chnls, err := l.loadToSampler(3, 4, pst, map[string]string{"channel_1": "/presets/2/channel_1.sfz"}, true)
+	require.NoError(t, err)
+
+	// previous preset stays preloaded, its sends are detached from removed chains
+	prev := &repo.SamplerSession{PresetId: 1, AudioDevId: 3, Channels: repo.SamplerChannels{"1": 7}, FxChains: map[string]int{"reverb": 5}}
+	l.fxSends[7] = map[string]int{"reverb": 0}
+	l.session = &repo.SamplerSession{PresetId: 2, AudioDevId: 3, Channels: chnls}
+	l.replaceEffects(prev, pst)
+
+	require.NoError(t, l.removeChannels(chnls))
+	assert.Equal(t, map[int]map[string]int{7: {"reverb": 0}}, l.fxSends)
+
+	time.Sleep(100 * time.Millisecond)
+	mockServer.stop()
+	assert.Equal(t, []string{
+		"ADD CHANNEL",
+		"SET CHANNEL AUDIO_OUTPUT_DEVICE 0 3",
+		"SET CHANNEL MIDI_INPUT_DEVICE 0 4",
+		"LOAD ENGINE sfz 0",
+		"SET CHANNEL MUTE 0 1",
+		"LOAD INSTRUMENT '/presets/2/channel_1.sfz' 0 0",
+		"CREATE FX_SEND 0 91 'reverb'",
+		"SET FX_SEND LEVEL 0 0 0.50",
+		"REMOVE FX_SEND EFFECT 7 0",
+		"REMOVE SEND_EFFECT_CHAIN 3 5",
+		"ADD SEND_EFFECT_CHAIN 3",
+		"CREATE EFFECT_INSTANCE LADSPA 'caps.so' 'Plate'",
+		"APPEND SEND_EFFECT_CHAIN EFFECT 3 0 0",
+		"GET EFFECT_INSTANCE INFO 0",
+		"GET EFFECT_INSTANCE_INPUT_CONTROL INFO 0 0",
+		"GET EFFECT_INSTANCE_INPUT_CONTROL INFO 0 1",
+		"SET FX_SEND EFFECT 0 0 0 0",
+		"REMOVE CHANNEL 0",
+	}, mockServer.getMessages())
+}
@@ -0,0 +1,63 @@
+package linuxsampler
+
+import (
+	"fmt"
+
+	lscp "github.com/raspidrum-srv/libs/liblscp-go"
+)
+
+// OnMidiCC sets handler of MIDI CC received by sampler channels, i.e. from knobs of drum module.
+// Handler is called on event reader of LSCP client, so it must not block.
+// Subscription is restored after reconnect by client
+func (l *LinuxSampler) OnMidiCC(f func(samplerChn int, cc int, value int)) error {
+	_, err := l.Client.Subscribe(midiCCHandler(f), lscp.EventChannelMidi)
+	if err != nil {
+		return fmt.Errorf("failed subscribe to MIDI CC: %w", err)
+	}
+	return nil
+}
+
+func midiCCHandler(f func(samplerChn int, cc int, value int)) lscp.EventHandler {
+	return func(e lscp.Event) {
+		evt, ok := e.(lscp.ChannelMidiEvent)
+		if !ok || evt.MidiType != lscp.MidiControlChange {
+			return
+		}
+		f(evt.Channel, evt.Data1, evt.Data2)
+	}
+}
+
+// OnMidiNote sets handler of MIDI note-on received by sampler channels, i.e. hits of drum module pads.
+// Handler is called on event reader of LSCP client, so it must not block
+func (l *LinuxSampler) OnMidiNote(f func(samplerChn int, note int, velocity int)) error {
+	_, err := l.Client.Subscribe(midiNoteHandler(f), lscp.EventChannelMidi)
+	if err != nil {
+		return fmt.Errorf("failed subscribe to MIDI notes: %w", err)
+	}
+	return nil
+}
+
+func midiNoteHandler(f func(samplerChn int, note int, velocity int)) lscp.EventHandler {
+	return func(e lscp.Event) {
+		evt, ok := e.(lscp.ChannelMidiEvent)
+		// note-on with zero velocity is note-off
+		if !ok || evt.MidiType != lscp.MidiNoteOn || evt.Data2 == 0 {
+			return
+		}
+		f(evt.Channel, evt.Data1, evt.Data2)
+	}
+}
+
+// OnVoiceCount sets handler of changes of playing voices count of sampler channels.
+// Handler is called on event reader of LSCP client, so it must not block
+func (l *LinuxSampler) OnVoiceCount(f func(samplerChn int, count int)) error {
+	_, err := l.Client.Subscribe(func(e lscp.Event) {
+		if evt, ok := e.(lscp.VoiceCountEvent); ok {
+			f(evt.Channel, evt.Count)
+		}
+	}, lscp.EventVoiceCount)
+	if err != nil {
+		return fmt.Errorf("failed subscribe to voice count: %w", err)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package linuxsampler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"runtime"
@@ -11,6 +12,7 @@ import (
 	repo "github.com/raspidrum-srv/internal/repo"
 	"github.com/raspidrum-srv/internal/repo/dbus"
 	lscp "github.com/raspidrum-srv/libs/liblscp-go"
+	"github.com/spf13/afero"
 )
 
 // Engine - LinuxSampler engine: gig, sfz, sf2
@@ -18,12 +20,27 @@ type LinuxSampler struct {
 	Client  lscp.Client
 	Engine  string
 	DataDir string // root dir for sfz-files, samples and presets
+	// fs of DataDir. Used to remove generated preset files of presets, which aren't in sampler anymore
+	Fs afero.Fs
 	// Systemd is used to control and check the state of the linuxsampler systemd service. Linux only
 	Systemd dbus.SystemdManager
 
-	//clientMu          sync.Mutex
 	healthcheckCancel context.CancelFunc
 	healthcheckWg     sync.WaitGroup
+
+	// devices and channels created in sampler. Removed by CloseSession
+	sessionMu    sync.Mutex
+	audioOutputs []int
+	midiInputs   []int
+	channels     []int
+	// channels of the loaded preset
+	session *repo.SamplerSession
+	// channels of preloaded presets. Key - preset id
+	preloaded map[int64]repo.SamplerChannels
+	// FX sends of sampler channels. Key - sampler channel id, value - send id by key of effect chain
+	fxSends map[int]map[string]int
+	// called by health check after reconnect
+	onReconnect func()
 }
 
 func InitLinuxSampler(samplesPath string) (*LinuxSampler, error) {
@@ -32,6 +49,7 @@ func InitLinuxSampler(samplesPath string) (*LinuxSampler, error) {
 	sampler := LinuxSampler{
 		Engine:  "sfz",
 		DataDir: samplesPath,
+		Fs:      afero.NewOsFs(),
 	}
 
 	if runtime.GOOS == "linux" {
@@ -70,59 +88,121 @@ func InitLinuxSampler(samplesPath string) (*LinuxSampler, error) {
 	return &sampler, nil
 }
 
-// Connect
-// params grouped by audio channels. Audio channel is key of map
-func (l *LinuxSampler) ConnectAudioOutput(driver string, params map[int][]repo.Param[string]) (devId int, err error) {
-	devId, err = l.Client.CreateAudioOutputDevice(driver)
+// Returns audio output drivers with their parameters
+func (l *LinuxSampler) GetAudioOutputDrivers() ([]repo.AudioDriver, error) {
+	drvs, err := l.Client.GetAudioOutputDrivers()
 	if err != nil {
-		return
+		return nil, fmt.Errorf("failed get audio output drivers: %w", err)
 	}
-	if len(params) != 0 {
-		// key (k) - channelId
-		// value (v) - array of channel params
-		for k, v := range params {
-			for _, p := range v {
-				prm := lscp.Parameter[any]{
-					Name:  p.Name,
-					Value: p.Value,
-				}
-				err = l.Client.SetAudioOutputChannelParameter(devId, k, prm)
-				if err != nil {
-					return
-				}
+	res := make([]repo.AudioDriver, len(drvs))
+	for i, d := range drvs {
+		res[i] = repo.AudioDriver{
+			Name:        d.Name,
+			Description: d.Description,
+			Version:     d.Version,
+			Params:      make([]repo.DriverParam, len(d.Params)),
+		}
+		for j, p := range d.Params {
+			prm := repo.DriverParam{
+				Name:          p.Name,
+				Description:   p.Description,
+				Type:          lscp.ParameterToName[p.Type],
+				Mandatory:     p.IsMandatory(),
+				Multiplicity:  p.IsMultiplicity,
+				Possibilities: p.Possibilities,
+			}
+			if len(p.Default) > 0 {
+				prm.Default = &p.Default
 			}
+			res[i].Params[j] = prm
 		}
 	}
-	return
+	return res, nil
 }
 
-// Connect to MIDI port and optional set port parameters (i.e. bindings)
-func (l *LinuxSampler) ConnectMidiInput(driver string, params []repo.Param[string]) (devId int, err error) {
-	devId, err = l.Client.CreateMidiInputDevice(driver)
+// Creates audio output device with device parameters and sets parameters of its audio channels
+func (l *LinuxSampler) ConnectAudioOutput(out repo.AudioOutput) (devId int, err error) {
+	params := make([]lscp.Parameter[any], len(out.Params))
+	for i, p := range out.Params {
+		params[i] = lscp.Parameter[any]{
+			Name:  p.Name,
+			Value: p.Value,
+		}
+	}
+	devId, err = l.Client.CreateAudioOutputDevice(out.Driver, params...)
 	if err != nil {
 		return
 	}
-	if len(params) != 0 {
-		for _, p := range params {
+	l.sessionMu.Lock()
+	l.audioOutputs = append(l.audioOutputs, devId)
+	l.sessionMu.Unlock()
+
+	// key (k) - channelId
+	// value (v) - array of channel params
+	for k, v := range out.Channels {
+		for _, p := range v {
 			prm := lscp.Parameter[any]{
 				Name:  p.Name,
 				Value: p.Value,
 			}
-			err = l.Client.SetMidiInputPortParameter(devId, 0, prm)
+			err = l.Client.SetAudioOutputChannelParameter(devId, k, prm)
 			if err != nil {
 				return
 			}
 		}
 	}
+	return
+}
 
+// Connect to MIDI port and optional set port parameters (i.e. bindings)
+func (l *LinuxSampler) ConnectMidiInput(driver string, params []repo.Param[string]) (devId int, err error) {
+	devId, err = l.Client.CreateMidiInputDevice(driver)
+	if err != nil {
+		return
+	}
+	l.sessionMu.Lock()
+	l.midiInputs = append(l.midiInputs, devId)
+	l.sessionMu.Unlock()
+
+	err = l.setMidiInputParams(devId, params)
 	return
 }
 
+// Sets port parameters (i.e. bindings) of MIDI input devices created by ConnectMidiInput.
+// Used to bind reconnected MIDI devices without reloading of preset
+func (l *LinuxSampler) RebindMidiInputs(params []repo.Param[string]) error {
+	l.sessionMu.Lock()
+	defer l.sessionMu.Unlock()
+	for _, devId := range l.midiInputs {
+		if err := l.setMidiInputParams(devId, params); err != nil {
+			return fmt.Errorf("failed rebind MIDI input %d: %w", devId, err)
+		}
+	}
+	return nil
+}
+
+func (l *LinuxSampler) setMidiInputParams(devId int, params []repo.Param[string]) error {
+	for _, p := range params {
+		prm := lscp.Parameter[any]{
+			Name:  p.Name,
+			Value: p.Value,
+		}
+		if err := l.Client.SetMidiInputPortParameter(devId, 0, prm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (l *LinuxSampler) CreateChannel(audioDevId, midiDevId int) (channelId int, err error) {
 	channelId, err = l.Client.AddSamplerChannel()
 	if err != nil {
 		return
 	}
+	l.sessionMu.Lock()
+	l.channels = append(l.channels, channelId)
+	l.sessionMu.Unlock()
+
 	err = l.Client.SetChannelAudioOutputDevice(channelId, audioDevId)
 	if err != nil {
 		return
@@ -146,6 +226,14 @@ func (l *LinuxSampler) SetChannelVolume(samplerChn int, volume float32) error {
 	return l.Client.SetChannelVolume(samplerChn, volume)
 }
 
+func (l *LinuxSampler) SetChannelMute(samplerChn int, mute bool) error {
+	return l.Client.SetChannelMute(samplerChn, mute)
+}
+
+func (l *LinuxSampler) SetChannelSolo(samplerChn int, solo bool) error {
+	return l.Client.SetChannelSolo(samplerChn, solo)
+}
+
 func (l *LinuxSampler) SendMidiCC(samplerChn int, cc int, value float32) error {
 	return l.Client.SendChannelMidiData(samplerChn, "CC", cc, int(value))
 }
@@ -175,8 +263,16 @@ func (l *LinuxSampler) EnsureLinuxSamplerRunning(ctx context.Context) error {
 	return nil
 }
 
+// max time of waiting for ping result. Ping is queued after concurrent commands, e.g. modal LOAD INSTRUMENT
+var healthcheckPingTimeout = 5 * time.Second
+
+// count of consecutive ping timeouts, after which busy sampler is considered hung and is reconnected.
+// About a minute with default ping timeout
+var healthcheckMaxPingTimeouts = 12
+
 // StartHealthCheck launches a background goroutine that checks the connection to LinuxSampler every 2 seconds.
 // On connection loss, it attempts to restart the service and reconnect the client as needed.
+// Ping, which doesn't get result in time, isn't connection loss, unless it repeats healthcheckMaxPingTimeouts times.
 // hc — клиент для healthcheck (может быть mock в тестах). Если nil, используется l.Client.
 func (l *LinuxSampler) StartHealthCheck(ctx context.Context) {
 	if l.healthcheckCancel != nil {
@@ -190,32 +286,46 @@ func (l *LinuxSampler) StartHealthCheck(ctx context.Context) {
 		defer l.healthcheckWg.Done()
 		ticker := time.NewTicker(2 * time.Second)
 		defer ticker.Stop()
+		timeouts := 0
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case <-ticker.C:
-				//l.clientMu.Lock()
-				client := l.Client
-				//l.clientMu.Unlock()
-				err := client.Ping()
+				// client is goroutine-safe: ping is queued with concurrent commands and never gets their results
+				pingCtx, cancel := context.WithTimeout(ctx, healthcheckPingTimeout)
+				err := l.Client.WithContext(pingCtx).Ping()
+				cancel()
 				if err == nil {
+					timeouts = 0
 					continue
 				}
+				// ping timed out behind running commands: sampler is busy, not lost.
+				// Connection isn't replaced, otherwise running commands fail
+				if !errors.Is(err, lscp.ErrConnection) {
+					timeouts++
+					if timeouts < healthcheckMaxPingTimeouts {
+						slog.Debug("[HealthCheck] linuxsampler is busy", slog.Any("error", err))
+						continue
+					}
+					slog.Warn("[HealthCheck] linuxsampler doesn't answer", slog.Int("pings", timeouts), slog.Any("error", err))
+				}
+				timeouts = 0
 				// Connection lost, try to recover
 				recoverCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
-				defer cancel()
 				errRun := l.EnsureLinuxSamplerRunning(recoverCtx)
+				cancel()
 				if errRun != nil {
 					slog.Error("[HealthCheck] Failed to ensure linuxsampler running", slog.Any("error", errRun))
 					continue
 				}
-				// If service was restarted, need reconnect
-				if err := client.Connect(); err != nil {
+				// If service was restarted, need reconnect. Commands waiting on lost connection fail
+				if err := l.Client.Connect(); err != nil {
 					slog.Error("[HealthCheck] Failed to reconnect to linuxsampler", slog.Any("error", err))
 					continue
 				}
 				slog.Info("[HealthCheck] Reconnected to linuxsampler")
+				l.reconnected()
 			}
 		}
 	}()
@@ -1,12 +1,17 @@
 package linuxsampler
 
 import (
+	"bufio"
 	"context"
 	"errors"
+	"fmt"
+	"net"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	repo "github.com/raspidrum-srv/internal/repo"
 	"github.com/raspidrum-srv/libs/liblscp-go"
 )
 
@@ -31,6 +36,9 @@ func (m *mockSystemdManager) WaitForServiceActive(ctx context.Context, name stri
 	return nil
 }
 
+// error of ping on lost connection
+var errConnectionLost = fmt.Errorf("%w: EOF", liblscp.ErrConnection)
+
 func TestHealthCheck_Success(t *testing.T) {
 	lscpDrv := &mockLscpDriver{}
 	lscpDrv.pingErr.Store(errNoError)
@@ -51,7 +59,7 @@ func TestHealthCheck_Success(t *testing.T) {
 
 func TestHealthCheck_ReconnectOnFailure(t *testing.T) {
 	lscpDrv := &mockLscpDriver{}
-	lscpDrv.pingErr.Store(errors.New("fail"))
+	lscpDrv.pingErr.Store(errConnectionLost)
 	lscpDrv.connectErr.Store(errNoError)
 	client := liblscp.NewClientWithDriver(lscpDrv)
 
@@ -75,7 +83,7 @@ func TestHealthCheck_ReconnectOnFailure(t *testing.T) {
 
 func TestHealthCheck_EnsureFail(t *testing.T) {
 	lscpDrv := &mockLscpDriver{}
-	lscpDrv.pingErr.Store(errors.New("fail"))
+	lscpDrv.pingErr.Store(errConnectionLost)
 	lscpDrv.connectErr.Store(errNoError)
 	client := liblscp.NewClientWithDriver(lscpDrv)
 
@@ -97,3 +105,144 @@ func TestHealthCheck_EnsureFail(t *testing.T) {
 		t.Error("Client should not be reconnected if EnsureLinuxSamplerRunning fails")
 	}
 }
+
+func TestHealthCheck_OnReconnect(t *testing.T) {
+	lscpDrv := &mockLscpDriver{}
+	lscpDrv.pingErr.Store(errConnectionLost)
+	lscpDrv.connectErr.Store(errNoError)
+
+	s := &LinuxSampler{
+		Client:   liblscp.NewClientWithDriver(lscpDrv),
+		Systemd:  &mockSystemdManager{},
+		channels: []int{0, 1},
+		session:  &repo.SamplerSession{PresetId: 1, Channels: repo.SamplerChannels{"1": 0, "2": 1}},
+	}
+	var called atomic.Bool
+	s.OnReconnect(func() {
+		// session of restarted sampler is closed before handler
+		if _, ok := s.Session(); ok {
+			t.Error("Session should be closed before reconnect handler")
+		}
+		called.Store(true)
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.StartHealthCheck(ctx)
+	time.Sleep(2500 * time.Millisecond)
+	s.StopHealthCheck()
+	if !called.Load() {
+		t.Error("Reconnect handler should be called")
+	}
+}
+
+func TestHealthCheck_Hung(t *testing.T) {
+	timeout, maxTimeouts := healthcheckPingTimeout, healthcheckMaxPingTimeouts
+	healthcheckPingTimeout, healthcheckMaxPingTimeouts = 100*time.Millisecond, 2
+	defer func() { healthcheckPingTimeout, healthcheckMaxPingTimeouts = timeout, maxTimeouts }()
+
+	lscpDrv := &mockLscpDriver{}
+	lscpDrv.pingHang.Store(true)
+	lscpDrv.connectErr.Store(errNoError)
+	s := &LinuxSampler{
+		Client:  liblscp.NewClientWithDriver(lscpDrv),
+		Systemd: &mockSystemdManager{},
+	}
+	var called atomic.Bool
+	s.OnReconnect(func() { called.Store(true) })
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the first timeout is considered busy sampler
+	s.StartHealthCheck(ctx)
+	time.Sleep(2500 * time.Millisecond)
+	if lscpDrv.connected.Load() {
+		t.Error("Client should not be reconnected after the first ping timeout")
+	}
+	time.Sleep(2 * time.Second)
+	s.StopHealthCheck()
+	if !lscpDrv.connected.Load() {
+		t.Error("Client should be reconnected to hung sampler")
+	}
+	if !called.Load() {
+		t.Error("Reconnect handler should be called for hung sampler")
+	}
+}
+
+// slowLscpServer answers LOAD INSTRUMENT after delay. Commands are answered in order like LinuxSampler does
+type slowLscpServer struct {
+	ln    net.Listener
+	delay time.Duration
+	conns atomic.Int32
+}
+
+func (s *slowLscpServer) serve() {
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		s.conns.Add(1)
+		go func() {
+			defer conn.Close()
+			rd := bufio.NewReader(conn)
+			for {
+				ln, err := rd.ReadString('\n')
+				if err != nil {
+					return
+				}
+				switch {
+				case strings.HasPrefix(ln, "LOAD INSTRUMENT"):
+					time.Sleep(s.delay)
+					fmt.Fprint(conn, "OK\r\n")
+				case strings.HasPrefix(ln, "GET SERVER INFO"):
+					fmt.Fprint(conn, "DESCRIPTION: LinuxSampler\r\n.\r\n")
+				default:
+					fmt.Fprint(conn, "OK\r\n")
+				}
+			}
+		}()
+	}
+}
+
+func TestHealthCheck_Busy(t *testing.T) {
+	timeout := healthcheckPingTimeout
+	healthcheckPingTimeout = 100 * time.Millisecond
+	defer func() { healthcheckPingTimeout = timeout }()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	srv := &slowLscpServer{ln: ln, delay: 3 * time.Second}
+	go srv.serve()
+	_, port, _ := net.SplitHostPort(ln.Addr().String())
+	client := liblscp.NewClient("127.0.0.1", port, "1s")
+	if err := client.Connect(); err != nil {
+		t.Fatal(err)
+	}
+
+	s := &LinuxSampler{
+		Client:  client,
+		Systemd: &mockSystemdManager{},
+	}
+	var called atomic.Bool
+	s.OnReconnect(func() { called.Store(true) })
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// ping is queued after modal loading of instrument and times out
+	s.StartHealthCheck(ctx)
+	err = client.LoadInstrument("/presets/1/channel_1.sfz", 0, 0)
+	s.StopHealthCheck()
+	if err != nil {
+		t.Errorf("Loading of instrument should not fail on busy sampler: %v", err)
+	}
+	if n := srv.conns.Load(); n != 1 {
+		t.Errorf("Client should not be reconnected to busy sampler, connections = %d", n)
+	}
+	if called.Load() {
+		t.Error("Reconnect handler should not be called for busy sampler")
+	}
+}
@@ -0,0 +1,127 @@
+package linuxsampler
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	repo "github.com/raspidrum-srv/internal/repo"
+	"github.com/raspidrum-srv/libs/liblscp-go"
+	"github.com/spf13/afero"
+)
+
+func TestLinuxSampler_RebindMidiInputs(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+	mockServer := startMockPipeServer(serverConn)
+	l := &LinuxSampler{
+		Client: liblscp.NewClientWithDriver(&mockLscpDriver{conn: clientConn}),
+	}
+
+	_, err := l.ConnectMidiInput("ALSA", []repo.Param[string]{{Name: "ALSA_SEQ_BINDINGS", Value: "24:0"}})
+	require.NoError(t, err)
+	err = l.RebindMidiInputs([]repo.Param[string]{{Name: "ALSA_SEQ_BINDINGS", Value: "32:0"}})
+	require.NoError(t, err)
+
+	time.Sleep(100 * time.Millisecond)
+	mockServer.stop()
+	assert.Equal(t, []string{
+		"CREATE MIDI_INPUT_DEVICE ALSA",
+		"SET MIDI_INPUT_PORT_PARAMETER 0 0 ALSA_SEQ_BINDINGS='24:0'",
+		"SET MIDI_INPUT_PORT_PARAMETER 0 0 ALSA_SEQ_BINDINGS='32:0'",
+	}, mockServer.getMessages())
+}
+
+func TestLinuxSampler_ConnectAudioOutput(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+	mockServer := startMockPipeServer(serverConn)
+	l := &LinuxSampler{
+		Client: liblscp.NewClientWithDriver(&mockLscpDriver{conn: clientConn}),
+	}
+
+	_, err := l.ConnectAudioOutput(repo.AudioOutput{
+		Driver: "JACK",
+		Params: []repo.Param[string]{{Name: "SAMPLERATE", Value: "48000"}},
+		Channels: map[int][]repo.Param[string]{
+			0: {{Name: "JACK_BINDINGS", Value: "system:playback_1"}},
+		},
+	})
+	require.NoError(t, err)
+
+	time.Sleep(100 * time.Millisecond)
+	mockServer.stop()
+	assert.Equal(t, []string{
+		"CREATE AUDIO_OUTPUT_DEVICE JACK SAMPLERATE='48000'",
+		"SET AUDIO_OUTPUT_CHANNEL_PARAMETER 0 0 JACK_BINDINGS='system:playback_1'",
+	}, mockServer.getMessages())
+}
+
+func TestLinuxSampler_CloseSession(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+	mockServer := startMockPipeServer(serverConn)
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/data/presets/3/kick.sfz", []byte("<region>"), 0644))
+	l := &LinuxSampler{
+		Client:  liblscp.NewClientWithDriver(&mockLscpDriver{conn: clientConn}),
+		Engine:  "sfz",
+		DataDir: "/data",
+		Fs:      fs,
+	}
+
+	audioId, err := l.ConnectAudioOutput(repo.AudioOutput{Driver: "ALSA"})
+	require.NoError(t, err)
+	midiId, err := l.ConnectMidiInput("ALSA", nil)
+	require.NoError(t, err)
+	_, err = l.CreateChannel(audioId, midiId)
+	require.NoError(t, err)
+	l.session = &repo.SamplerSession{Channels: repo.SamplerChannels{"kick": 0}}
+
+	require.NoError(t, l.CloseSession())
+	_, ok := l.Session()
+	assert.False(t, ok)
+	// stale preset files are removed
+	ok, _ = afero.DirExists(fs, "/data/presets/3")
+	assert.False(t, ok)
+	// nothing to remove on the second close
+	require.NoError(t, l.CloseSession())
+
+	time.Sleep(100 * time.Millisecond)
+	mockServer.stop()
+	msgs := mockServer.getMessages()
+	assert.Equal(t, []string{
+		"REMOVE CHANNEL 0",
+		"DESTROY MIDI_INPUT_DEVICE 0",
+		"DESTROY AUDIO_OUTPUT_DEVICE 0",
+	}, msgs[len(msgs)-3:])
+}
+
+func TestLinuxSampler_midiCCHandler(t *testing.T) {
+	var got [][3]int
+	h := midiCCHandler(func(samplerChn, cc, value int) {
+		got = append(got, [3]int{samplerChn, cc, value})
+	})
+	h(liblscp.ChannelMidiEvent{Channel: 2, MidiMessage: liblscp.MidiMessage{MidiType: liblscp.MidiNoteOn, Data1: 36, Data2: 100}})
+	h(liblscp.VoiceCountEvent{Channel: 2, Count: 5})
+	h(liblscp.ChannelMidiEvent{Channel: 2, MidiMessage: liblscp.MidiMessage{MidiType: liblscp.MidiControlChange, Data1: 30, Data2: 64}})
+	assert.Equal(t, [][3]int{{2, 30, 64}}, got)
+}
+
+func TestLinuxSampler_midiNoteHandler(t *testing.T) {
+	var got [][3]int
+	h := midiNoteHandler(func(samplerChn, note, velocity int) {
+		got = append(got, [3]int{samplerChn, note, velocity})
+	})
+	h(liblscp.ChannelMidiEvent{Channel: 2, MidiMessage: liblscp.MidiMessage{MidiType: liblscp.MidiControlChange, Data1: 30, Data2: 64}})
+	h(liblscp.ChannelMidiEvent{Channel: 2, MidiMessage: liblscp.MidiMessage{MidiType: liblscp.MidiNoteOn, Data1: 36, Data2: 0}})
+	h(liblscp.ChannelMidiEvent{Channel: 2, MidiMessage: liblscp.MidiMessage{MidiType: liblscp.MidiNoteOff, Data1: 36, Data2: 10}})
+	h(liblscp.ChannelMidiEvent{Channel: 2, MidiMessage: liblscp.MidiMessage{MidiType: liblscp.MidiNoteOn, Data1: 36, Data2: 100}})
+	assert.Equal(t, [][3]int{{2, 36, 100}}, got)
+}
@@ -1,9 +1,14 @@
 package linuxsampler
 
 import (
+	"context"
 	"fmt"
+	"maps"
 	"os"
 	"path"
+	"slices"
+	"strconv"
+	"time"
 
 	m "github.com/raspidrum-srv/internal/model"
 	repo "github.com/raspidrum-srv/internal/repo"
@@ -11,15 +16,21 @@ import (
 	"github.com/spf13/afero"
 )
 
-var presetDir = "current"
 var dirPermission os.FileMode = os.ModePerm
 
+// polling interval of instruments loading status
+var loadPollInterval = 200 * time.Millisecond
+
 // TODO: move to cfg
 var sampleRoot = "samples"
 var presetRoot = "presets"
 var instrumentRoot = "instruments"
 
 func (l *LinuxSampler) LoadPreset(audioDevId, midiDevId int, preset *m.KitPreset, fs afero.Fs) (repo.SamplerChannels, error) {
+	// preloaded channels of the same preset are replaced
+	if err := l.ReleasePreset(preset.Id); err != nil {
+		return nil, err
+	}
 
 	instrFiles, err := l.genPresetFiles(preset, fs)
 	if err != nil {
@@ -27,25 +38,90 @@ func (l *LinuxSampler) LoadPreset(audioDevId, midiDevId int, preset *m.KitPreset
 	}
 
 	// load sfz control files and samples in sampler
-	chnls, err := l.loadToSampler(audioDevId, midiDevId, preset, instrFiles)
+	chnls, err := l.loadToSampler(audioDevId, midiDevId, preset, instrFiles, false)
 	if err != nil {
 		return nil, fmt.Errorf("failed load instrument config and samples to sampler: %w", err)
 	}
-	// set sampler volume
+
+	if err = l.activatePreset(audioDevId, midiDevId, preset, chnls, false); err != nil {
+		return nil, err
+	}
+	return chnls, nil
+}
+
+func (l *LinuxSampler) LoadPresetAsync(ctx context.Context, audioDevId, midiDevId int, preset *m.KitPreset, fs afero.Fs, progress func(repo.ChannelProgress)) (repo.SamplerChannels, error) {
+	// preloaded channels of the same preset are replaced
+	if err := l.ReleasePreset(preset.Id); err != nil {
+		return nil, err
+	}
+
+	instrFiles, err := l.genPresetFiles(preset, fs)
+	if err != nil {
+		return nil, fmt.Errorf("failed prepare instrument control files for preset: %w", err)
+	}
+
+	chnls, err := l.loadToSamplerAsync(ctx, audioDevId, midiDevId, preset, instrFiles, progress)
+	if err != nil {
+		return nil, fmt.Errorf("failed load instrument config and samples to sampler: %w", err)
+	}
+	if err = l.activatePreset(audioDevId, midiDevId, preset, chnls, true); err != nil {
+		return nil, err
+	}
+	return chnls, nil
+}
+
+// activatePreset makes loaded channels the loaded preset. Previous preset keeps playing until the new one is loaded,
+// then its channels are muted and stay preloaded. Previous channels of the same preset are removed
+func (l *LinuxSampler) activatePreset(audioDevId, midiDevId int, preset *m.KitPreset, chnls repo.SamplerChannels, unmute bool) error {
+	l.sessionMu.Lock()
+	prev := l.session
+	l.session = &repo.SamplerSession{
+		PresetId:   preset.Id,
+		AudioDevId: audioDevId,
+		MidiDevId:  midiDevId,
+		Channels:   chnls,
+	}
+	var err error
+	if prev != nil {
+		if prev.PresetId == preset.Id {
+			err = l.removeChannels(prev.Channels)
+		} else {
+			err = l.muteChannels(prev.Channels)
+			l.addPreloaded(prev.PresetId, prev.Channels)
+		}
+	}
+	l.replaceEffects(prev, preset)
+	if err != nil {
+		l.sessionMu.Unlock()
+		return fmt.Errorf("failed unload previous preset: %w", err)
+	}
+	if unmute {
+		err = l.unmutePreset(preset, chnls)
+	}
+	l.sessionMu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed unmute preset channels: %w", err)
+	}
+
+	l.setPresetVolume(preset)
+	return nil
+}
+
+// set sampler volume
+func (l *LinuxSampler) setPresetVolume(preset *m.KitPreset) {
 	chnl := preset.GetChannelByKey(m.SamplerChannelKey)
 	if chnl != nil {
 		if ctrl, ok := chnl.Controls.GetControlByKey(m.SamplerVolumeControlKey); ok {
 			l.SetGlobalVolume(ctrl.Value)
 		}
 	}
-	return chnls, nil
 }
 
 // make sfz control files
 // return map instrument.uid : filename with path
 func (l *LinuxSampler) genPresetFiles(preset *m.KitPreset, fs afero.Fs) (map[string]string, error) {
 	presetFiles := map[string]string{}
-	presetDir, err := preparePresetDir(l.DataDir, fs)
+	presetDir, err := preparePresetDir(l.DataDir, preset.Id, fs)
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +133,7 @@ func (l *LinuxSampler) genPresetFiles(preset *m.KitPreset, fs afero.Fs) (map[str
 		chnlFiles[v.Key] = []string{}
 	}
 
+	instrNames := make(map[string]struct{}, len(preset.Instruments))
 	for _, v := range preset.Instruments {
 		// make one file for each instrument
 		fcontent := []string{}
@@ -88,7 +165,12 @@ func (l *LinuxSampler) genPresetFiles(preset *m.KitPreset, fs afero.Fs) (map[str
 		intrDir := path.Join(l.DataDir, instrumentRoot, v.Instrument.Uid, v.Instrument.Key)
 		fcontent = append(fcontent, fmt.Sprintf(`#include "%s.sfz"`, intrDir))
 		// save to file
+		// instruments of custom kit come from different kits and may have the same key
 		instrName := v.Instrument.Key + "_ctrl.sfz"
+		if _, ok := instrNames[instrName]; ok {
+			instrName = v.Instrument.Key + "_" + v.Instrument.Uid + "_ctrl.sfz"
+		}
+		instrNames[instrName] = struct{}{}
 		fname := path.Join(presetDir, instrName)
 		presetFiles[v.Instrument.Uid] = fname
 		err = file.WriteLines(fcontent, fname, fs)
@@ -131,8 +213,13 @@ func getControlLimits() []string {
 }
 
 // recreate dir with instrument control files (<instrument>_ctrl.sfz)
-func preparePresetDir(rootDir string, fs afero.Fs) (string, error) {
-	dr := path.Join(rootDir, presetRoot, presetDir)
+// each loaded and preloaded preset has own directory with generated files
+func presetDirName(presetId int64) string {
+	return strconv.FormatInt(presetId, 10)
+}
+
+func preparePresetDir(rootDir string, presetId int64, fs afero.Fs) (string, error) {
+	dr := path.Join(rootDir, presetRoot, presetDirName(presetId))
 	err := fs.RemoveAll(dr)
 	if err != nil {
 		return dr, fmt.Errorf("failed to prepare preset directory %s: %w", dr, err)
@@ -144,10 +231,27 @@ func preparePresetDir(rootDir string, fs afero.Fs) (string, error) {
 	return dr, nil
 }
 
-// Create sampler channels and load into its preset instruments
+// removePresetDir removes generated files of preset. Does nothing if fs isn't set
+func (l *LinuxSampler) removePresetDir(presetId int64) error {
+	if l.Fs == nil {
+		return nil
+	}
+	return l.Fs.RemoveAll(path.Join(l.DataDir, presetRoot, presetDirName(presetId)))
+}
+
+// Create sampler channels and load into its preset instruments.
+// Muted channels are muted before loading of instruments, so they don't sound while loading.
+// Created channels are removed on error
 // return map: key - Channel.Key, value - sampler channel Id
-func (l *LinuxSampler) loadToSampler(audDevId, midiDevId int, preset *m.KitPreset, instrfiles map[string]string) (repo.SamplerChannels, error) {
+func (l *LinuxSampler) loadToSampler(audDevId, midiDevId int, preset *m.KitPreset, instrfiles map[string]string, muted bool) (_ repo.SamplerChannels, err error) {
 	channels := repo.SamplerChannels{}
+	defer func() {
+		if err != nil {
+			l.sessionMu.Lock()
+			l.removeChannels(channels)
+			l.sessionMu.Unlock()
+		}
+	}()
 
 	//loading instruments
 	for _, cv := range preset.Channels {
@@ -161,6 +265,11 @@ func (l *LinuxSampler) loadToSampler(audDevId, midiDevId int, preset *m.KitPrese
 			return nil, fmt.Errorf("failed create sampler channel: %w", err)
 		}
 		channels[cv.Key] = chnlId
+		if muted {
+			if err = l.Client.SetChannelMute(chnlId, true); err != nil {
+				return nil, fmt.Errorf("failed mute sampler channel: %w", err)
+			}
+		}
 
 		// load instruments to channel
 		chnlName := "channel_" + cv.Key
@@ -173,13 +282,102 @@ func (l *LinuxSampler) loadToSampler(audDevId, midiDevId int, preset *m.KitPrese
 			return nil, fmt.Errorf("failed load instruments %s to sampler: %w", chnlName, err)
 		}
 
-		// set channel controls
-		for _, ccv := range cv.Controls {
-			if len(ccv.CfgKey) == 0 && m.ControlTypeFromString[ccv.Type] == m.CTVolume {
-				l.SetChannelVolume(chnlId, ccv.Value)
-			}
+		l.setChannelControls(chnlId, &cv)
+		l.createFxSends(chnlId, &cv)
+	}
+
+	return channels, nil
+}
+
+// Creates muted sampler channels and starts loading of preset instruments into them in background.
+// Waits until instruments of all channels are loaded. Created channels are removed on error and ctx cancellation
+func (l *LinuxSampler) loadToSamplerAsync(ctx context.Context, audDevId, midiDevId int, preset *m.KitPreset, instrfiles map[string]string, progress func(repo.ChannelProgress)) (_ repo.SamplerChannels, err error) {
+	channels := repo.SamplerChannels{}
+	defer func() {
+		if err != nil {
+			l.sessionMu.Lock()
+			l.removeChannels(channels)
+			l.sessionMu.Unlock()
 		}
+	}()
+
+	for _, cv := range preset.Channels {
+		// skip sampler channel
+		if cv.Key == m.SamplerChannelKey {
+			continue
+		}
+		chnlName := "channel_" + cv.Key
+		fname, ok := instrfiles[chnlName]
+		if !ok {
+			return nil, fmt.Errorf("failed load instrument: not found filename for channel instruments file %s", chnlName)
+		}
+		chnlId, err := l.CreateChannel(audDevId, midiDevId)
+		if err != nil {
+			return nil, fmt.Errorf("failed create sampler channel: %w", err)
+		}
+		channels[cv.Key] = chnlId
+		if err = l.Client.SetChannelMute(chnlId, true); err != nil {
+			return nil, fmt.Errorf("failed mute sampler channel: %w", err)
+		}
+		if err = l.Client.LoadInstrumentNonModal(fname, 0, chnlId); err != nil {
+			return nil, fmt.Errorf("failed load instruments %s to sampler: %w", chnlName, err)
+		}
+		l.setChannelControls(chnlId, &cv)
+		l.createFxSends(chnlId, &cv)
 	}
 
+	if err = l.waitLoaded(ctx, channels, progress); err != nil {
+		return nil, err
+	}
 	return channels, nil
 }
+
+// polls INSTRUMENT_STATUS of channels until instruments of all channels are loaded
+func (l *LinuxSampler) waitLoaded(ctx context.Context, chnls repo.SamplerChannels, progress func(repo.ChannelProgress)) error {
+	keys := slices.Sorted(maps.Keys(chnls))
+	reported := make(map[string]int, len(keys))
+	client := l.Client.WithContext(ctx)
+	ticker := time.NewTicker(loadPollInterval)
+	defer ticker.Stop()
+	for {
+		loaded := true
+		for _, k := range keys {
+			if p, ok := reported[k]; ok && p == 100 {
+				continue
+			}
+			info, err := client.GetSamplerChannelInfo(chnls[k])
+			if err != nil {
+				return fmt.Errorf("failed get loading status of channel %s: %w", k, err)
+			}
+			if info.InstrumentStatus < 0 {
+				return fmt.Errorf("failed load instruments of channel %s: status %d", k, info.InstrumentStatus)
+			}
+			if p, ok := reported[k]; !ok || p != info.InstrumentStatus {
+				reported[k] = info.InstrumentStatus
+				if progress != nil {
+					progress(repo.ChannelProgress{ChannelKey: k, Percent: info.InstrumentStatus})
+				}
+			}
+			if info.InstrumentStatus < 100 {
+				loaded = false
+			}
+		}
+		if loaded {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// set channel controls
+func (l *LinuxSampler) setChannelControls(chnlId int, cv *m.PresetChannel) {
+	for _, ccv := range cv.Controls {
+		if len(ccv.CfgKey) == 0 && m.ControlTypeFromString[ccv.Type] == m.CTVolume {
+			l.SetChannelVolume(chnlId, ccv.Value)
+		}
+	}
+}
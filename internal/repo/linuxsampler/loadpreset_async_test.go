@@ -0,0 +1,105 @@
+package linuxsampler
+
+import (
+	"context"
+	"net"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	m "github.com/raspidrum-srv/internal/model"
+	repo "github.com/raspidrum-srv/internal/repo"
+	"github.com/raspidrum-srv/libs/liblscp-go"
+)
+
+// lscp commands without polling of loading status
+func withoutPolling(msgs []string) []string {
+	return slices.DeleteFunc(slices.Clone(msgs), func(v string) bool { return strings.HasPrefix(v, "GET CHANNEL INFO") })
+}
+
+func TestLinuxSampler_loadToSamplerAsync(t *testing.T) {
+	loadPollInterval = 10 * time.Millisecond
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+	mockServer := startMockPipeServer(serverConn)
+	// each poll adds 50%
+	polls := map[int]int{}
+	mockServer.setInstrumentStatus(func(chn int) int {
+		polls[chn]++
+		return min(polls[chn]*50, 100)
+	})
+	l := &LinuxSampler{
+		Client: liblscp.NewClientWithDriver(&mockLscpDriver{conn: clientConn}),
+		Engine: "sfz",
+	}
+
+	pst := &m.KitPreset{Channels: []m.PresetChannel{{Key: "1"}, {Key: "2"}}}
+	files := map[string]string{"channel_1": "/presets/2/channel_1.sfz", "channel_2": "/presets/2/channel_2.sfz"}
+	var got []repo.ChannelProgress
+	chnls, err := l.loadToSamplerAsync(context.Background(), 0, 0, pst, files, func(p repo.ChannelProgress) {
+		got = append(got, p)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, repo.SamplerChannels{"1": 0, "2": 1}, chnls)
+	assert.Equal(t, []repo.ChannelProgress{
+		{ChannelKey: "1", Percent: 50},
+		{ChannelKey: "2", Percent: 50},
+		{ChannelKey: "1", Percent: 100},
+		{ChannelKey: "2", Percent: 100},
+	}, got)
+
+	time.Sleep(100 * time.Millisecond)
+	mockServer.stop()
+	assert.Equal(t, []string{
+		"ADD CHANNEL",
+		"SET CHANNEL AUDIO_OUTPUT_DEVICE 0 0",
+		"SET CHANNEL MIDI_INPUT_DEVICE 0 0",
+		"LOAD ENGINE sfz 0",
+		"SET CHANNEL MUTE 0 1",
+		"LOAD INSTRUMENT NON_MODAL '/presets/2/channel_1.sfz' 0 0",
+		"ADD CHANNEL",
+		"SET CHANNEL AUDIO_OUTPUT_DEVICE 1 0",
+		"SET CHANNEL MIDI_INPUT_DEVICE 1 0",
+		"LOAD ENGINE sfz 1",
+		"SET CHANNEL MUTE 1 1",
+		"LOAD INSTRUMENT NON_MODAL '/presets/2/channel_2.sfz' 0 1",
+	}, withoutPolling(mockServer.getMessages()))
+}
+
+func TestLinuxSampler_loadToSamplerAsync_Cancel(t *testing.T) {
+	loadPollInterval = 10 * time.Millisecond
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+	mockServer := startMockPipeServer(serverConn)
+	// loading never finishes
+	mockServer.setInstrumentStatus(func(chn int) int { return 10 })
+	l := &LinuxSampler{
+		Client: liblscp.NewClientWithDriver(&mockLscpDriver{conn: clientConn}),
+		Engine: "sfz",
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var reported atomic.Bool
+	go func() {
+		assert.Eventually(t, reported.Load, time.Second, 5*time.Millisecond)
+		cancel()
+	}()
+	pst := &m.KitPreset{Channels: []m.PresetChannel{{Key: "1"}}}
+	_, err := l.loadToSamplerAsync(ctx, 0, 0, pst, map[string]string{"channel_1": "/presets/2/channel_1.sfz"}, func(p repo.ChannelProgress) {
+		reported.Store(true)
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, l.channels)
+
+	time.Sleep(100 * time.Millisecond)
+	mockServer.stop()
+	msgs := withoutPolling(mockServer.getMessages())
+	assert.Equal(t, "REMOVE CHANNEL 0", msgs[len(msgs)-1])
+}
@@ -67,7 +67,7 @@ func TestLinuxSampler_genPresetFiles(t *testing.T) {
 			},
 			orderImportant: true,
 			want: res{
-				dir: path.Join(rootDir, presetRoot, presetDir),
+				dir: path.Join(rootDir, presetRoot, presetDirName(0)),
 				files: map[string][]string{
 					"simple_ctrl.sfz": {
 						"<control>",
@@ -132,7 +132,7 @@ func TestLinuxSampler_genPresetFiles(t *testing.T) {
 			},
 			orderImportant: false,
 			want: res{
-				dir: path.Join(rootDir, presetRoot, presetDir),
+				dir: path.Join(rootDir, presetRoot, presetDirName(0)),
 				files: map[string][]string{
 					"kick_ctrl.sfz": {
 						"<control>",
@@ -167,6 +167,65 @@ func TestLinuxSampler_genPresetFiles(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "instruments of different kits with same key",
+			args: args{
+				preset: &m.KitPreset{
+					Channels: []m.PresetChannel{
+						{Key: "1"},
+					},
+					Instruments: []m.PresetInstrument{
+						{
+							ChannelKey: "1",
+							Instrument: m.InstrumentRef{
+								Uid:        "1111-ffff",
+								Key:        "snare",
+								CfgMidiKey: "KEYSNARE",
+							},
+							MidiKey:  "snare",
+							MidiNote: 38,
+						},
+						{
+							ChannelKey: "1",
+							Instrument: m.InstrumentRef{
+								Uid:        "2222-ffff",
+								Key:        "snare",
+								CfgMidiKey: "KEYSNARE",
+							},
+							MidiKey:  "snare2",
+							MidiNote: 40,
+						},
+					},
+				},
+				fs: afero.NewMemMapFs(),
+			},
+			orderImportant: true,
+			want: res{
+				dir: path.Join(rootDir, presetRoot, presetDirName(0)),
+				files: map[string][]string{
+					"snare_ctrl.sfz": {
+						"<control>",
+						"default_path=samples/1111-ffff/snare/",
+						"#define $KEYSNARE 38",
+						`#include "instruments/1111-ffff/snare.sfz"`,
+					},
+					"snare_2222-ffff_ctrl.sfz": {
+						"<control>",
+						"default_path=samples/2222-ffff/snare/",
+						"#define $KEYSNARE 40",
+						`#include "instruments/2222-ffff/snare.sfz"`,
+					},
+					"channel_1.sfz": {
+						"#define $VOLMIN 18",
+						"#define $VOLSHIFT 24",
+						"#define $PITCHMAX 1200",
+						"#define $PITCHMIN 600",
+						`#include "snare_ctrl.sfz"`,
+						`#include "snare_2222-ffff_ctrl.sfz"`,
+					},
+				},
+			},
+		},
 		{
 			name: "two instruments with layers, with controls",
 			args: args{
@@ -236,7 +295,7 @@ func TestLinuxSampler_genPresetFiles(t *testing.T) {
 			},
 			orderImportant: false,
 			want: res{
-				dir: path.Join(rootDir, presetRoot, presetDir),
+				dir: path.Join(rootDir, presetRoot, presetDirName(0)),
 				files: map[string][]string{
 					"ride_ctrl.sfz": {
 						"<control>",
@@ -504,8 +563,8 @@ func TestLinuxSampler_loadToSampler(t *testing.T) {
 					},
 				},
 				instrumentFiles: map[string]string{
-					"1111-ffff": path.Join(rootDir, presetRoot, presetDir, "simple_ctrl.sfz"),
-					"channel_1": path.Join(rootDir, presetRoot, presetDir, "channel_1.sfz"),
+					"1111-ffff": path.Join(rootDir, presetRoot, presetDirName(0), "simple_ctrl.sfz"),
+					"channel_1": path.Join(rootDir, presetRoot, presetDirName(0), "channel_1.sfz"),
 				},
 			},
 			want: res{
@@ -514,7 +573,7 @@ func TestLinuxSampler_loadToSampler(t *testing.T) {
 					"SET CHANNEL AUDIO_OUTPUT_DEVICE 0 0",
 					"SET CHANNEL MIDI_INPUT_DEVICE 0 0",
 					"LOAD ENGINE sfz 0",
-					"LOAD INSTRUMENT '" + path.Join(rootDir, presetRoot, presetDir, "channel_1.sfz") + "' 0 0",
+					"LOAD INSTRUMENT '" + path.Join(rootDir, presetRoot, presetDirName(0), "channel_1.sfz") + "' 0 0",
 					"SET CHANNEL VOLUME 0 1.00",
 				},
 				channels: repo.SamplerChannels{
@@ -551,9 +610,9 @@ func TestLinuxSampler_loadToSampler(t *testing.T) {
 					},
 				},
 				instrumentFiles: map[string]string{
-					"1111-ffff": path.Join(rootDir, presetRoot, presetDir, "kick_ctrl.sfz"),
-					"2222-ffff": path.Join(rootDir, presetRoot, presetDir, "snare_ctrl.sfz"),
-					"channel_1": path.Join(rootDir, presetRoot, presetDir, "channel_1.sfz"),
+					"1111-ffff": path.Join(rootDir, presetRoot, presetDirName(0), "kick_ctrl.sfz"),
+					"2222-ffff": path.Join(rootDir, presetRoot, presetDirName(0), "snare_ctrl.sfz"),
+					"channel_1": path.Join(rootDir, presetRoot, presetDirName(0), "channel_1.sfz"),
 				},
 			},
 			want: res{
@@ -562,7 +621,7 @@ func TestLinuxSampler_loadToSampler(t *testing.T) {
 					"SET CHANNEL AUDIO_OUTPUT_DEVICE 0 0",
 					"SET CHANNEL MIDI_INPUT_DEVICE 0 0",
 					"LOAD ENGINE sfz 0",
-					"LOAD INSTRUMENT '" + path.Join(rootDir, presetRoot, presetDir, "channel_1.sfz") + "' 0 0",
+					"LOAD INSTRUMENT '" + path.Join(rootDir, presetRoot, presetDirName(0), "channel_1.sfz") + "' 0 0",
 				},
 				channels: repo.SamplerChannels{
 					"1": 0,
@@ -599,10 +658,10 @@ func TestLinuxSampler_loadToSampler(t *testing.T) {
 					},
 				},
 				instrumentFiles: map[string]string{
-					"1111-ffff": path.Join(rootDir, presetRoot, presetDir, "kick_ctrl.sfz"),
-					"2222-ffff": path.Join(rootDir, presetRoot, presetDir, "snare_ctrl.sfz"),
-					"channel_1": path.Join(rootDir, presetRoot, presetDir, "channel_1.sfz"),
-					"channel_2": path.Join(rootDir, presetRoot, presetDir, "channel_2.sfz"),
+					"1111-ffff": path.Join(rootDir, presetRoot, presetDirName(0), "kick_ctrl.sfz"),
+					"2222-ffff": path.Join(rootDir, presetRoot, presetDirName(0), "snare_ctrl.sfz"),
+					"channel_1": path.Join(rootDir, presetRoot, presetDirName(0), "channel_1.sfz"),
+					"channel_2": path.Join(rootDir, presetRoot, presetDirName(0), "channel_2.sfz"),
 				},
 			},
 			want: res{
@@ -611,12 +670,12 @@ func TestLinuxSampler_loadToSampler(t *testing.T) {
 					"SET CHANNEL AUDIO_OUTPUT_DEVICE 0 0",
 					"SET CHANNEL MIDI_INPUT_DEVICE 0 0",
 					"LOAD ENGINE sfz 0",
-					"LOAD INSTRUMENT '" + path.Join(rootDir, presetRoot, presetDir, "channel_1.sfz") + "' 0 0",
+					"LOAD INSTRUMENT '" + path.Join(rootDir, presetRoot, presetDirName(0), "channel_1.sfz") + "' 0 0",
 					"ADD CHANNEL",
 					"SET CHANNEL AUDIO_OUTPUT_DEVICE 1 0",
 					"SET CHANNEL MIDI_INPUT_DEVICE 1 0",
 					"LOAD ENGINE sfz 1",
-					"LOAD INSTRUMENT '" + path.Join(rootDir, presetRoot, presetDir, "channel_2.sfz") + "' 0 1",
+					"LOAD INSTRUMENT '" + path.Join(rootDir, presetRoot, presetDirName(0), "channel_2.sfz") + "' 0 1",
 				},
 				channels: repo.SamplerChannels{
 					"1": 0,
@@ -641,7 +700,7 @@ func TestLinuxSampler_loadToSampler(t *testing.T) {
 				DataDir: rootDir,
 			}
 
-			gotChannels, err := l.loadToSampler(0, 0, tt.args.preset, tt.args.instrumentFiles)
+			gotChannels, err := l.loadToSampler(0, 0, tt.args.preset, tt.args.instrumentFiles, false)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("LinuxSampler.loadToSampler() error = %v, wantErr %v", err, tt.wantErr)
 			}
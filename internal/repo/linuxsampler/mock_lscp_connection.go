@@ -2,9 +2,11 @@ package linuxsampler
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
 	"net"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -21,9 +23,18 @@ type mockLscpDriver struct {
 	connectErr atomic.Value // error
 	connected  atomic.Bool
 	conn       net.Conn
+	// serializes commands like lscpDriver does
+	mu sync.Mutex
+	rd *bufio.Reader
+	// ping never gets result
+	pingHang atomic.Bool
 }
 
-func (m *mockLscpDriver) Ping() error {
+func (m *mockLscpDriver) Ping(ctx context.Context) error {
+	if m.pingHang.Load() {
+		<-ctx.Done()
+		return fmt.Errorf("failed lscp command: GET SERVER INFO : %w", ctx.Err())
+	}
 	err, _ := m.pingErr.Load().(error)
 	if err == errNoError {
 		return nil
@@ -47,16 +58,21 @@ func (m *mockLscpDriver) Disconnect() error {
 	return nil
 }
 
-func (m *mockLscpDriver) RetrieveInfo(lscpCmd string, isMultiResult bool) (liblscp.ResultSet, error) {
+func (m *mockLscpDriver) RetrieveInfo(ctx context.Context, lscpCmd string, isMultiResult bool) (liblscp.ResultSet, error) {
 	if m.conn == nil {
 		return liblscp.ResultSet{}, fmt.Errorf("not connected")
 	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.rd == nil {
+		m.rd = bufio.NewReader(m.conn)
+	}
 	cmd := strings.Trim(lscpCmd, " ")
 	_, err := fmt.Fprintf(m.conn, "%s\r\n", cmd)
 	if err != nil {
 		return liblscp.ResultSet{}, fmt.Errorf("failed lscp command: %s : %w", lscpCmd, err)
 	}
-	return liblscp.GetResultSetFromConn(m.conn, isMultiResult)
+	return liblscp.ReadResultSet(m.rd, isMultiResult)
 }
 
 // MockPipeServer управляет in-memory сервером
@@ -66,6 +82,10 @@ type MockPipeServer struct {
 	mu               sync.Mutex
 	done             chan struct{}
 	channelIdx       int
+	fxChainIdx       int
+	fxInstanceIdx    int
+	// INSTRUMENT_STATUS of GET CHANNEL INFO. 100 if not set
+	instrumentStatus func(chn int) int
 }
 
 func startMockPipeServer(conn net.Conn) *MockPipeServer {
@@ -98,13 +118,33 @@ func (m *MockPipeServer) listen() {
 			var resp string
 			switch {
 			case req == "ADD CHANNEL":
-				resp = fmt.Sprintf("OK[%d]\n\r", m.channelIdx)
+				resp = fmt.Sprintf("OK[%d]\r\n", m.channelIdx)
 				m.channelIdx++
+			case strings.HasPrefix(req, "GET CHANNEL INFO "):
+				chn, _ := strconv.Atoi(strings.TrimPrefix(req, "GET CHANNEL INFO "))
+				status := 100
+				m.mu.Lock()
+				if m.instrumentStatus != nil {
+					status = m.instrumentStatus(chn)
+				}
+				m.mu.Unlock()
+				resp = fmt.Sprintf("ENGINE_NAME: SFZ\r\nINSTRUMENT_STATUS: %d\r\nMUTE: true\r\n.\r\n", status)
+			case strings.HasPrefix(req, "ADD SEND_EFFECT_CHAIN "):
+				resp = fmt.Sprintf("OK[%d]\r\n", m.fxChainIdx)
+				m.fxChainIdx++
+			case strings.HasPrefix(req, "CREATE EFFECT_INSTANCE "):
+				resp = fmt.Sprintf("OK[%d]\r\n", m.fxInstanceIdx)
+				m.fxInstanceIdx++
+			case strings.HasPrefix(req, "GET EFFECT_INSTANCE INFO "):
+				resp = "SYSTEM: LADSPA\r\nINPUT_CONTROLS: 2\r\n.\r\n"
+			case strings.HasPrefix(req, "GET EFFECT_INSTANCE_INPUT_CONTROL INFO "):
+				prm := req[strings.LastIndex(req, " ")+1:]
+				resp = fmt.Sprintf("DESCRIPTION: param %s\r\nVALUE: 0.5\r\nRANGE_MIN: 0\r\nRANGE_MAX: 1\r\n.\r\n", prm)
 			default:
 				// SET CHANNEL ...
 				// LOAD LOAD ENGINE ...
 				// LOAD INSTRUMENT ...
-				resp = "OK\n\r"
+				resp = "OK\r\n"
 			}
 			m.conn.Write([]byte(resp))
 		}
@@ -116,6 +156,14 @@ func (m *MockPipeServer) stop() {
 		close(m.done)
 	}
 	m.channelIdx = 0
+	m.fxChainIdx = 0
+	m.fxInstanceIdx = 0
+}
+
+func (m *MockPipeServer) setInstrumentStatus(f func(chn int) int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.instrumentStatus = f
 }
 
 func (m *MockPipeServer) getMessages() []string {
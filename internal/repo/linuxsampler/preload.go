@@ -0,0 +1,169 @@
+package linuxsampler
+
+import (
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"path"
+	"slices"
+
+	m "github.com/raspidrum-srv/internal/model"
+	repo "github.com/raspidrum-srv/internal/repo"
+	"github.com/spf13/afero"
+)
+
+func (l *LinuxSampler) PreloadPreset(audioDevId, midiDevId int, preset *m.KitPreset, fs afero.Fs) error {
+	l.sessionMu.Lock()
+	_, preloaded := l.preloaded[preset.Id]
+	loaded := l.session != nil && l.session.PresetId == preset.Id
+	l.sessionMu.Unlock()
+	if preloaded || loaded {
+		return nil
+	}
+
+	instrFiles, err := l.genPresetFiles(preset, fs)
+	if err != nil {
+		return fmt.Errorf("failed prepare instrument control files for preset: %w", err)
+	}
+	chnls, err := l.loadToSampler(audioDevId, midiDevId, preset, instrFiles, true)
+	if err != nil {
+		return fmt.Errorf("failed preload preset %d to sampler: %w", preset.Id, err)
+	}
+	l.sessionMu.Lock()
+	l.addPreloaded(preset.Id, chnls)
+	l.sessionMu.Unlock()
+	return nil
+}
+
+// Previous preset is muted before unmuting of the next one, so they never sound together
+func (l *LinuxSampler) SwitchPreset(preset *m.KitPreset) (repo.SamplerChannels, bool, error) {
+	l.sessionMu.Lock()
+	defer l.sessionMu.Unlock()
+	chnls, ok := l.preloaded[preset.Id]
+	if !ok || l.session == nil {
+		return nil, false, nil
+	}
+	prev := l.session
+	if err := l.muteChannels(prev.Channels); err != nil {
+		return nil, false, fmt.Errorf("failed switch preset: %w", err)
+	}
+	if err := l.unmutePreset(preset, chnls); err != nil {
+		return nil, false, fmt.Errorf("failed switch preset: %w", err)
+	}
+	delete(l.preloaded, preset.Id)
+	l.addPreloaded(prev.PresetId, prev.Channels)
+	l.session = &repo.SamplerSession{
+		PresetId:   preset.Id,
+		AudioDevId: prev.AudioDevId,
+		MidiDevId:  prev.MidiDevId,
+		Channels:   chnls,
+	}
+	l.replaceEffects(prev, preset)
+	l.setPresetVolume(preset)
+	return maps.Clone(chnls), true, nil
+}
+
+// Removes channels of preloaded preset and generated files of preset, unless it's the loaded one.
+// Used for deleted preset too
+func (l *LinuxSampler) ReleasePreset(presetId int64) error {
+	l.sessionMu.Lock()
+	defer l.sessionMu.Unlock()
+	if chnls, ok := l.preloaded[presetId]; ok {
+		delete(l.preloaded, presetId)
+		if err := l.removeChannels(chnls); err != nil {
+			return fmt.Errorf("failed release preset %d: %w", presetId, err)
+		}
+	}
+	if l.session == nil || l.session.PresetId != presetId {
+		if err := l.removePresetDir(presetId); err != nil {
+			slog.Warn("failed remove files of released preset", slog.Int64("presetId", presetId), slog.Any("error", err))
+		}
+	}
+	return nil
+}
+
+func (l *LinuxSampler) PreloadedPresets() []int64 {
+	l.sessionMu.Lock()
+	defer l.sessionMu.Unlock()
+	return slices.Sorted(maps.Keys(l.preloaded))
+}
+
+// Sum of sizes of instrument sample files. LinuxSampler streams samples from disk
+// and keeps in memory only their beginnings, so it's the upper bound
+func (l *LinuxSampler) PresetMemory(preset *m.KitPreset, fs afero.Fs) (int64, error) {
+	var size int64
+	seen := make(map[string]struct{}, len(preset.Instruments))
+	for _, v := range preset.Instruments {
+		if _, ok := seen[v.Instrument.Uid]; ok {
+			continue
+		}
+		seen[v.Instrument.Uid] = struct{}{}
+		err := afero.Walk(fs, path.Join(l.DataDir, sampleRoot, v.Instrument.Uid), func(p string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() {
+				size += info.Size()
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed estimate memory of preset %d: %w", preset.Id, err)
+		}
+	}
+	return size, nil
+}
+
+// must be called with locked sessionMu
+func (l *LinuxSampler) addPreloaded(presetId int64, chnls repo.SamplerChannels) {
+	if l.preloaded == nil {
+		l.preloaded = map[int64]repo.SamplerChannels{}
+	}
+	l.preloaded[presetId] = chnls
+}
+
+// muted channels are unsoloed too, otherwise soloed channel of preloaded preset silences the loaded one
+func (l *LinuxSampler) muteChannels(chnls repo.SamplerChannels) error {
+	for _, v := range slices.Sorted(maps.Values(chnls)) {
+		if err := l.Client.SetChannelMute(v, true); err != nil {
+			return err
+		}
+		if err := l.Client.SetChannelSolo(v, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unmutePreset unmutes channels of preset except muted ones and solos soloed ones
+func (l *LinuxSampler) unmutePreset(preset *m.KitPreset, chnls repo.SamplerChannels) error {
+	for _, key := range slices.Sorted(maps.Keys(chnls)) {
+		v := chnls[key]
+		muted, soloed := false, false
+		if ch := preset.GetChannelByKey(key); ch != nil {
+			muted, soloed = ch.IsMuted(), ch.IsSoloed()
+		}
+		if err := l.Client.SetChannelMute(v, muted); err != nil {
+			return err
+		}
+		if soloed {
+			if err := l.Client.SetChannelSolo(v, true); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// must be called with locked sessionMu
+func (l *LinuxSampler) removeChannels(chnls repo.SamplerChannels) error {
+	for _, v := range slices.Sorted(maps.Values(chnls)) {
+		if err := l.Client.RemoveSamplerChannel(v); err != nil {
+			return err
+		}
+		l.channels = slices.DeleteFunc(l.channels, func(c int) bool { return c == v })
+		delete(l.fxSends, v)
+	}
+	return nil
+}
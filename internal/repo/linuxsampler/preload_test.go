@@ -0,0 +1,123 @@
+package linuxsampler
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	m "github.com/raspidrum-srv/internal/model"
+	repo "github.com/raspidrum-srv/internal/repo"
+	"github.com/raspidrum-srv/libs/liblscp-go"
+	"github.com/spf13/afero"
+)
+
+func TestLinuxSampler_loadToSampler_Muted(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+	mockServer := startMockPipeServer(serverConn)
+	l := &LinuxSampler{
+		Client: liblscp.NewClientWithDriver(&mockLscpDriver{conn: clientConn}),
+		Engine: "sfz",
+	}
+
+	pst := &m.KitPreset{Channels: []m.PresetChannel{{Key: "1"}}}
+	chnls, err := l.loadToSampler(0, 0, pst, map[string]string{"channel_1": "/presets/2/channel_1.sfz"}, true)
+	require.NoError(t, err)
+	assert.Equal(t, repo.SamplerChannels{"1": 0}, chnls)
+
+	time.Sleep(100 * time.Millisecond)
+	mockServer.stop()
+	// channel is muted before loading of instruments
+	assert.Equal(t, []string{
+		"ADD CHANNEL",
+		"SET CHANNEL AUDIO_OUTPUT_DEVICE 0 0",
+		"SET CHANNEL MIDI_INPUT_DEVICE 0 0",
+		"LOAD ENGINE sfz 0",
+		"SET CHANNEL MUTE 0 1",
+		"LOAD INSTRUMENT '/presets/2/channel_1.sfz' 0 0",
+	}, mockServer.getMessages())
+}
+
+func TestLinuxSampler_SwitchPreset(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+	mockServer := startMockPipeServer(serverConn)
+	fs := afero.NewMemMapFs()
+	for _, id := range []string{"1", "2", "5", "6"} {
+		require.NoError(t, afero.WriteFile(fs, "/data/presets/"+id+"/kick.sfz", []byte("<region>"), 0644))
+	}
+	l := &LinuxSampler{
+		Client:    liblscp.NewClientWithDriver(&mockLscpDriver{conn: clientConn}),
+		DataDir:   "/data",
+		Fs:        fs,
+		session:   &repo.SamplerSession{PresetId: 1, AudioDevId: 3, MidiDevId: 4, Channels: repo.SamplerChannels{"kick": 0, "snare": 1}},
+		preloaded: map[int64]repo.SamplerChannels{2: {"kick": 2, "snare": 3}, 5: {"kick": 5}},
+		channels:  []int{0, 1, 2, 3, 5},
+	}
+
+	// not preloaded
+	_, ok, err := l.SwitchPreset(&m.KitPreset{Id: 7})
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// muted and soloed channels keep their state
+	chnls, ok, err := l.SwitchPreset(&m.KitPreset{Id: 2, Channels: []m.PresetChannel{{Key: "kick", Solo: true}, {Key: "snare", Mute: true}}})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, repo.SamplerChannels{"kick": 2, "snare": 3}, chnls)
+	sess, ok := l.Session()
+	assert.True(t, ok)
+	assert.Equal(t, repo.SamplerSession{PresetId: 2, AudioDevId: 3, MidiDevId: 4, Channels: repo.SamplerChannels{"kick": 2, "snare": 3}}, sess)
+	assert.Equal(t, []int64{1, 5}, l.PreloadedPresets())
+
+	require.NoError(t, l.ReleasePreset(5))
+	// not preloaded
+	require.NoError(t, l.ReleasePreset(5))
+	assert.Equal(t, []int64{1}, l.PreloadedPresets())
+	assert.Equal(t, []int{0, 1, 2, 3}, l.channels)
+	// files of released and deleted presets are removed, files of the loaded preset are kept
+	require.NoError(t, l.ReleasePreset(6))
+	require.NoError(t, l.ReleasePreset(2))
+	for id, want := range map[string]bool{"1": true, "2": true, "5": false, "6": false} {
+		ok, _ := afero.DirExists(fs, "/data/presets/"+id)
+		assert.Equal(t, want, ok, id)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	mockServer.stop()
+	assert.Equal(t, []string{
+		"SET CHANNEL MUTE 0 1",
+		"SET CHANNEL SOLO 0 0",
+		"SET CHANNEL MUTE 1 1",
+		"SET CHANNEL SOLO 1 0",
+		"SET CHANNEL MUTE 2 0",
+		"SET CHANNEL SOLO 2 1",
+		"SET CHANNEL MUTE 3 1",
+		"REMOVE CHANNEL 5",
+	}, mockServer.getMessages())
+}
+
+func TestLinuxSampler_PresetMemory(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/data/samples/1111/kick/kick_1.wav", make([]byte, 100), 0644))
+	require.NoError(t, afero.WriteFile(fs, "/data/samples/1111/kick/kick_2.wav", make([]byte, 50), 0644))
+	require.NoError(t, afero.WriteFile(fs, "/data/samples/2222/snare/snare.wav", make([]byte, 20), 0644))
+	l := &LinuxSampler{DataDir: "/data"}
+
+	size, err := l.PresetMemory(&m.KitPreset{Instruments: []m.PresetInstrument{
+		{Instrument: m.InstrumentRef{Uid: "1111"}},
+		{Instrument: m.InstrumentRef{Uid: "2222"}},
+		// the same instrument is counted once
+		{Instrument: m.InstrumentRef{Uid: "1111"}},
+	}}, fs)
+	require.NoError(t, err)
+	assert.Equal(t, int64(170), size)
+
+	_, err = l.PresetMemory(&m.KitPreset{Instruments: []m.PresetInstrument{{Instrument: m.InstrumentRef{Uid: "3333"}}}}, fs)
+	assert.Error(t, err)
+}
@@ -0,0 +1,88 @@
+package linuxsampler
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"path"
+
+	repo "github.com/raspidrum-srv/internal/repo"
+)
+
+func (l *LinuxSampler) Session() (repo.SamplerSession, bool) {
+	l.sessionMu.Lock()
+	defer l.sessionMu.Unlock()
+	if l.session == nil {
+		return repo.SamplerSession{}, false
+	}
+	res := *l.session
+	res.Channels = maps.Clone(l.session.Channels)
+	res.FxChains = maps.Clone(l.session.FxChains)
+	res.Effects = maps.Clone(l.session.Effects)
+	return res, true
+}
+
+// CloseSession removes effects and sampler channels of loaded and preloaded presets, then MIDI input and audio output devices.
+// Generated files of all presets are removed too, including ones left by previous run.
+// Removal continues on errors: ids aren't valid anymore, i.e. after restart of sampler.
+// All ids are forgotten, errors are joined
+func (l *LinuxSampler) CloseSession() error {
+	l.sessionMu.Lock()
+	defer l.sessionMu.Unlock()
+
+	var errs []error
+	if l.session != nil {
+		if err := l.removeEffects(l.session.AudioDevId, l.session.FxChains, l.session.Effects); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, v := range l.channels {
+		if err := l.Client.RemoveSamplerChannel(v); err != nil {
+			errs = append(errs, fmt.Errorf("failed remove sampler channel %d: %w", v, err))
+		}
+	}
+	for _, v := range l.midiInputs {
+		if err := l.Client.DestroyMidiInputDevice(v); err != nil {
+			errs = append(errs, fmt.Errorf("failed destroy MIDI input device %d: %w", v, err))
+		}
+	}
+	for _, v := range l.audioOutputs {
+		if err := l.Client.DestroyAudioOutputDevice(v); err != nil {
+			errs = append(errs, fmt.Errorf("failed destroy audio output device %d: %w", v, err))
+		}
+	}
+	if l.Fs != nil {
+		if err := l.Fs.RemoveAll(path.Join(l.DataDir, presetRoot)); err != nil {
+			errs = append(errs, fmt.Errorf("failed remove preset files: %w", err))
+		}
+	}
+	l.channels = nil
+	l.midiInputs = nil
+	l.audioOutputs = nil
+	l.session = nil
+	l.preloaded = nil
+	l.fxSends = nil
+	return errors.Join(errs...)
+}
+
+// OnReconnect sets handler, which is called by health check after client is reconnected to LinuxSampler.
+// Restarted sampler is empty, so session is closed before: handler has to create devices and channels again
+func (l *LinuxSampler) OnReconnect(f func()) {
+	l.sessionMu.Lock()
+	defer l.sessionMu.Unlock()
+	l.onReconnect = f
+}
+
+func (l *LinuxSampler) reconnected() {
+	// ids of restarted sampler aren't valid, errors are expected
+	if err := l.CloseSession(); err != nil {
+		slog.Debug("failed close sampler session after reconnect", slog.Any("error", err))
+	}
+	l.sessionMu.Lock()
+	f := l.onReconnect
+	l.sessionMu.Unlock()
+	if f != nil {
+		f()
+	}
+}
@@ -0,0 +1,101 @@
+package liblscp
+
+import (
+	"strconv"
+	"strings"
+)
+
+type AudioOutputDriver struct {
+	Name        string
+	Description string
+	Version     string
+	// parameters of audio output device, which are supported by driver
+	Params []Parameter[string]
+}
+
+// Parses result of GET AUDIO_OUTPUT_DRIVER INFO. Returns driver and names of its parameters
+func ParseAudioOutputDriver(name string, ln []string) (AudioOutputDriver, []string, error) {
+	drv := AudioOutputDriver{Name: name}
+	var params []string
+	for _, v := range ln {
+		if vl, f := strings.CutPrefix(v, "DESCRIPTION: "); f {
+			drv.Description = vl
+			continue
+		}
+		if vl, f := strings.CutPrefix(v, "VERSION: "); f {
+			drv.Version = vl
+			continue
+		}
+		if vl, f := strings.CutPrefix(v, "PARAMETERS: "); f {
+			params = strings.Split(vl, ",")
+		}
+	}
+	return drv, params, nil
+}
+
+// Parses result of GET AUDIO_OUTPUT_DRIVER_PARAMETER INFO.
+// Values of all types are returned as strings without quotes
+func ParseDriverParameter(name string, ln []string) (Parameter[string], error) {
+	prm := Parameter[string]{Name: name}
+	for _, v := range ln {
+		if vl, f := strings.CutPrefix(v, "DESCRIPTION: "); f {
+			prm.Description = vl
+			continue
+		}
+		if vl, f := strings.CutPrefix(v, "TYPE: "); f {
+			prm.Type = ParameterToType[vl]
+			continue
+		}
+		if vl, f := strings.CutPrefix(v, "MANDATORY: "); f {
+			b, err := strconv.ParseBool(strings.TrimSpace(vl))
+			if err != nil {
+				return prm, err
+			}
+			prm.isMandatory = b
+			continue
+		}
+		if vl, f := strings.CutPrefix(v, "MULTIPLICITY: "); f {
+			b, err := strconv.ParseBool(strings.TrimSpace(vl))
+			if err != nil {
+				return prm, err
+			}
+			prm.IsMultiplicity = b
+			continue
+		}
+		if vl, f := strings.CutPrefix(v, "DEFAULT: "); f {
+			prm.Default = strings.Trim(vl, "'")
+			continue
+		}
+		if vl, f := strings.CutPrefix(v, "RANGE_MIN: "); f {
+			f, err := parseFloat(vl)
+			if err != nil {
+				return prm, err
+			}
+			prm.SetRangeMin(f)
+			continue
+		}
+		if vl, f := strings.CutPrefix(v, "RANGE_MAX: "); f {
+			f, err := parseFloat(vl)
+			if err != nil {
+				return prm, err
+			}
+			prm.SetRangeMax(f)
+			continue
+		}
+		if vl, f := strings.CutPrefix(v, "POSSIBILITIES: "); f {
+			prm.Possibilities = parseQuotedList(vl)
+		}
+	}
+	return prm, nil
+}
+
+// Parses a comma separated list. Items of string lists are encapsulated into apostrophes: '0,0','1,0'
+func parseQuotedList(list string) []string {
+	if len(list) == 0 {
+		return nil
+	}
+	if !strings.HasPrefix(list, "'") {
+		return strings.Split(list, ",")
+	}
+	return strings.Split(strings.Trim(list, "'"), "','")
+}
@@ -1,179 +1,78 @@
 package liblscp
 
 import (
-	"bufio"
+	"context"
+	"errors"
 	"fmt"
-	"log/slog"
-	"net"
 	"strings"
-	"time"
 )
 
-// LscpDriver defines the interface for LSCP protocol driver
-// Provides connection, ping, disconnect, and command execution
-type LscpDriver interface {
-	Connect() error
-	Disconnect() error
-	Ping() error
-	RetrieveInfo(lscpCmd string, isMultiResult bool) (ResultSet, error)
-}
-
-// lscpDriver is a concrete implementation of LscpDriver
-// Handles TCP connection and LSCP protocol
-type lscpDriver struct {
-	host       string
-	port       string
-	conTimeout string
-	conn       net.Conn
-}
-
-func newLscpDriver(host, port, timeout string) *lscpDriver {
-	return &lscpDriver{
-		host:       host,
-		port:       port,
-		conTimeout: timeout,
-	}
-}
-
-func (d *lscpDriver) Connect() error {
-	t, err := time.ParseDuration(d.conTimeout)
-	if err != nil {
-		return fmt.Errorf("failed parse timeout duration: '%s' %w", d.conTimeout, err)
-	}
-	if d.conn != nil {
-		d.conn.Close()
-	}
-	d.conn, err = net.DialTimeout("tcp", net.JoinHostPort(d.host, d.port), t)
-	if err != nil {
-		return fmt.Errorf("failed connect to: '%s:%s' %w", d.host, d.port, err)
-	}
-	return nil
-}
-
-func (d *lscpDriver) Disconnect() error {
-	if d.conn != nil {
-		if err := d.conn.Close(); err != nil {
-			return fmt.Errorf("failed disconnect from LinuxSampler: %w", err)
-		}
-		d.conn = nil
-	}
-	return nil
-}
-
-func (d *lscpDriver) Ping() error {
-	// Use a simple command to check connection, e.g. GET SERVER INFO
-	if d.conn == nil {
-		return fmt.Errorf("not connected")
-	}
-	_, err := fmt.Fprintf(d.conn, "%s\r\n", "GET SERVER INFO")
-	return err
-}
-
-func (d *lscpDriver) RetrieveInfo(lscpCmd string, isMultiResult bool) (ResultSet, error) {
-	if d.conn == nil {
-		return ResultSet{}, fmt.Errorf("not connected")
-	}
-	cmd := strings.Trim(lscpCmd, " ")
-	_, err := fmt.Fprintf(d.conn, "%s\r\n", cmd)
-	if err != nil {
-		return ResultSet{}, fmt.Errorf("failed lscp command: %s : %w", lscpCmd, err)
-	}
-	return GetResultSetFromConn(d.conn, isMultiResult)
-}
-
-// getResultSetFromConn is a helper for lscpDriver
-func GetResultSetFromConn(conn net.Conn, isMultiResult bool) (ResultSet, error) {
-	rs := ResultSet{}
-	rd := bufio.NewReader(conn)
-
-	ln, err := getLineFromReader(rd)
-	if err != nil {
-		return rs, err
-	}
-
-	if f := strings.HasPrefix(ln, "ERR"); f {
-		if err := parseError(ln, &rs); err != nil {
-			return rs, err
-		}
-		// it's error got from LinuxSampler
-		return rs, &LscpError{rs.Code, rs.Message}
-	}
-	if f := strings.HasPrefix(ln, "WRN"); f {
-		if err := parseWarning(ln, &rs); err != nil {
-			return rs, err
-		}
-		// it's warning got from LinuxSampler
-		slog.Warn("LinuxSampler", slog.Int("code", rs.Code), slog.String("msg", rs.Message))
-		return rs, nil
-	}
-	if f := strings.HasPrefix(ln, "OK"); f {
-		if err := parseOk(ln, &rs); err != nil {
-			return rs, err
-		}
-		// it's empty OK result
-		return rs, nil
-	}
-
-	// It's single line result
-	if !isMultiResult {
-		rs.Type = ResultType.Ok
-		rs.Message = ln
-		return rs, nil
-	}
-
-	// it's multuline result
-	for ln != "." {
-		rs.AddLine(ln)
-		ln, err = getLineFromReader(rd)
-		if err != nil {
-			return rs, err
-		}
-	}
-	rs.Type = ResultType.Ok
-	return rs, nil
-}
-
-func getLineFromReader(r *bufio.Reader) (string, error) {
-	for {
-		s, err := r.ReadString('\n')
-		if err != nil {
-			return "", err
-		}
-		if !strings.HasPrefix(s, "NOTIFY:") {
-			return strings.TrimSuffix(s, "\r\n"), nil
-		}
-	}
-}
-
 // Client now uses LscpDriver
+// Client is safe for concurrent use: commands are queued by driver and their results never interleave
 
 type Client struct {
 	driver LscpDriver
+	// nil if driver doesn't implement EventDialer
+	events *eventListener
+	// context of commands, see WithContext
+	ctx context.Context
 }
 
 func NewClient(host, port, timeout string) Client {
 	drv := newLscpDriver(host, port, timeout)
-	return Client{driver: drv}
+	return Client{driver: drv, events: newEventListener(drv)}
 }
 
+// Events are supported if driver implements EventDialer
 func NewClientWithDriver(driver LscpDriver) Client {
-	return Client{driver: driver}
+	c := Client{driver: driver}
+	if d, ok := driver.(EventDialer); ok {
+		c.events = newEventListener(d)
+	}
+	return c
 }
 
+// Connects to LinuxSampler. Restores event subscriptions, if any
 func (c *Client) Connect() error {
-	return c.driver.Connect()
+	if err := c.driver.Connect(); err != nil {
+		return err
+	}
+	if c.events != nil {
+		return c.events.resubscribe()
+	}
+	return nil
 }
 
+// Closes command and event connections. Event subscriptions are kept and restored on Connect
 func (c *Client) Disconnect() error {
-	return c.driver.Disconnect()
+	var evtErr error
+	if c.events != nil {
+		evtErr = c.events.close()
+	}
+	return errors.Join(c.driver.Disconnect(), evtErr)
 }
 
 func (c *Client) Ping() error {
-	return c.driver.Ping()
+	return c.driver.Ping(c.context())
+}
+
+// Returns copy of client, which commands are canceled when ctx is done.
+// Without deadline commands wait for result until connection is closed
+func (c *Client) WithContext(ctx context.Context) *Client {
+	cc := *c
+	cc.ctx = ctx
+	return &cc
+}
+
+func (c *Client) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
 }
 
 func (c *Client) retrieveInfo(lscpCmd string, isMultiResult bool) (ResultSet, error) {
-	return c.driver.RetrieveInfo(lscpCmd, isMultiResult)
+	return c.driver.RetrieveInfo(c.context(), lscpCmd, isMultiResult)
 }
 
 func (c *Client) retrieveIndex(lscpCmd string) (int, error) {
@@ -192,6 +91,26 @@ func (c *Client) getIntegerList(lscpCmd string) ([]int, error) {
 	return parseIntList(rs.Message)
 }
 
+// LSCP event subscriptions
+
+// Subscribes handler to LinuxSampler notifications. Sends SUBSCRIBE for events, which aren't subscribed yet.
+// Notifications are received on separate connection, opened on first subscription.
+// Returns subscription id for Unsubscribe
+func (c *Client) Subscribe(handler EventHandler, events ...EventType) (int, error) {
+	if c.events == nil {
+		return 0, ErrEventsNotSupported
+	}
+	return c.events.subscribe(handler, events...)
+}
+
+// Removes subscription. Sends UNSUBSCRIBE for events without other subscriptions
+func (c *Client) Unsubscribe(subId int) error {
+	if c.events == nil {
+		return ErrEventsNotSupported
+	}
+	return c.events.unsubscribe(subId)
+}
+
 // LSCP common commands
 
 // Gets information about the LinuxSampler instance.
@@ -218,6 +137,13 @@ func (c *Client) CreateAudioOutputDevice(adrv string, params ...Parameter[any])
 	return c.retrieveIndex(fmt.Sprintf("%s %s %s", cmd, adrv, strings.Join(plist, " ")))
 }
 
+// Destroys already created audio output device.
+// devId The numerical ID of the audio output device to be destroyed.
+func (c *Client) DestroyAudioOutputDevice(devId int) error {
+	_, err := c.retrieveIndex(fmt.Sprintf("DESTROY AUDIO_OUTPUT_DEVICE %d", devId))
+	return err
+}
+
 // Gets a list of all created audio output devices.
 func (c *Client) GetAudioOutputDevices() ([]AudioOutputDevice, error) {
 	ids, err := c.GetAudioOutputDeviceIDs()
@@ -251,6 +177,71 @@ func (c *Client) GetAudioOutputDeviceInfo(devId int) (AudioOutputDevice, error)
 	return ParseAudioOutputDevice(devId, rs.MultiLineResult)
 }
 
+// Gets all audio output drivers currently available for the LinuxSampler instance.
+func (c *Client) GetAudioOutputDriverNames() ([]string, error) {
+	cmd := "LIST AVAILABLE_AUDIO_OUTPUT_DRIVERS"
+	rs, err := c.retrieveInfo(cmd, false)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Split(rs.Message, ","), nil
+}
+
+// Gets detailed information about all available audio output drivers with their parameters.
+func (c *Client) GetAudioOutputDrivers() ([]AudioOutputDriver, error) {
+	names, err := c.GetAudioOutputDriverNames()
+	if err != nil {
+		return nil, err
+	}
+	drvs := make([]AudioOutputDriver, len(names))
+	for i, v := range names {
+		drvs[i], err = c.GetAudioOutputDriverInfo(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return drvs, nil
+}
+
+// Gets detailed information about a specific audio output driver with its parameters.
+// driver The name of the audio output driver.
+func (c *Client) GetAudioOutputDriverInfo(driver string) (AudioOutputDriver, error) {
+	cmd := fmt.Sprintf("GET AUDIO_OUTPUT_DRIVER INFO %s", driver)
+	rs, err := c.retrieveInfo(cmd, true)
+	if err != nil {
+		return AudioOutputDriver{}, fmt.Errorf("failed lscp command: %s : %w", cmd, err)
+	}
+	drv, params, err := ParseAudioOutputDriver(driver, rs.MultiLineResult)
+	if err != nil {
+		return drv, err
+	}
+	for _, v := range params {
+		prm, err := c.GetAudioOutputDriverParameterInfo(driver, v)
+		if err != nil {
+			return drv, err
+		}
+		drv.Params = append(drv.Params, prm)
+	}
+	return drv, nil
+}
+
+// Gets detailed information about a specific audio output driver parameter.
+// driver The name of the audio output driver.
+// param The name of the parameter.
+// deplist Optional values of parameters, which this parameter depends on.
+// Possibilities and default value may differ for them, e.g. SAMPLERATE depends on CARD
+func (c *Client) GetAudioOutputDriverParameterInfo(driver string, param string, deplist ...Parameter[any]) (Parameter[string], error) {
+	cmd := fmt.Sprintf("GET AUDIO_OUTPUT_DRIVER_PARAMETER INFO %s %s", driver, param)
+	for _, v := range deplist {
+		cmd += fmt.Sprintf(" %s=%s", v.Name, v.GetStringValue())
+	}
+	rs, err := c.retrieveInfo(cmd, true)
+	if err != nil {
+		return Parameter[string]{}, fmt.Errorf("failed lscp command: %s : %w", cmd, err)
+	}
+	return ParseDriverParameter(param, rs.MultiLineResult)
+}
+
 // Alters a specific setting of an audio output channel.
 // chn The audio channel number.
 // prm A <code>Parameter</code> instance containing the name of the parameter
@@ -346,6 +337,17 @@ func (c *Client) LoadInstrument(filename string, instrIdx int, samplerChn int) e
 	return err
 }
 
+// Starts loading of an instrument in background and returns immediately.
+// Loading progress is reported by INSTRUMENT_STATUS of GetSamplerChannelInfo.
+// filename The name of the instrument file on the LinuxSampler instance's host system.
+// instrIdx The index of the instrument in the instrument file.
+// samplerChn The number of the sampler channel the instrument should be assigned to.
+func (c *Client) LoadInstrumentNonModal(filename string, instrIdx int, samplerChn int) error {
+	cmd := fmt.Sprintf("LOAD INSTRUMENT NON_MODAL '%s' %d %d", filename, instrIdx, samplerChn)
+	_, err := c.retrieveIndex(cmd)
+	return err
+}
+
 // Loads a sampler engine to a specific sampler channel.
 // engineName The name of the engine.
 // amplerChn The number of the sampler channel the deployed engine should be assigned to.
@@ -368,6 +370,17 @@ func (c *Client) AddSamplerChannel() (int, error) {
 	return c.retrieveIndex("ADD CHANNEL")
 }
 
+// Gets the current settings of the specified sampler channel.
+// samplerChn The sampler channel number.
+func (c *Client) GetSamplerChannelInfo(samplerChn int) (SamplerChannel, error) {
+	cmd := fmt.Sprintf("GET CHANNEL INFO %d", samplerChn)
+	rs, err := c.retrieveInfo(cmd, true)
+	if err != nil {
+		return SamplerChannel{}, fmt.Errorf("failed lscp command: %s : %w", cmd, err)
+	}
+	return ParseSamplerChannel(samplerChn, rs.MultiLineResult)
+}
+
 // Removes the specified sampler channel.
 // samplerChn The numerical ID of the sampler channel to be removed.
 func (c *Client) RemoveSamplerChannel(samplerChn int) error {
@@ -666,12 +679,12 @@ func (c *Client) GetEffectInstanceInfo(id int) (EffectInstance, error) {
 	if err != nil {
 		return eff, err
 	}
-	for i, _ := range eff.Params {
+	for i := range eff.Params {
 		prm, err := c.GetEffectInstanceParameterInfo(id, i)
 		if err != nil {
 			return eff, err
 		}
-		eff.Params = append(eff.Params, prm)
+		eff.Params[i] = prm
 	}
 	return eff, nil
 }
@@ -0,0 +1,306 @@
+package liblscp
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Connection to LinuxSampler is lost. Reconnect with Connect
+var ErrConnection = errors.New("lscp connection failed")
+
+// LscpDriver defines the interface for LSCP protocol driver
+// Provides connection, ping, disconnect, and command execution.
+// Implementations must be safe for concurrent use
+type LscpDriver interface {
+	Connect() error
+	Disconnect() error
+	Ping(ctx context.Context) error
+	// Sends command and waits its result until ctx is done
+	RetrieveInfo(ctx context.Context, lscpCmd string, isMultiResult bool) (ResultSet, error)
+}
+
+// maximum count of commands sent to LinuxSampler and waiting for result
+const maxPipelined = 32
+
+type lscpResult struct {
+	rs  ResultSet
+	err error
+}
+
+type lscpRequest struct {
+	ctx   context.Context
+	cmd   string
+	multi bool
+	// buffered, so reader never blocks on caller, which gave up waiting
+	res chan lscpResult
+}
+
+// lscpSession is a single connection with its writer and reader loops.
+// Writer sends queued commands without waiting for results of previous ones.
+// Reader reads results in the order of sent commands, so they never interleave
+type lscpSession struct {
+	conn    net.Conn
+	queue   chan *lscpRequest
+	pending chan *lscpRequest
+	done    chan struct{}
+	once    sync.Once
+	// reason of closing. Set before done is closed
+	err error
+}
+
+func newLscpSession(conn net.Conn) *lscpSession {
+	s := &lscpSession{
+		conn:    conn,
+		queue:   make(chan *lscpRequest),
+		pending: make(chan *lscpRequest, maxPipelined),
+		done:    make(chan struct{}),
+	}
+	go s.writeLoop()
+	go s.readLoop()
+	return s
+}
+
+func (s *lscpSession) writeLoop() {
+	for {
+		select {
+		case <-s.done:
+			return
+		case req := <-s.queue:
+			// caller gave up before command is sent
+			if req.ctx.Err() != nil {
+				req.res <- lscpResult{err: req.ctx.Err()}
+				continue
+			}
+			select {
+			case s.pending <- req:
+			case <-s.done:
+				return
+			}
+			dl, _ := req.ctx.Deadline()
+			s.conn.SetWriteDeadline(dl)
+			if _, err := fmt.Fprintf(s.conn, "%s\r\n", req.cmd); err != nil {
+				s.close(fmt.Errorf("%w: %w", ErrConnection, err))
+				return
+			}
+		}
+	}
+}
+
+func (s *lscpSession) readLoop() {
+	// one reader per connection, so buffered bytes are never lost
+	rd := bufio.NewReader(s.conn)
+	for {
+		select {
+		case <-s.done:
+			return
+		case req := <-s.pending:
+			rs, err := ReadResultSet(rd, req.multi)
+			req.res <- lscpResult{rs: rs, err: err}
+			if errors.Is(err, ErrConnection) {
+				s.close(err)
+				return
+			}
+		}
+	}
+}
+
+func (s *lscpSession) close(err error) {
+	s.once.Do(func() {
+		s.err = err
+		close(s.done)
+		s.conn.Close()
+	})
+}
+
+// Queues command and waits its result
+func (s *lscpSession) execute(ctx context.Context, cmd string, multi bool) (ResultSet, error) {
+	req := &lscpRequest{ctx: ctx, cmd: cmd, multi: multi, res: make(chan lscpResult, 1)}
+	select {
+	case s.queue <- req:
+	case <-s.done:
+		return ResultSet{}, s.err
+	case <-ctx.Done():
+		return ResultSet{}, ctx.Err()
+	}
+	select {
+	case r := <-req.res:
+		return r.rs, r.err
+	case <-s.done:
+		// result may be read just before closing
+		select {
+		case r := <-req.res:
+			return r.rs, r.err
+		default:
+			return ResultSet{}, s.err
+		}
+	case <-ctx.Done():
+		// result is read and dropped by reader
+		return ResultSet{}, ctx.Err()
+	}
+}
+
+// lscpDriver is a concrete implementation of LscpDriver
+// Handles TCP connection and LSCP protocol
+type lscpDriver struct {
+	host       string
+	port       string
+	conTimeout string
+	// guards sess. Connect replaces session
+	mu   sync.Mutex
+	sess *lscpSession
+}
+
+func newLscpDriver(host, port, timeout string) *lscpDriver {
+	return &lscpDriver{
+		host:       host,
+		port:       port,
+		conTimeout: timeout,
+	}
+}
+
+// Opens new connection. Commands waiting for result of previous connection fail with ErrConnection
+func (d *lscpDriver) Connect() error {
+	conn, err := d.dial()
+	if err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.sess != nil {
+		d.sess.close(fmt.Errorf("%w: reconnected", ErrConnection))
+	}
+	d.sess = newLscpSession(conn)
+	return nil
+}
+
+func (d *lscpDriver) Disconnect() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.sess != nil {
+		d.sess.close(fmt.Errorf("%w: disconnected", ErrConnection))
+		d.sess = nil
+	}
+	return nil
+}
+
+// Executes GET SERVER INFO. Uses connection timeout, if ctx has no deadline
+func (d *lscpDriver) Ping(ctx context.Context) error {
+	if _, ok := ctx.Deadline(); !ok {
+		t, err := time.ParseDuration(d.conTimeout)
+		if err != nil {
+			return fmt.Errorf("failed parse timeout duration: '%s' %w", d.conTimeout, err)
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t)
+		defer cancel()
+	}
+	_, err := d.RetrieveInfo(ctx, "GET SERVER INFO", true)
+	return err
+}
+
+func (d *lscpDriver) RetrieveInfo(ctx context.Context, lscpCmd string, isMultiResult bool) (ResultSet, error) {
+	d.mu.Lock()
+	sess := d.sess
+	d.mu.Unlock()
+	if sess == nil {
+		return ResultSet{}, fmt.Errorf("%w: not connected", ErrConnection)
+	}
+	cmd := strings.Trim(lscpCmd, " ")
+	rs, err := sess.execute(ctx, cmd, isMultiResult)
+	if err != nil {
+		var lerr *LscpError
+		if errors.As(err, &lerr) {
+			// it's error got from LinuxSampler
+			return rs, err
+		}
+		return rs, fmt.Errorf("failed lscp command: %s : %w", lscpCmd, err)
+	}
+	return rs, nil
+}
+
+// Opens separate connection for LSCP notifications
+func (d *lscpDriver) DialEvents() (net.Conn, error) {
+	return d.dial()
+}
+
+func (d *lscpDriver) dial() (net.Conn, error) {
+	t, err := time.ParseDuration(d.conTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed parse timeout duration: '%s' %w", d.conTimeout, err)
+	}
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(d.host, d.port), t)
+	if err != nil {
+		return nil, fmt.Errorf("failed connect to: '%s:%s' %w", d.host, d.port, err)
+	}
+	return conn, nil
+}
+
+// Reads result of one command. Errors of reading are wrapped with ErrConnection
+func ReadResultSet(rd *bufio.Reader, isMultiResult bool) (ResultSet, error) {
+	rs := ResultSet{}
+
+	ln, err := getLineFromReader(rd)
+	if err != nil {
+		return rs, fmt.Errorf("%w: %w", ErrConnection, err)
+	}
+
+	if f := strings.HasPrefix(ln, "ERR"); f {
+		if err := parseError(ln, &rs); err != nil {
+			return rs, err
+		}
+		// it's error got from LinuxSampler
+		return rs, &LscpError{rs.Code, rs.Message}
+	}
+	if f := strings.HasPrefix(ln, "WRN"); f {
+		if err := parseWarning(ln, &rs); err != nil {
+			return rs, err
+		}
+		// it's warning got from LinuxSampler
+		slog.Warn("LinuxSampler", slog.Int("code", rs.Code), slog.String("msg", rs.Message))
+		return rs, nil
+	}
+	if f := strings.HasPrefix(ln, "OK"); f {
+		if err := parseOk(ln, &rs); err != nil {
+			return rs, err
+		}
+		// it's empty OK result
+		return rs, nil
+	}
+
+	// It's single line result
+	if !isMultiResult {
+		rs.Type = ResultType.Ok
+		rs.Message = ln
+		return rs, nil
+	}
+
+	// it's multuline result
+	for ln != "." {
+		rs.AddLine(ln)
+		ln, err = getLineFromReader(rd)
+		if err != nil {
+			return rs, fmt.Errorf("%w: %w", ErrConnection, err)
+		}
+	}
+	rs.Type = ResultType.Ok
+	return rs, nil
+}
+
+func getLineFromReader(r *bufio.Reader) (string, error) {
+	for {
+		s, err := r.ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+		if !strings.HasPrefix(s, "NOTIFY:") {
+			return strings.TrimSuffix(s, "\r\n"), nil
+		}
+	}
+}
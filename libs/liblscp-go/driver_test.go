@@ -0,0 +1,214 @@
+package liblscp
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLscpServer answers commands over TCP:
+//   - GET SERVER INFO - multiline result
+//   - SLOW <n> - OK[n] after delay
+//   - BATCH <n> - OK[n], but results are sent only when 3 BATCH commands are received
+//   - CLOSE - closes connection
+//   - <any> <n> - OK[n]
+type fakeLscpServer struct {
+	ln    net.Listener
+	mu    sync.Mutex
+	conns []net.Conn
+}
+
+func startFakeLscpServer(t *testing.T) *fakeLscpServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &fakeLscpServer{ln: ln}
+	t.Cleanup(s.stop)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.conns = append(s.conns, conn)
+			s.mu.Unlock()
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeLscpServer) port() string {
+	return fmt.Sprint(s.ln.Addr().(*net.TCPAddr).Port)
+}
+
+func (s *fakeLscpServer) stop() {
+	s.ln.Close()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range s.conns {
+		c.Close()
+	}
+}
+
+func (s *fakeLscpServer) serve(conn net.Conn) {
+	defer conn.Close()
+	scanner := bufio.NewScanner(conn)
+	var batch []string
+	for scanner.Scan() {
+		cmd, arg, _ := strings.Cut(scanner.Text(), " ")
+		switch cmd {
+		case "GET":
+			fmt.Fprint(conn, "DESCRIPTION: fake\r\nVERSION: 1.0\r\nPROTOCOL_VERSION: 1.7\r\n.\r\n")
+		case "CLOSE":
+			return
+		case "SLOW":
+			time.Sleep(200 * time.Millisecond)
+			fmt.Fprintf(conn, "OK[%s]\r\n", arg)
+		case "BATCH":
+			batch = append(batch, arg)
+			if len(batch) == 3 {
+				for _, v := range batch {
+					fmt.Fprintf(conn, "OK[%s]\r\n", v)
+				}
+				batch = nil
+			}
+		default:
+			fmt.Fprintf(conn, "OK[%s]\r\n", arg)
+		}
+	}
+}
+
+func connectFakeDriver(t *testing.T, srv *fakeLscpServer) *lscpDriver {
+	d := newLscpDriver("127.0.0.1", srv.port(), "1s")
+	require.NoError(t, d.Connect())
+	t.Cleanup(func() { d.Disconnect() })
+	return d
+}
+
+func TestLscpDriver_Concurrent(t *testing.T) {
+	d := connectFakeDriver(t, startFakeLscpServer(t))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 201)
+	for g := 0; g < 10; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				idx := g*100 + i
+				rs, err := d.RetrieveInfo(context.Background(), fmt.Sprintf("SET %d", idx), false)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if rs.Index != idx {
+					errs <- fmt.Errorf("got result of other command: want %d, got %d", idx, rs.Index)
+				}
+			}
+		}(g)
+	}
+	// health check concurrently with commands
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 10; i++ {
+			if err := d.Ping(context.Background()); err != nil {
+				errs <- err
+			}
+		}
+	}()
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+}
+
+func TestLscpDriver_ContextDeadline(t *testing.T) {
+	d := connectFakeDriver(t, startFakeLscpServer(t))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := d.RetrieveInfo(ctx, "SLOW 1", false)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// late result of SLOW is dropped
+	rs, err := d.RetrieveInfo(context.Background(), "SET 2", false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, rs.Index)
+
+	// command isn't sent, if ctx is already done
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = d.RetrieveInfo(ctx, "SET 3", false)
+	assert.ErrorIs(t, err, context.Canceled)
+	rs, err = d.RetrieveInfo(context.Background(), "SET 4", false)
+	require.NoError(t, err)
+	assert.Equal(t, 4, rs.Index)
+}
+
+func TestLscpDriver_Pipelining(t *testing.T) {
+	d := connectFakeDriver(t, startFakeLscpServer(t))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	// server answers only when all commands are received
+	var wg sync.WaitGroup
+	got := make([]int, 3)
+	for i := range got {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			rs, err := d.RetrieveInfo(ctx, fmt.Sprintf("BATCH %d", i), false)
+			assert.NoError(t, err)
+			got[i] = rs.Index
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, []int{0, 1, 2}, got)
+}
+
+func TestLscpDriver_Reconnect(t *testing.T) {
+	d := connectFakeDriver(t, startFakeLscpServer(t))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err := d.RetrieveInfo(ctx, "CLOSE", false)
+	assert.ErrorIs(t, err, ErrConnection)
+	_, err = d.RetrieveInfo(ctx, "SET 1", false)
+	assert.ErrorIs(t, err, ErrConnection)
+	assert.Error(t, d.Ping(ctx))
+
+	require.NoError(t, d.Connect())
+	rs, err := d.RetrieveInfo(ctx, "SET 2", false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, rs.Index)
+	assert.NoError(t, d.Ping(ctx))
+
+	require.NoError(t, d.Disconnect())
+	_, err = d.RetrieveInfo(ctx, "SET 3", false)
+	assert.Error(t, err)
+}
+
+func TestClient_WithContext(t *testing.T) {
+	srv := startFakeLscpServer(t)
+	c := NewClient("127.0.0.1", srv.port(), "1s")
+	require.NoError(t, c.Connect())
+	defer c.Disconnect()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := c.WithContext(ctx).AddSamplerChannel()
+	assert.ErrorIs(t, err, context.Canceled)
+	// original client isn't bound to ctx
+	assert.NoError(t, c.Ping())
+}
@@ -0,0 +1,259 @@
+package liblscp
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LSCP event, which can be subscribed by SUBSCRIBE command
+type EventType string
+
+const (
+	EventChannelInfo   EventType = "CHANNEL_INFO"
+	EventVoiceCount    EventType = "VOICE_COUNT"
+	EventStreamCount   EventType = "STREAM_COUNT"
+	EventBufferFill    EventType = "BUFFER_FILL"
+	EventChannelMidi   EventType = "CHANNEL_MIDI"
+	EventDeviceMidi    EventType = "DEVICE_MIDI"
+	EventFxSendInfo    EventType = "FX_SEND_INFO"
+	EventMiscellaneous EventType = "MISCELLANEOUS"
+)
+
+// Notification got from LinuxSampler. Use type switch to get concrete event
+type Event interface {
+	Type() EventType
+}
+
+// Settings of sampler channel changed. Use GetSamplerChannelInfo to get them
+type ChannelInfoEvent struct {
+	Channel int
+}
+
+func (e ChannelInfoEvent) Type() EventType { return EventChannelInfo }
+
+// Count of active voices of sampler channel changed
+type VoiceCountEvent struct {
+	Channel int
+	Count   int
+}
+
+func (e VoiceCountEvent) Type() EventType { return EventVoiceCount }
+
+// Count of active disk streams of sampler channel changed
+type StreamCountEvent struct {
+	Channel int
+	Count   int
+}
+
+func (e StreamCountEvent) Type() EventType { return EventStreamCount }
+
+// Fill state of disk stream buffer
+type StreamFill struct {
+	StreamId int
+	// bytes or percent, see BufferFillEvent.Percentage
+	Fill int
+}
+
+// Fill state of disk stream buffers of sampler channel changed
+type BufferFillEvent struct {
+	Channel int
+	// true if Fill values are in percent, otherwise in bytes
+	Percentage bool
+	Streams    []StreamFill
+}
+
+func (e BufferFillEvent) Type() EventType { return EventBufferFill }
+
+// MIDI message types reported by CHANNEL_MIDI and DEVICE_MIDI events
+const (
+	MidiNoteOn        = "NOTE_ON"
+	MidiNoteOff       = "NOTE_OFF"
+	MidiControlChange = "CC"
+)
+
+// MIDI message of CHANNEL_MIDI and DEVICE_MIDI events
+type MidiMessage struct {
+	// NOTE_ON, NOTE_OFF, CC, ...
+	MidiType string
+	// note number or controller number
+	Data1 int
+	// velocity or controller value
+	Data2 int
+}
+
+// MIDI data arrived on sampler channel
+type ChannelMidiEvent struct {
+	Channel int
+	MidiMessage
+}
+
+func (e ChannelMidiEvent) Type() EventType { return EventChannelMidi }
+
+// MIDI data arrived on port of MIDI input device
+type DeviceMidiEvent struct {
+	Device int
+	Port   int
+	MidiMessage
+}
+
+func (e DeviceMidiEvent) Type() EventType { return EventDeviceMidi }
+
+// Settings of FX send of sampler channel changed
+type FxSendInfoEvent struct {
+	Channel  int
+	FxSendId int
+}
+
+func (e FxSendInfoEvent) Type() EventType { return EventFxSendInfo }
+
+// Debug messages of LinuxSampler
+type MiscEvent struct {
+	Message string
+}
+
+func (e MiscEvent) Type() EventType { return EventMiscellaneous }
+
+// Parses notification line: NOTIFY:<event>:<event-data>
+func ParseEvent(ln string) (Event, error) {
+	ln = strings.TrimRight(ln, "\r\n")
+	body, f := strings.CutPrefix(ln, "NOTIFY:")
+	if !f {
+		return nil, fmt.Errorf("not notification: '%s'", ln)
+	}
+	name, data, f := strings.Cut(body, ":")
+	if !f {
+		return nil, fmt.Errorf("invalid notification: '%s'", ln)
+	}
+
+	switch EventType(name) {
+	case EventMiscellaneous:
+		return MiscEvent{Message: data}, nil
+	case EventChannelInfo:
+		chn, err := parseEventInts(data, 1)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s notification: %w", name, err)
+		}
+		return ChannelInfoEvent{Channel: chn[0]}, nil
+	case EventVoiceCount:
+		v, err := parseEventInts(data, 2)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s notification: %w", name, err)
+		}
+		return VoiceCountEvent{Channel: v[0], Count: v[1]}, nil
+	case EventStreamCount:
+		v, err := parseEventInts(data, 2)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s notification: %w", name, err)
+		}
+		return StreamCountEvent{Channel: v[0], Count: v[1]}, nil
+	case EventFxSendInfo:
+		v, err := parseEventInts(data, 2)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s notification: %w", name, err)
+		}
+		return FxSendInfoEvent{Channel: v[0], FxSendId: v[1]}, nil
+	case EventBufferFill:
+		evt, err := parseBufferFill(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s notification: %w", name, err)
+		}
+		return evt, nil
+	case EventChannelMidi:
+		fields := strings.Fields(data)
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("invalid %s notification: '%s'", name, data)
+		}
+		chn, err := parseInt(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s notification: %w", name, err)
+		}
+		msg, err := parseMidiMessage(fields[1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s notification: %w", name, err)
+		}
+		return ChannelMidiEvent{Channel: chn, MidiMessage: msg}, nil
+	case EventDeviceMidi:
+		fields := strings.Fields(data)
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("invalid %s notification: '%s'", name, data)
+		}
+		dev, err := parseInt(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s notification: %w", name, err)
+		}
+		port, err := parseInt(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s notification: %w", name, err)
+		}
+		msg, err := parseMidiMessage(fields[2:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s notification: %w", name, err)
+		}
+		return DeviceMidiEvent{Device: dev, Port: port, MidiMessage: msg}, nil
+	}
+	return nil, fmt.Errorf("unsupported notification: '%s'", name)
+}
+
+// Parses space separated integers. Expects exactly cnt values
+func parseEventInts(data string, cnt int) ([]int, error) {
+	fields := strings.Fields(data)
+	if len(fields) != cnt {
+		return nil, fmt.Errorf("expected %d values: '%s'", cnt, data)
+	}
+	res := make([]int, cnt)
+	for i, v := range fields {
+		n, err := parseInt(v)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = n
+	}
+	return res, nil
+}
+
+// Parses <type> <arg1> <arg2>
+func parseMidiMessage(fields []string) (MidiMessage, error) {
+	d1, err := parseInt(fields[1])
+	if err != nil {
+		return MidiMessage{}, err
+	}
+	d2, err := parseInt(fields[2])
+	if err != nil {
+		return MidiMessage{}, err
+	}
+	return MidiMessage{MidiType: fields[0], Data1: d1, Data2: d2}, nil
+}
+
+// Parses <sampler-channel> <fill-data>, where fill-data is [<stream-id>]<fill>,...
+// fill is bytes count or percent with % suffix
+func parseBufferFill(data string) (BufferFillEvent, error) {
+	chnStr, fillStr, _ := strings.Cut(strings.TrimSpace(data), " ")
+	chn, err := parseInt(chnStr)
+	if err != nil {
+		return BufferFillEvent{}, err
+	}
+	evt := BufferFillEvent{Channel: chn}
+	fillStr = strings.TrimSpace(fillStr)
+	if fillStr == "" {
+		return evt, nil
+	}
+	for i, v := range strings.Split(fillStr, ",") {
+		idStr, fill, f := strings.Cut(strings.TrimPrefix(v, "["), "]")
+		if !f {
+			return evt, fmt.Errorf("invalid stream fill: '%s'", v)
+		}
+		fill, pct := strings.CutSuffix(fill, "%")
+		if i == 0 {
+			evt.Percentage = pct
+		}
+		sf := StreamFill{}
+		if sf.StreamId, err = parseInt(idStr); err != nil {
+			return evt, err
+		}
+		if sf.Fill, err = parseInt(fill); err != nil {
+			return evt, err
+		}
+		evt.Streams = append(evt.Streams, sf)
+	}
+	return evt, nil
+}
@@ -0,0 +1,62 @@
+package liblscp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEvent(t *testing.T) {
+	tests := []struct {
+		name string
+		ln   string
+		want Event
+	}{
+		{"channel info", "NOTIFY:CHANNEL_INFO:2\r\n", ChannelInfoEvent{Channel: 2}},
+		{"voice count", "NOTIFY:VOICE_COUNT:1 12", VoiceCountEvent{Channel: 1, Count: 12}},
+		{"stream count", "NOTIFY:STREAM_COUNT:0 3", StreamCountEvent{Channel: 0, Count: 3}},
+		{"fx send info", "NOTIFY:FX_SEND_INFO:1 0", FxSendInfoEvent{Channel: 1, FxSendId: 0}},
+		{"misc", "NOTIFY:MISCELLANEOUS:engine: loaded", MiscEvent{Message: "engine: loaded"}},
+		{
+			"buffer fill percent", "NOTIFY:BUFFER_FILL:0 [1]80%,[2]45%",
+			BufferFillEvent{Channel: 0, Percentage: true, Streams: []StreamFill{{StreamId: 1, Fill: 80}, {StreamId: 2, Fill: 45}}},
+		},
+		{
+			"buffer fill bytes", "NOTIFY:BUFFER_FILL:3 [0]2048",
+			BufferFillEvent{Channel: 3, Streams: []StreamFill{{StreamId: 0, Fill: 2048}}},
+		},
+		{"buffer fill empty", "NOTIFY:BUFFER_FILL:3", BufferFillEvent{Channel: 3}},
+		{
+			"channel midi", "NOTIFY:CHANNEL_MIDI:1 NOTE_ON 38 100",
+			ChannelMidiEvent{Channel: 1, MidiMessage: MidiMessage{MidiType: MidiNoteOn, Data1: 38, Data2: 100}},
+		},
+		{
+			"device midi", "NOTIFY:DEVICE_MIDI:0 1 NOTE_OFF 36 0",
+			DeviceMidiEvent{Device: 0, Port: 1, MidiMessage: MidiMessage{MidiType: MidiNoteOff, Data1: 36, Data2: 0}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseEvent(tt.ln)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want.Type(), got.Type())
+		})
+	}
+}
+
+func TestParseEvent_Invalid(t *testing.T) {
+	for _, ln := range []string{
+		"OK",
+		"NOTIFY:VOICE_COUNT",
+		"NOTIFY:VOICE_COUNT:1",
+		"NOTIFY:CHANNEL_INFO:x",
+		"NOTIFY:CHANNEL_MIDI:1 NOTE_ON 38",
+		"NOTIFY:BUFFER_FILL:0 1:80%",
+		"NOTIFY:CHANNEL_COUNT:2",
+	} {
+		_, err := ParseEvent(ln)
+		assert.Error(t, err, ln)
+	}
+}
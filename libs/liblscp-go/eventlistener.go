@@ -0,0 +1,320 @@
+package liblscp
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrEventsNotSupported = errors.New("lscp driver doesn't support event connection")
+
+// EventDialer is implemented by drivers, which can open separate connection for LSCP events.
+// Notifications are read from this connection only, so they never interleave with command results
+type EventDialer interface {
+	DialEvents() (net.Conn, error)
+}
+
+// Called from event reading goroutine. Must not block and must not call Subscribe/Unsubscribe
+type EventHandler func(Event)
+
+// timeout of SUBSCRIBE/UNSUBSCRIBE result
+var eventCmdTimeout = 5 * time.Second
+
+var errEventCmdTimeout = errors.New("timeout")
+
+type subscription struct {
+	events  []EventType
+	handler EventHandler
+}
+
+// eventListener holds event connection, sends SUBSCRIBE/UNSUBSCRIBE commands and dispatches notifications to handlers
+type eventListener struct {
+	dialer EventDialer
+	// serializes commands and connection changes
+	cmdMu sync.Mutex
+	// guards fields below
+	mu      sync.Mutex
+	conn    net.Conn
+	replies chan string
+	// generation of connection. Incremented on opening of connection
+	connGen int
+	nextId  int
+	subs    map[int]subscription
+	// count of subscriptions per event
+	subscribed map[EventType]int
+}
+
+func newEventListener(dialer EventDialer) *eventListener {
+	return &eventListener{
+		dialer:     dialer,
+		subs:       map[int]subscription{},
+		subscribed: map[EventType]int{},
+	}
+}
+
+// Subscribes handler to events. Returns subscription id for Unsubscribe.
+// Opens event connection on first subscription
+func (l *eventListener) subscribe(handler EventHandler, events ...EventType) (int, error) {
+	if len(events) == 0 {
+		return 0, fmt.Errorf("no events to subscribe")
+	}
+	l.cmdMu.Lock()
+	defer l.cmdMu.Unlock()
+
+	if err := l.ensureConn(); err != nil {
+		return 0, err
+	}
+	l.mu.Lock()
+	gen := l.connGen
+	var newEvts []EventType
+	for _, e := range events {
+		if l.subscribed[e] == 0 && !slices.Contains(newEvts, e) {
+			newEvts = append(newEvts, e)
+		}
+	}
+	l.mu.Unlock()
+
+	for i, e := range newEvts {
+		if err := l.command(fmt.Sprintf("SUBSCRIBE %s", e)); err != nil {
+			// rollback already subscribed events. Reopened connection has only tracked subscriptions, nothing to rollback
+			for _, s := range newEvts[:i] {
+				if l.generation() != gen {
+					break
+				}
+				l.command(fmt.Sprintf("UNSUBSCRIBE %s", s))
+			}
+			return 0, err
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.nextId++
+	l.subs[l.nextId] = subscription{events: events, handler: handler}
+	for _, e := range events {
+		l.subscribed[e]++
+	}
+	return l.nextId, nil
+}
+
+// Removes subscription. Sends UNSUBSCRIBE for events without other subscriptions.
+// Closes event connection when last subscription is removed
+func (l *eventListener) unsubscribe(id int) error {
+	l.cmdMu.Lock()
+	defer l.cmdMu.Unlock()
+
+	l.mu.Lock()
+	sub, ok := l.subs[id]
+	if !ok {
+		l.mu.Unlock()
+		return fmt.Errorf("subscription not found: %d", id)
+	}
+	delete(l.subs, id)
+	var unsub []EventType
+	for _, e := range sub.events {
+		l.subscribed[e]--
+		if l.subscribed[e] <= 0 {
+			delete(l.subscribed, e)
+			unsub = append(unsub, e)
+		}
+	}
+	connected := l.conn != nil
+	last := len(l.subs) == 0
+	l.mu.Unlock()
+
+	if !connected {
+		return nil
+	}
+	var errs []error
+	for _, e := range unsub {
+		if err := l.command(fmt.Sprintf("UNSUBSCRIBE %s", e)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if last {
+		l.close()
+	}
+	return errors.Join(errs...)
+}
+
+// Reopens event connection and subscribes to all events of existing subscriptions.
+// Used after reconnect to restarted LinuxSampler
+func (l *eventListener) resubscribe() error {
+	l.cmdMu.Lock()
+	defer l.cmdMu.Unlock()
+
+	l.close()
+	return l.restore()
+}
+
+// Opens event connection and subscribes to all events of existing subscriptions.
+// Caller must hold cmdMu
+func (l *eventListener) restore() error {
+	l.mu.Lock()
+	events := make([]EventType, 0, len(l.subscribed))
+	for e := range l.subscribed {
+		events = append(events, e)
+	}
+	l.mu.Unlock()
+	if len(events) == 0 {
+		return nil
+	}
+
+	if err := l.ensureConn(); err != nil {
+		return err
+	}
+	for _, e := range events {
+		if err := l.send(fmt.Sprintf("SUBSCRIBE %s", e)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Closes event connection. Subscriptions are kept and restored by resubscribe
+func (l *eventListener) close() error {
+	l.mu.Lock()
+	conn := l.conn
+	l.conn = nil
+	l.mu.Unlock()
+	if conn == nil {
+		return nil
+	}
+	if err := conn.Close(); err != nil {
+		return fmt.Errorf("failed close event connection: %w", err)
+	}
+	return nil
+}
+
+func (l *eventListener) generation() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.connGen
+}
+
+// caller must hold cmdMu
+func (l *eventListener) ensureConn() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conn != nil {
+		return nil
+	}
+	conn, err := l.dialer.DialEvents()
+	if err != nil {
+		return fmt.Errorf("failed open event connection: %w", err)
+	}
+	l.conn = conn
+	l.connGen++
+	l.replies = make(chan string, 1)
+	go l.readLoop(conn, l.replies)
+	return nil
+}
+
+// Sends command to event connection and waits its result.
+// Late result of timed out command would be taken as result of the next command,
+// so connection is reopened with existing subscriptions. Caller must hold cmdMu
+func (l *eventListener) command(cmd string) error {
+	err := l.send(cmd)
+	if errors.Is(err, errEventCmdTimeout) {
+		if rerr := l.restore(); rerr != nil {
+			slog.Warn("failed reopen LinuxSampler event connection", slog.String("err", rerr.Error()))
+		}
+	}
+	return err
+}
+
+// Sends command to event connection and waits its result. Connection is closed on timeout.
+// Caller must hold cmdMu
+func (l *eventListener) send(cmd string) error {
+	l.mu.Lock()
+	conn, replies := l.conn, l.replies
+	l.mu.Unlock()
+	if conn == nil {
+		return fmt.Errorf("failed lscp command: %s : event connection closed", cmd)
+	}
+	if _, err := fmt.Fprintf(conn, "%s\r\n", cmd); err != nil {
+		return fmt.Errorf("failed lscp command: %s : %w", cmd, err)
+	}
+
+	select {
+	case ln, ok := <-replies:
+		if !ok {
+			return fmt.Errorf("failed lscp command: %s : event connection closed", cmd)
+		}
+		rs := ResultSet{}
+		if strings.HasPrefix(ln, "ERR") {
+			if err := parseError(ln, &rs); err != nil {
+				return fmt.Errorf("failed lscp command: %s : %w", cmd, err)
+			}
+			return fmt.Errorf("failed lscp command: %s : %w", cmd, &LscpError{rs.Code, rs.Message})
+		}
+		if strings.HasPrefix(ln, "WRN") {
+			if err := parseWarning(ln, &rs); err == nil {
+				slog.Warn("LinuxSampler", slog.Int("code", rs.Code), slog.String("msg", rs.Message))
+			}
+		}
+		return nil
+	case <-time.After(eventCmdTimeout):
+		l.close()
+		return fmt.Errorf("failed lscp command: %s : %w", cmd, errEventCmdTimeout)
+	}
+}
+
+// Reads event connection until it's closed. Notifications are dispatched to handlers,
+// other lines are results of SUBSCRIBE/UNSUBSCRIBE
+func (l *eventListener) readLoop(conn net.Conn, replies chan<- string) {
+	defer close(replies)
+	rd := bufio.NewReader(conn)
+	for {
+		ln, err := rd.ReadString('\n')
+		if err != nil {
+			l.mu.Lock()
+			if l.conn == conn {
+				l.conn = nil
+				slog.Warn("LinuxSampler event connection lost", slog.String("err", err.Error()))
+			}
+			l.mu.Unlock()
+			return
+		}
+		ln = strings.TrimSpace(ln)
+		if ln == "" {
+			continue
+		}
+		if !strings.HasPrefix(ln, "NOTIFY:") {
+			select {
+			case replies <- ln:
+			default:
+				slog.Warn("unexpected lscp result on event connection", slog.String("line", ln))
+			}
+			continue
+		}
+		evt, err := ParseEvent(ln)
+		if err != nil {
+			slog.Warn("failed parse lscp notification", slog.String("err", err.Error()))
+			continue
+		}
+		l.dispatch(evt)
+	}
+}
+
+func (l *eventListener) dispatch(evt Event) {
+	l.mu.Lock()
+	var handlers []EventHandler
+	// in order of subscription
+	for _, id := range slices.Sorted(maps.Keys(l.subs)) {
+		if s := l.subs[id]; slices.Contains(s.events, evt.Type()) {
+			handlers = append(handlers, s.handler)
+		}
+	}
+	l.mu.Unlock()
+	for _, h := range handlers {
+		h(evt)
+	}
+}
@@ -0,0 +1,247 @@
+package liblscp
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEventDriver opens in-memory event connections served by fakeEventServer
+type fakeEventDriver struct {
+	mu      sync.Mutex
+	servers []*fakeEventServer
+	// SUBSCRIBE of these events returns error
+	rejected []string
+	// result of these commands is delayed
+	delayed []string
+	delay   time.Duration
+}
+
+func (d *fakeEventDriver) Connect() error                 { return nil }
+func (d *fakeEventDriver) Disconnect() error              { return nil }
+func (d *fakeEventDriver) Ping(ctx context.Context) error { return nil }
+func (d *fakeEventDriver) RetrieveInfo(ctx context.Context, lscpCmd string, isMultiResult bool) (ResultSet, error) {
+	return ResultSet{}, nil
+}
+
+func (d *fakeEventDriver) DialEvents() (net.Conn, error) {
+	client, server := net.Pipe()
+	srv := &fakeEventServer{conn: server, rejected: d.rejected, delayed: d.delayed, delay: d.delay}
+	go srv.listen()
+	d.mu.Lock()
+	d.servers = append(d.servers, srv)
+	d.mu.Unlock()
+	return client, nil
+}
+
+func (d *fakeEventDriver) server(i int) *fakeEventServer {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.servers[i]
+}
+
+type fakeEventServer struct {
+	conn     net.Conn
+	rejected []string
+	delayed  []string
+	delay    time.Duration
+	mu       sync.Mutex
+	received []string
+}
+
+func (s *fakeEventServer) listen() {
+	scanner := bufio.NewScanner(s.conn)
+	for scanner.Scan() {
+		req := scanner.Text()
+		if slices.Contains(s.delayed, req) {
+			time.Sleep(s.delay)
+		}
+		s.mu.Lock()
+		s.received = append(s.received, req)
+		resp := "OK\r\n"
+		for _, e := range s.rejected {
+			if req == "SUBSCRIBE "+e {
+				resp = "ERR:0:unknown event\r\n"
+			}
+		}
+		s.conn.Write([]byte(resp))
+		s.mu.Unlock()
+	}
+}
+
+func (s *fakeEventServer) notify(ln string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fmt.Fprintf(s.conn, "%s\r\n", ln)
+}
+
+func (s *fakeEventServer) messages() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.received...)
+}
+
+// collects events from handler
+type eventSink struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (s *eventSink) handle(e Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, e)
+}
+
+func (s *eventSink) get() []Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Event(nil), s.events...)
+}
+
+func TestClient_Subscribe(t *testing.T) {
+	drv := &fakeEventDriver{}
+	c := NewClientWithDriver(drv)
+
+	midi, voices := &eventSink{}, &eventSink{}
+	midiSub, err := c.Subscribe(midi.handle, EventChannelMidi)
+	require.NoError(t, err)
+	voiceSub, err := c.Subscribe(voices.handle, EventVoiceCount, EventChannelMidi)
+	require.NoError(t, err)
+
+	srv := drv.server(0)
+	// CHANNEL_MIDI is subscribed once
+	assert.Equal(t, []string{"SUBSCRIBE CHANNEL_MIDI", "SUBSCRIBE VOICE_COUNT"}, srv.messages())
+
+	srv.notify("NOTIFY:CHANNEL_MIDI:0 NOTE_ON 38 127")
+	srv.notify("NOTIFY:VOICE_COUNT:0 4")
+	srv.notify("NOTIFY:BROKEN")
+	srv.notify("NOTIFY:STREAM_COUNT:0 1")
+
+	note := ChannelMidiEvent{Channel: 0, MidiMessage: MidiMessage{MidiType: MidiNoteOn, Data1: 38, Data2: 127}}
+	assert.Eventually(t, func() bool { return len(voices.get()) == 2 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []Event{note}, midi.get())
+	assert.Equal(t, []Event{note, VoiceCountEvent{Channel: 0, Count: 4}}, voices.get())
+
+	// CHANNEL_MIDI is still used by other subscription
+	require.NoError(t, c.Unsubscribe(midiSub))
+	srv.notify("NOTIFY:CHANNEL_MIDI:0 NOTE_OFF 38 0")
+	assert.Eventually(t, func() bool { return len(voices.get()) == 3 }, time.Second, 10*time.Millisecond)
+	assert.Len(t, midi.get(), 1)
+
+	require.NoError(t, c.Unsubscribe(voiceSub))
+	assert.Equal(t, []string{
+		"SUBSCRIBE CHANNEL_MIDI",
+		"SUBSCRIBE VOICE_COUNT",
+		"UNSUBSCRIBE VOICE_COUNT",
+		"UNSUBSCRIBE CHANNEL_MIDI",
+	}, srv.messages())
+
+	assert.Error(t, c.Unsubscribe(voiceSub))
+}
+
+func TestClient_SubscribeRejected(t *testing.T) {
+	drv := &fakeEventDriver{rejected: []string{"VOICE_COUNT"}}
+	c := NewClientWithDriver(drv)
+
+	_, err := c.Subscribe(func(Event) {}, EventChannelInfo, EventVoiceCount)
+	var lscpErr *LscpError
+	require.ErrorAs(t, err, &lscpErr)
+	// already subscribed events are rolled back
+	assert.Equal(t, []string{
+		"SUBSCRIBE CHANNEL_INFO",
+		"SUBSCRIBE VOICE_COUNT",
+		"UNSUBSCRIBE CHANNEL_INFO",
+	}, drv.server(0).messages())
+}
+
+func TestClient_SubscribeAfterReconnect(t *testing.T) {
+	drv := &fakeEventDriver{}
+	c := NewClientWithDriver(drv)
+
+	sink := &eventSink{}
+	_, err := c.Subscribe(sink.handle, EventChannelInfo)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Disconnect())
+	require.NoError(t, c.Connect())
+
+	srv := drv.server(1)
+	assert.Equal(t, []string{"SUBSCRIBE CHANNEL_INFO"}, srv.messages())
+	srv.notify("NOTIFY:CHANNEL_INFO:5")
+	assert.Eventually(t, func() bool { return len(sink.get()) == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []Event{ChannelInfoEvent{Channel: 5}}, sink.get())
+}
+
+func TestClient_SubscribeTimeout(t *testing.T) {
+	timeout := eventCmdTimeout
+	eventCmdTimeout = 50 * time.Millisecond
+	defer func() { eventCmdTimeout = timeout }()
+	drv := &fakeEventDriver{
+		rejected: []string{"CHANNEL_MIDI"},
+		delayed:  []string{"SUBSCRIBE VOICE_COUNT"},
+		delay:    100 * time.Millisecond,
+	}
+	c := NewClientWithDriver(drv)
+
+	sink := &eventSink{}
+	_, err := c.Subscribe(sink.handle, EventChannelInfo)
+	require.NoError(t, err)
+	_, err = c.Subscribe(func(Event) {}, EventVoiceCount)
+	require.ErrorIs(t, err, errEventCmdTimeout)
+
+	// late OK isn't taken as result of the next command, connection is reopened with existing subscriptions
+	_, err = c.Subscribe(func(Event) {}, EventChannelMidi)
+	var lscpErr *LscpError
+	require.ErrorAs(t, err, &lscpErr)
+
+	srv := drv.server(1)
+	assert.Equal(t, []string{"SUBSCRIBE CHANNEL_INFO", "SUBSCRIBE CHANNEL_MIDI"}, srv.messages())
+	srv.notify("NOTIFY:CHANNEL_INFO:5")
+	assert.Eventually(t, func() bool { return len(sink.get()) == 1 }, time.Second, 10*time.Millisecond)
+}
+
+func TestClient_SubscribeTimeoutRollback(t *testing.T) {
+	timeout := eventCmdTimeout
+	eventCmdTimeout = 50 * time.Millisecond
+	defer func() { eventCmdTimeout = timeout }()
+	drv := &fakeEventDriver{delayed: []string{"SUBSCRIBE VOICE_COUNT"}, delay: 100 * time.Millisecond}
+	c := NewClientWithDriver(drv)
+
+	_, err := c.Subscribe(func(Event) {}, EventChannelInfo)
+	require.NoError(t, err)
+	_, err = c.Subscribe(func(Event) {}, EventStreamCount, EventVoiceCount)
+	require.ErrorIs(t, err, errEventCmdTimeout)
+
+	// reopened connection hasn't subscription to rollback
+	assert.Equal(t, []string{"SUBSCRIBE CHANNEL_INFO"}, drv.server(1).messages())
+}
+
+func TestClient_SubscribeNotSupported(t *testing.T) {
+	c := NewClientWithDriver(fakeDriverNoEvents{})
+	_, err := c.Subscribe(func(Event) {}, EventChannelInfo)
+	assert.ErrorIs(t, err, ErrEventsNotSupported)
+}
+
+type fakeDriverNoEvents struct{}
+
+func (fakeDriverNoEvents) Connect() error                 { return nil }
+func (fakeDriverNoEvents) Disconnect() error              { return nil }
+func (fakeDriverNoEvents) Ping(ctx context.Context) error { return nil }
+func (fakeDriverNoEvents) RetrieveInfo(ctx context.Context, lscpCmd string, isMultiResult bool) (ResultSet, error) {
+	return ResultSet{}, nil
+}
+
+func TestClient_SubscribeNoEvents(t *testing.T) {
+	c := NewClientWithDriver(&fakeEventDriver{})
+	_, err := c.Subscribe(func(Event) {})
+	assert.ErrorContains(t, err, "no events")
+}
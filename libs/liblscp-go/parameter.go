@@ -35,6 +35,7 @@ var ParameterToType = map[string]ParameterType{
 type Parameter[T any] struct {
 	Name           string
 	Description    string
+	Type           ParameterType
 	Value          T
 	IsMultiplicity bool
 	isMandatory    bool
@@ -54,7 +55,11 @@ func (p *Parameter[T]) SetRangeMin(min float32) {
 }
 
 func (p *Parameter[T]) SetRangeMax(max float32) {
-	p.rangeMin = &max
+	p.rangeMax = &max
+}
+
+func (p *Parameter[T]) IsMandatory() bool {
+	return p.isMandatory
 }
 
 func (p *Parameter[T]) RangeMin() (setted bool, value float32) {
@@ -0,0 +1,120 @@
+package liblscp
+
+import (
+	"strconv"
+	"strings"
+)
+
+type SamplerChannel struct {
+	Id         int
+	EngineName string
+	Volume     float32
+	// -1 if device isn't assigned
+	AudioOutputDevice int
+	MidiInputDevice   int
+	MidiInputPort     int
+	InstrumentFile    string
+	InstrumentNr      int
+	InstrumentName    string
+	// loading progress of instrument 0..100. Negative value means loading error
+	InstrumentStatus int
+	Mute             bool
+	// channel is muted because other channels are soloed
+	MutedBySolo bool
+	Solo        bool
+}
+
+// Parses result of GET CHANNEL INFO
+func ParseSamplerChannel(chnId int, ln []string) (SamplerChannel, error) {
+	chn := SamplerChannel{
+		Id:                chnId,
+		AudioOutputDevice: -1,
+		MidiInputDevice:   -1,
+	}
+	var err error
+	for _, v := range ln {
+		if vl, f := strings.CutPrefix(v, "ENGINE_NAME: "); f {
+			chn.EngineName = vl
+			continue
+		}
+		if vl, f := strings.CutPrefix(v, "VOLUME: "); f {
+			if chn.Volume, err = parseFloat(vl); err != nil {
+				return chn, err
+			}
+			continue
+		}
+		if vl, f := strings.CutPrefix(v, "AUDIO_OUTPUT_DEVICE: "); f {
+			if chn.AudioOutputDevice, err = parseIntOrNone(vl); err != nil {
+				return chn, err
+			}
+			continue
+		}
+		if vl, f := strings.CutPrefix(v, "MIDI_INPUT_DEVICE: "); f {
+			if chn.MidiInputDevice, err = parseIntOrNone(vl); err != nil {
+				return chn, err
+			}
+			continue
+		}
+		if vl, f := strings.CutPrefix(v, "MIDI_INPUT_PORT: "); f {
+			if chn.MidiInputPort, err = parseIntOrNone(vl); err != nil {
+				return chn, err
+			}
+			continue
+		}
+		if vl, f := strings.CutPrefix(v, "INSTRUMENT_FILE: "); f {
+			chn.InstrumentFile = unescapeLscp(vl)
+			continue
+		}
+		if vl, f := strings.CutPrefix(v, "INSTRUMENT_NR: "); f {
+			if chn.InstrumentNr, err = parseIntOrNone(vl); err != nil {
+				return chn, err
+			}
+			continue
+		}
+		if vl, f := strings.CutPrefix(v, "INSTRUMENT_NAME: "); f {
+			chn.InstrumentName = unescapeLscp(vl)
+			continue
+		}
+		if vl, f := strings.CutPrefix(v, "INSTRUMENT_STATUS: "); f {
+			if chn.InstrumentStatus, err = parseInt(vl); err != nil {
+				return chn, err
+			}
+			continue
+		}
+		if vl, f := strings.CutPrefix(v, "MUTE: "); f {
+			if vl == "MUTED_BY_SOLO" {
+				chn.MutedBySolo = true
+				continue
+			}
+			if chn.Mute, err = strconv.ParseBool(vl); err != nil {
+				return chn, err
+			}
+			continue
+		}
+		if vl, f := strings.CutPrefix(v, "SOLO: "); f {
+			if chn.Solo, err = strconv.ParseBool(vl); err != nil {
+				return chn, err
+			}
+		}
+	}
+	return chn, nil
+}
+
+// Parses int value, which may be NONE. NONE is returned as -1
+func parseIntOrNone(s string) (int, error) {
+	if s == "NONE" {
+		return -1, nil
+	}
+	return parseInt(s)
+}
+
+// LinuxSampler escapes non-ASCII characters in file names as \xNN
+func unescapeLscp(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	if res, err := strconv.Unquote(`"` + strings.ReplaceAll(s, `"`, `\"`) + `"`); err == nil {
+		return res
+	}
+	return s
+}